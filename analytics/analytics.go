@@ -0,0 +1,202 @@
+// Package analytics provides time series of a match timeline,
+// like gold, xp and cs per participant and team.
+package analytics
+
+import (
+	"errors"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/go-lol/lol"
+)
+
+// ErrNoTimeline is returned if a match has no timeline.
+var ErrNoTimeline = errors.New("analytics: match has no timeline")
+
+// Point is a value of a frame.
+type Point struct {
+	// Time since the game started.
+	Time time.Duration
+	// Total gold earned.
+	Gold int32
+	XP   int32
+	// Minions and jungle monsters killed.
+	CS    int32
+	Level int32
+}
+
+// Series is points of each frame in chronological order.
+type Series []Point
+
+// At returns the point nearest to t, or the earlier one if two points are equally near.
+// Frames are taken a few milliseconds after each interval, e.g. at 600047 ms for 10 minutes,
+// so the point at or before t would be a frame behind.
+func (s Series) At(t time.Duration) (Point, bool) {
+	i := sort.Search(len(s), func(i int) bool { return s[i].Time >= t })
+	switch {
+	case len(s) == 0:
+		return Point{}, false
+	case i == len(s):
+		return s[i-1], true
+	case i > 0 && t-s[i-1].Time <= s[i].Time-t:
+		return s[i-1], true
+	}
+	return s[i], true
+}
+
+// ParticipantSeries returns series of each participant by participant id.
+func ParticipantSeries(m *lol.MatchDetail) (map[int32]Series, error) {
+	if m.Timeline == nil {
+		return nil, ErrNoTimeline
+	}
+
+	series := make(map[int32]Series)
+	for _, f := range m.Timeline.Frames {
+		t := f.Timestamp.Duration()
+		for key, pf := range f.ParticipantFrames {
+			id := pf.ParticipantID
+			if id == 0 {
+				n, _ := strconv.Atoi(key)
+				id = int32(n)
+			}
+			series[id] = append(series[id], Point{
+				Time:  t,
+				Gold:  pf.TotalGold,
+				XP:    pf.Xp,
+				CS:    pf.MinionsKilled + pf.JungleMinionsKilled,
+				Level: pf.Level,
+			})
+		}
+	}
+	return series, nil
+}
+
+// TeamSeries returns series of each team by team id.
+// Gold, XP and CS are sums of participants, and Level is the average.
+func TeamSeries(m *lol.MatchDetail) (map[int32]Series, error) {
+	ps, err := ParticipantSeries(m)
+	if err != nil {
+		return nil, err
+	}
+
+	type sum struct {
+		Point
+		n int32
+	}
+	teams := make(map[int32][]sum)
+	for _, p := range m.Participants {
+		for i, pt := range ps[p.ParticipantID] {
+			s := teams[p.TeamID]
+			if i == len(s) {
+				s = append(s, sum{Point: Point{Time: pt.Time}})
+			}
+			s[i].Gold += pt.Gold
+			s[i].XP += pt.XP
+			s[i].CS += pt.CS
+			s[i].Level += pt.Level
+			s[i].n++
+			teams[p.TeamID] = s
+		}
+	}
+
+	series := make(map[int32]Series)
+	for team, sums := range teams {
+		s := make(Series, len(sums))
+		for i, sum := range sums {
+			s[i] = sum.Point
+			s[i].Level /= sum.n
+		}
+		series[team] = s
+	}
+	return series, nil
+}
+
+// Lead is a gold lead of a team.
+type Lead struct {
+	Time time.Duration
+	// Negative if behind.
+	Gold int32
+}
+
+// GoldLead returns the gold lead of team 100 (blue) over team 200 (red) for each frame.
+func GoldLead(m *lol.MatchDetail) ([]Lead, error) {
+	ts, err := TeamSeries(m)
+	if err != nil {
+		return nil, err
+	}
+
+	blue, red := ts[100], ts[200]
+	lead := make([]Lead, 0, len(blue))
+	for i := 0; i < len(blue) && i < len(red); i++ {
+		lead = append(lead, Lead{Time: blue[i].Time, Gold: blue[i].Gold - red[i].Gold})
+	}
+	return lead, nil
+}
+
+// LaneDiff is a difference between lane opponents at a time.
+type LaneDiff struct {
+	// ParticipantTimeline.Lane and Role. e.g. "BOTTOM", "DUO_CARRY"
+	Lane, Role string
+
+	ParticipantID, OpponentID int32
+	At                        time.Duration
+
+	// Participant minus opponent.
+	Gold, XP, CS int32
+}
+
+// DefaultLaneDiffTimes are times used by LaneDiffs if not given.
+var DefaultLaneDiffTimes = []time.Duration{10 * time.Minute, 15 * time.Minute, 20 * time.Minute}
+
+// LaneDiffs returns differences of participants in team 100 against
+// opponents with the same lane and role, at each time.
+// Participants without a single opponent are skipped,
+// and times without a frame within half a frame interval, like after the game ended, are skipped.
+func LaneDiffs(m *lol.MatchDetail, at ...time.Duration) ([]LaneDiff, error) {
+	ps, err := ParticipantSeries(m)
+	if err != nil {
+		return nil, err
+	}
+	if len(at) == 0 {
+		at = DefaultLaneDiffTimes
+	}
+
+	var diffs []LaneDiff
+	v := lol.NewMatchView(m)
+	for _, p := range v.TeamParticipants(100) {
+		opponent := v.Opponent(p.ParticipantID)
+		if opponent == nil {
+			continue
+		}
+
+		for _, t := range at {
+			a, ok1 := ps[p.ParticipantID].At(t)
+			b, ok2 := ps[opponent.ParticipantID].At(t)
+			if !ok1 || !ok2 || abs(a.Time-t) > frameInterval(m)/2 {
+				continue
+			}
+			diffs = append(diffs, LaneDiff{
+				Lane: p.Lane(), Role: p.Role(),
+				ParticipantID: p.ParticipantID, OpponentID: opponent.ParticipantID,
+				At:   t,
+				Gold: a.Gold - b.Gold, XP: a.XP - b.XP, CS: a.CS - b.CS,
+			})
+		}
+	}
+	return diffs, nil
+}
+
+func frameInterval(m *lol.MatchDetail) time.Duration {
+	if m.Timeline.FrameInterval == 0 {
+		return time.Minute
+	}
+	return m.Timeline.FrameInterval.Duration()
+}
+
+func abs(d time.Duration) time.Duration {
+	if d < 0 {
+		return -d
+	}
+	return d
+}
@@ -0,0 +1,138 @@
+package analytics
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/go-lol/lol"
+)
+
+func frame(minute int64, frames ...*lol.ParticipantFrame) *lol.Frame {
+	f := &lol.Frame{Timestamp: lol.Millis(minute * 60000), ParticipantFrames: make(map[string]*lol.ParticipantFrame)}
+	for _, pf := range frames {
+		f.ParticipantFrames[itoa(pf.ParticipantID)] = pf
+	}
+	return f
+}
+
+// newTestMatch returns a 15 minutes match of mid laners, 1 (team 100) and 2 (team 200).
+func newTestMatch() *lol.MatchDetail {
+	m := &lol.MatchDetail{
+		Participants: []*lol.MatchParticipant{
+			{ParticipantID: 1, TeamID: 100, Timeline: &lol.ParticipantTimeline{Lane: "MIDDLE", Role: "SOLO"}},
+			{ParticipantID: 2, TeamID: 200, Timeline: &lol.ParticipantTimeline{Lane: "MIDDLE", Role: "SOLO"}},
+		},
+		Timeline: &lol.Timeline{FrameInterval: 60000},
+	}
+	for i := int64(0); i <= 15; i++ {
+		m.Timeline.Frames = append(m.Timeline.Frames, frame(i,
+			&lol.ParticipantFrame{ParticipantID: 1, TotalGold: int32(500 + 400*i), Xp: int32(300 * i), MinionsKilled: int32(8 * i), Level: 1},
+			&lol.ParticipantFrame{ParticipantID: 2, TotalGold: int32(500 + 300*i), Xp: int32(250 * i), MinionsKilled: int32(6 * i), JungleMinionsKilled: 1, Level: 2},
+		))
+	}
+	return m
+}
+
+func TestSeries(t *testing.T) {
+	m := newTestMatch()
+
+	ps, err := ParticipantSeries(m)
+	if err != nil {
+		t.Fatal(err)
+		return
+	}
+	if p, ok := ps[2].At(90 * time.Second); !ok || p.Time != time.Minute || p.Gold != 800 || p.CS != 7 {
+		t.Errorf("Invalid point %+v", p)
+	}
+	if p, ok := ps[2].At(119 * time.Second); !ok || p.Time != 2*time.Minute {
+		t.Errorf("Expected the point at 2 minutes, got %+v", p)
+	}
+	if p, ok := ps[2].At(time.Hour); !ok || p.Time != 15*time.Minute {
+		t.Errorf("Expected the last point, got %+v", p)
+	}
+	if _, ok := (Series{}).At(0); ok {
+		t.Error("Expected no point of an empty series")
+	}
+
+	ts, err := TeamSeries(m)
+	if err != nil {
+		t.Fatal(err)
+		return
+	}
+	if p := ts[100][10]; p.Gold != 4500 || p.Level != 1 {
+		t.Errorf("Invalid team point %+v", p)
+	}
+
+	lead, err := GoldLead(m)
+	if err != nil {
+		t.Fatal(err)
+		return
+	}
+	if len(lead) != 16 || lead[10].Gold != 1000 {
+		t.Errorf("Invalid gold lead %v", lead)
+	}
+
+	if _, err := ParticipantSeries(&lol.MatchDetail{}); err != ErrNoTimeline {
+		t.Errorf("Expected ErrNoTimeline, got %v", err)
+	}
+}
+
+func TestLaneDiffs(t *testing.T) {
+	diffs, err := LaneDiffs(newTestMatch())
+	if err != nil {
+		t.Fatal(err)
+		return
+	}
+
+	// No diff at 20 minutes.
+	if len(diffs) != 2 {
+		t.Fatalf("Invalid diffs %+v", diffs)
+		return
+	}
+	if d := diffs[1]; d.At != 15*time.Minute || d.OpponentID != 2 || d.Gold != 1500 || d.XP != 750 || d.CS != 29 {
+		t.Errorf("Invalid diff %+v", d)
+	}
+
+	// Frames are taken a few milliseconds after each minute.
+	m := newTestMatch()
+	for _, f := range m.Timeline.Frames {
+		f.Timestamp += 47
+	}
+	offDiffs, err := LaneDiffs(m)
+	if err != nil {
+		t.Fatal(err)
+		return
+	}
+	if len(offDiffs) != 2 || offDiffs[0].Gold != 1000 || offDiffs[1].Gold != 1500 {
+		t.Errorf("Invalid diffs of off-minute frames %+v", offDiffs)
+	}
+
+	var buf bytes.Buffer
+	if err := WriteLaneDiffsCSV(&buf, diffs); err != nil {
+		t.Fatal(err)
+		return
+	}
+	want := "lane,role,participant,opponent,seconds,gold_diff,xp_diff,cs_diff\n" +
+		"MIDDLE,SOLO,1,2,600,1000,500,19\n" +
+		"MIDDLE,SOLO,1,2,900,1500,750,29\n"
+	if buf.String() != want {
+		t.Errorf("Expected %q, got %q", want, buf.String())
+	}
+}
+
+func TestWriteCSV(t *testing.T) {
+	var buf bytes.Buffer
+	err := WriteCSV(&buf, "team", map[int32]Series{
+		200: {{Time: time.Minute, Gold: 2500, XP: 100, CS: 3, Level: 1}},
+		100: {{Time: 0, Gold: 2500}},
+	})
+	if err != nil {
+		t.Fatal(err)
+		return
+	}
+	want := "team,seconds,gold,xp,cs,level\n100,0,2500,0,0,0\n200,60,2500,100,3,1\n"
+	if buf.String() != want {
+		t.Errorf("Expected %q, got %q", want, buf.String())
+	}
+}
@@ -0,0 +1,68 @@
+package analytics
+
+import (
+	"encoding/csv"
+	"io"
+	"sort"
+	"strconv"
+	"time"
+)
+
+// WriteCSV writes series sorted by id and time, with a header:
+//
+//	<idName>,seconds,gold,xp,cs,level
+//
+// idName is like "participant" or "team".
+func WriteCSV(w io.Writer, idName string, series map[int32]Series) error {
+	ids := make([]int, 0, len(series))
+	for id := range series {
+		ids = append(ids, int(id))
+	}
+	sort.Ints(ids)
+
+	cw := csv.NewWriter(w)
+	cw.Write([]string{idName, "seconds", "gold", "xp", "cs", "level"})
+	for _, id := range ids {
+		for _, p := range series[int32(id)] {
+			cw.Write([]string{
+				strconv.Itoa(id), seconds(p.Time),
+				itoa(p.Gold), itoa(p.XP), itoa(p.CS), itoa(p.Level),
+			})
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+// WriteGoldLeadCSV writes gold lead with a header:
+//
+//	seconds,gold_lead
+func WriteGoldLeadCSV(w io.Writer, lead []Lead) error {
+	cw := csv.NewWriter(w)
+	cw.Write([]string{"seconds", "gold_lead"})
+	for _, l := range lead {
+		cw.Write([]string{seconds(l.Time), itoa(l.Gold)})
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+// WriteLaneDiffsCSV writes lane differences with a header:
+//
+//	lane,role,participant,opponent,seconds,gold_diff,xp_diff,cs_diff
+func WriteLaneDiffsCSV(w io.Writer, diffs []LaneDiff) error {
+	cw := csv.NewWriter(w)
+	cw.Write([]string{"lane", "role", "participant", "opponent", "seconds", "gold_diff", "xp_diff", "cs_diff"})
+	for _, d := range diffs {
+		cw.Write([]string{
+			d.Lane, d.Role, itoa(d.ParticipantID), itoa(d.OpponentID), seconds(d.At),
+			itoa(d.Gold), itoa(d.XP), itoa(d.CS),
+		})
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+func itoa(v int32) string { return strconv.FormatInt(int64(v), 10) }
+
+func seconds(d time.Duration) string { return strconv.FormatInt(int64(d/time.Second), 10) }
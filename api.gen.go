@@ -1,4968 +0,0 @@
-// Generated by go-lol-generator. DO NOT EDIT.
-
-package lol
-
-import "encoding/json"
-import "io"
-import "strconv"
-import "net/http"
-import "net/url"
-
-import "golang.org/x/net/context"
-import "github.com/go-lol/lol/uritemplates"
-
-var _ = json.Marshal
-var _ = io.EOF
-
-const (
-	// Global is a service area of league of legends.
-	Global Region = 1
-	// PBE is a service area of league of legends.
-	PBE Region = 2
-	// NA is a service area of league of legends.
-	NA Region = 10
-	// EUW is a service area of league of legends.
-	EUW Region = 11
-	// EUNE is a service area of league of legends.
-	EUNE Region = 12
-	// KR is a service area of league of legends.
-	KR Region = 13
-	// BR is a service area of league of legends.
-	BR Region = 14
-	// TR is a service area of league of legends.
-	TR Region = 15
-	// RU is a service area of league of legends.
-	RU Region = 16
-	// LAS is a service area of league of legends.
-	LAS Region = 17
-	// LAN is a service area of league of legends.
-	LAN Region = 18
-	// OCE is a service area of league of legends.
-	OCE Region = 19
-)
-
-var regions = []Region{
-	PBE,
-	NA,
-	EUW,
-	EUNE,
-	KR,
-	BR,
-	TR,
-	RU,
-	LAS,
-	LAN,
-	OCE,
-}
-
-var regionByName = map[string]Region{
-	"global": Global,
-	"pbe":    PBE,
-	"na":     NA,
-	"euw":    EUW,
-	"eune":   EUNE,
-	"kr":     KR,
-	"br":     BR,
-	"tr":     TR,
-	"ru":     RU,
-	"las":    LAS,
-	"lan":    LAN,
-	"oce":    OCE,
-}
-
-var regionByPlatformID = map[string]Region{
-	"pbe":  PBE,
-	"na1":  NA,
-	"euw1": EUW,
-	"eun1": EUNE,
-	"kr":   KR,
-	"br1":  BR,
-	"tr1":  TR,
-	"ru":   RU,
-	"la2":  LAS,
-	"la1":  LAN,
-	"oc1":  OCE,
-}
-
-var nameByRegion = map[Region]string{
-	Global: "global",
-	PBE:    "pbe",
-	NA:     "na",
-	EUW:    "euw",
-	EUNE:   "eune",
-	KR:     "kr",
-	BR:     "br",
-	TR:     "tr",
-	RU:     "ru",
-	LAS:    "las",
-	LAN:    "lan",
-	OCE:    "oce",
-}
-
-var platformIDByRegion = map[Region]string{
-	Global: "",
-	PBE:    "pbe",
-	NA:     "na1",
-	EUW:    "euw1",
-	EUNE:   "eun1",
-	KR:     "kr",
-	BR:     "br1",
-	TR:     "tr1",
-	RU:     "ru",
-	LAS:    "la2",
-	LAN:    "la1",
-	OCE:    "oc1",
-}
-
-var hostByRegion = map[Region]string{
-	Global: "global.api.pvp.net",
-	PBE:    "pbe.api.pvp.net",
-	NA:     "na.api.pvp.net",
-	EUW:    "euw.api.pvp.net",
-	EUNE:   "eune.api.pvp.net",
-	KR:     "kr.api.pvp.net",
-	BR:     "br.api.pvp.net",
-	TR:     "tr.api.pvp.net",
-	RU:     "ru.api.pvp.net",
-	LAS:    "las.api.pvp.net",
-	LAN:    "lan.api.pvp.net",
-	OCE:    "oce.api.pvp.net",
-}
-
-// RealmDto - This object contains realm data.
-//
-// resource: "lol-static-data", original name: "RealmDto"
-type Realm struct {
-	// The base CDN url.
-	Cdn string `json:"cdn,omitempty"`
-	// Latest changed version of Dragon Magic's css file.
-	CSS string `json:"css,omitempty"`
-	// Latest changed version of Dragon Magic.
-	Dd string `json:"dd,omitempty"`
-	// Default language for this realm.
-	L string `json:"l,omitempty"`
-	// Legacy script mode for IE6 or older.
-	Lg string `json:"lg,omitempty"`
-	// Latest changed version for each data type listed.
-	N map[string]string `json:"n,omitempty"`
-	// Special behavior number identifying the largest profileicon id that can be used under 500. Any profileicon that is requested between this number and 500 should be mapped to 0.
-	Profileiconmax int32 `json:"profileiconmax,omitempty"`
-	// Additional api data drawn from other sources that may be related to data dragon functionality.
-	Store string `json:"store,omitempty"`
-	// Current version of this file for this realm.
-	V string `json:"v,omitempty"`
-}
-
-// Event - This object contains game event information. Note that not all legal type values documented below are valid for all games. Event data evolves over time and certain values may be relevant only for older or newer games.
-//
-// resource: "match", original name: "Event"
-type Event struct {
-	// The ascended type of the event. Only present if relevant. Note that CLEAR_ASCENDED refers to when a participants kills the ascended player. (Legal values: CHAMPION_ASCENDED, CLEAR_ASCENDED, MINION_ASCENDED)
-	AscendedType string `json:"ascendedType,omitempty"`
-	// The assisting participant IDs of the event. Only present if relevant.
-	AssistingParticipantIds []int32 `json:"assistingParticipantIds,omitempty"`
-	// The building type of the event. Only present if relevant. (Legal values: INHIBITOR_BUILDING, TOWER_BUILDING)
-	BuildingType string `json:"buildingType,omitempty"`
-	// The creator ID of the event. Only present if relevant.
-	CreatorID int32 `json:"creatorId,omitempty"`
-	// Event type. (Legal values: ASCENDED_EVENT, BUILDING_KILL, CAPTURE_POINT, CHAMPION_KILL, ELITE_MONSTER_KILL, ITEM_DESTROYED, ITEM_PURCHASED, ITEM_SOLD, ITEM_UNDO, PORO_KING_SUMMON, SKILL_LEVEL_UP, WARD_KILL, WARD_PLACED)
-	EventType string `json:"eventType,omitempty"`
-	// The ending item ID of the event. Only present if relevant.
-	ItemAfter int32 `json:"itemAfter,omitempty"`
-	// The starting item ID of the event. Only present if relevant.
-	ItemBefore int32 `json:"itemBefore,omitempty"`
-	// The item ID of the event. Only present if relevant.
-	ItemID int32 `json:"itemId,omitempty"`
-	// The killer ID of the event. Only present if relevant. Killer ID 0 indicates a minion.
-	KillerID int32 `json:"killerId,omitempty"`
-	// The lane type of the event. Only present if relevant. (Legal values: BOT_LANE, MID_LANE, TOP_LANE)
-	LaneType string `json:"laneType,omitempty"`
-	// The level up type of the event. Only present if relevant. (Legal values: EVOLVE, NORMAL)
-	LevelUpType string `json:"levelUpType,omitempty"`
-	// The monster type of the event. Only present if relevant. (Legal values: BARON_NASHOR, BLUE_GOLEM, DRAGON, RED_LIZARD, RIFTHERALD, VILEMAW)
-	MonsterType string `json:"monsterType,omitempty"`
-	// The participant ID of the event. Only present if relevant.
-	ParticipantID int32 `json:"participantId,omitempty"`
-	// The point captured in the event. Only present if relevant. (Legal values: POINT_A, POINT_B, POINT_C, POINT_D, POINT_E)
-	PointCaptured string `json:"pointCaptured,omitempty"`
-	// The position of the event. Only present if relevant.
-	Position *Position `json:"position,omitempty"`
-	// The skill slot of the event. Only present if relevant.
-	SkillSlot int32 `json:"skillSlot,omitempty"`
-	// The team ID of the event. Only present if relevant.
-	TeamID int32 `json:"teamId,omitempty"`
-	// Represents how many milliseconds into the game the event occurred.
-	Timestamp int64 `json:"timestamp,omitempty"`
-	// The tower type of the event. Only present if relevant. (Legal values: BASE_TURRET, FOUNTAIN_TURRET, INNER_TURRET, NEXUS_TURRET, OUTER_TURRET, UNDEFINED_TURRET)
-	TowerType string `json:"towerType,omitempty"`
-	// The victim ID of the event. Only present if relevant.
-	VictimID int32 `json:"victimId,omitempty"`
-	// The ward type of the event. Only present if relevant. (Legal values: SIGHT_WARD, TEEMO_MUSHROOM, UNDEFINED, VISION_WARD, YELLOW_TRINKET, YELLOW_TRINKET_UPGRADE)
-	WardType string `json:"wardType,omitempty"`
-}
-
-// ItemDto - This object contains item data.
-//
-// resource: "lol-static-data", original name: "ItemDto"
-type Item struct {
-	Colloq        string            `json:"colloq,omitempty"`
-	ConsumeOnFull bool              `json:"consumeOnFull,omitempty"`
-	Consumed      bool              `json:"consumed,omitempty"`
-	Depth         int32             `json:"depth,omitempty"`
-	Description   string            `json:"description,omitempty"`
-	Effect        map[string]string `json:"effect,omitempty"`
-	From          []string          `json:"from,omitempty"`
-	// Data Dragon includes the gold field for basic data, which is shared by both rune and item. However, only items have a gold field on them, representing their gold cost in the store. Since runes are not sold in the store, they have no gold cost.
-	Gold                 *Gold           `json:"gold,omitempty"`
-	Group                string          `json:"group,omitempty"`
-	HideFromAll          bool            `json:"hideFromAll,omitempty"`
-	ID                   int32           `json:"id,omitempty"`
-	Image                *Image          `json:"image,omitempty"`
-	InStore              bool            `json:"inStore,omitempty"`
-	Into                 []string        `json:"into,omitempty"`
-	Maps                 map[string]bool `json:"maps,omitempty"`
-	Name                 string          `json:"name,omitempty"`
-	Plaintext            string          `json:"plaintext,omitempty"`
-	RequiredChampion     string          `json:"requiredChampion,omitempty"`
-	Rune                 *MetaData       `json:"rune,omitempty"`
-	SanitizedDescription string          `json:"sanitizedDescription,omitempty"`
-	SpecialRecipe        int32           `json:"specialRecipe,omitempty"`
-	Stacks               int32           `json:"stacks,omitempty"`
-	Stats                *BasicDataStats `json:"stats,omitempty"`
-	Tags                 []string        `json:"tags,omitempty"`
-}
-
-// LeagueEntryDto - This object contains league participant information representing a summoner or team.
-//
-// resource: "league", original name: "LeagueEntryDto"
-type LeagueEntry struct {
-	// The league division of the participant.
-	Division string `json:"division,omitempty"`
-	// Specifies if the participant is fresh blood.
-	IsFreshBlood bool `json:"isFreshBlood,omitempty"`
-	// Specifies if the participant is on a hot streak.
-	IsHotStreak bool `json:"isHotStreak,omitempty"`
-	// Specifies if the participant is inactive.
-	IsInactive bool `json:"isInactive,omitempty"`
-	// Specifies if the participant is a veteran.
-	IsVeteran bool `json:"isVeteran,omitempty"`
-	// The league points of the participant.
-	LeaguePoints int32 `json:"leaguePoints,omitempty"`
-	// The number of losses for the participant.
-	Losses int32 `json:"losses,omitempty"`
-	// Mini series data for the participant. Only present if the participant is currently in a mini series.
-	MiniSeries *MiniSeries `json:"miniSeries,omitempty"`
-	// The ID of the participant (i.e., summoner or team) represented by this entry.
-	PlayerOrTeamID string `json:"playerOrTeamId,omitempty"`
-	// The name of the the participant (i.e., summoner or team) represented by this entry.
-	PlayerOrTeamName string `json:"playerOrTeamName,omitempty"`
-	// The number of wins for the participant.
-	Wins int32 `json:"wins,omitempty"`
-}
-
-// ParticipantTimelineData - This object contains timeline data
-//
-// resource: "match", original name: "ParticipantTimelineData"
-type ParticipantTimelineData struct {
-	// Value per minute from 10 min to 20 min
-	TenToTwenty float64 `json:"tenToTwenty,omitempty"`
-	// Value per minute from 30 min to the end of the game
-	ThirtyToEnd float64 `json:"thirtyToEnd,omitempty"`
-	// Value per minute from 20 min to 30 min
-	TwentyToThirty float64 `json:"twentyToThirty,omitempty"`
-	// Value per minute from the beginning of the game to 10 min
-	ZeroToTen float64 `json:"zeroToTen,omitempty"`
-}
-
-// ParticipantStats - This object contains participant statistics information
-//
-// resource: "match", original name: "ParticipantStats"
-type ParticipantStats struct {
-	// Number of assists
-	Assists int64 `json:"assists,omitempty"`
-	// Champion level achieved
-	ChampLevel int64 `json:"champLevel,omitempty"`
-	// If game was a dominion game, player's combat score, otherwise 0
-	CombatPlayerScore int64 `json:"combatPlayerScore,omitempty"`
-	// Number of deaths
-	Deaths int64 `json:"deaths,omitempty"`
-	// Number of double kills
-	DoubleKills int64 `json:"doubleKills,omitempty"`
-	// Flag indicating if participant got an assist on first blood
-	FirstBloodAssist bool `json:"firstBloodAssist,omitempty"`
-	// Flag indicating if participant got first blood
-	FirstBloodKill bool `json:"firstBloodKill,omitempty"`
-	// Flag indicating if participant got an assist on the first inhibitor
-	FirstInhibitorAssist bool `json:"firstInhibitorAssist,omitempty"`
-	// Flag indicating if participant destroyed the first inhibitor
-	FirstInhibitorKill bool `json:"firstInhibitorKill,omitempty"`
-	// Flag indicating if participant got an assist on the first tower
-	FirstTowerAssist bool `json:"firstTowerAssist,omitempty"`
-	// Flag indicating if participant destroyed the first tower
-	FirstTowerKill bool `json:"firstTowerKill,omitempty"`
-	// Gold earned
-	GoldEarned int64 `json:"goldEarned,omitempty"`
-	// Gold spent
-	GoldSpent int64 `json:"goldSpent,omitempty"`
-	// Number of inhibitor kills
-	InhibitorKills int64 `json:"inhibitorKills,omitempty"`
-	// First item ID
-	Item0 int64 `json:"item0,omitempty"`
-	// Second item ID
-	Item1 int64 `json:"item1,omitempty"`
-	// Third item ID
-	Item2 int64 `json:"item2,omitempty"`
-	// Fourth item ID
-	Item3 int64 `json:"item3,omitempty"`
-	// Fifth item ID
-	Item4 int64 `json:"item4,omitempty"`
-	// Sixth item ID
-	Item5 int64 `json:"item5,omitempty"`
-	// Seventh item ID
-	Item6 int64 `json:"item6,omitempty"`
-	// Number of killing sprees
-	KillingSprees int64 `json:"killingSprees,omitempty"`
-	// Number of kills
-	Kills int64 `json:"kills,omitempty"`
-	// Largest critical strike
-	LargestCriticalStrike int64 `json:"largestCriticalStrike,omitempty"`
-	// Largest killing spree
-	LargestKillingSpree int64 `json:"largestKillingSpree,omitempty"`
-	// Largest multi kill
-	LargestMultiKill int64 `json:"largestMultiKill,omitempty"`
-	// Magical damage dealt
-	MagicDamageDealt int64 `json:"magicDamageDealt,omitempty"`
-	// Magical damage dealt to champions
-	MagicDamageDealtToChampions int64 `json:"magicDamageDealtToChampions,omitempty"`
-	// Magic damage taken
-	MagicDamageTaken int64 `json:"magicDamageTaken,omitempty"`
-	// Minions killed
-	MinionsKilled int64 `json:"minionsKilled,omitempty"`
-	// Neutral minions killed
-	NeutralMinionsKilled int64 `json:"neutralMinionsKilled,omitempty"`
-	// Neutral jungle minions killed in the enemy team's jungle
-	NeutralMinionsKilledEnemyJungle int64 `json:"neutralMinionsKilledEnemyJungle,omitempty"`
-	// Neutral jungle minions killed in your team's jungle
-	NeutralMinionsKilledTeamJungle int64 `json:"neutralMinionsKilledTeamJungle,omitempty"`
-	// If game was a dominion game, number of node captures
-	NodeCapture int64 `json:"nodeCapture,omitempty"`
-	// If game was a dominion game, number of node capture assists
-	NodeCaptureAssist int64 `json:"nodeCaptureAssist,omitempty"`
-	// If game was a dominion game, number of node neutralizations
-	NodeNeutralize int64 `json:"nodeNeutralize,omitempty"`
-	// If game was a dominion game, number of node neutralization assists
-	NodeNeutralizeAssist int64 `json:"nodeNeutralizeAssist,omitempty"`
-	// If game was a dominion game, player's objectives score, otherwise 0
-	ObjectivePlayerScore int64 `json:"objectivePlayerScore,omitempty"`
-	// Number of penta kills
-	PentaKills int64 `json:"pentaKills,omitempty"`
-	// Physical damage dealt
-	PhysicalDamageDealt int64 `json:"physicalDamageDealt,omitempty"`
-	// Physical damage dealt to champions
-	PhysicalDamageDealtToChampions int64 `json:"physicalDamageDealtToChampions,omitempty"`
-	// Physical damage taken
-	PhysicalDamageTaken int64 `json:"physicalDamageTaken,omitempty"`
-	// Number of quadra kills
-	QuadraKills int64 `json:"quadraKills,omitempty"`
-	// Sight wards purchased
-	SightWardsBoughtInGame int64 `json:"sightWardsBoughtInGame,omitempty"`
-	// If game was a dominion game, number of completed team objectives (i.e., quests)
-	TeamObjective int64 `json:"teamObjective,omitempty"`
-	// Total damage dealt
-	TotalDamageDealt int64 `json:"totalDamageDealt,omitempty"`
-	// Total damage dealt to champions
-	TotalDamageDealtToChampions int64 `json:"totalDamageDealtToChampions,omitempty"`
-	// Total damage taken
-	TotalDamageTaken int64 `json:"totalDamageTaken,omitempty"`
-	// Total heal amount
-	TotalHeal int64 `json:"totalHeal,omitempty"`
-	// If game was a dominion game, player's total score, otherwise 0
-	TotalPlayerScore int64 `json:"totalPlayerScore,omitempty"`
-	// If game was a dominion game, team rank of the player's total score (e.g., 1-5)
-	TotalScoreRank int64 `json:"totalScoreRank,omitempty"`
-	// Total dealt crowd control time
-	TotalTimeCrowdControlDealt int64 `json:"totalTimeCrowdControlDealt,omitempty"`
-	// Total units healed
-	TotalUnitsHealed int64 `json:"totalUnitsHealed,omitempty"`
-	// Number of tower kills
-	TowerKills int64 `json:"towerKills,omitempty"`
-	// Number of triple kills
-	TripleKills int64 `json:"tripleKills,omitempty"`
-	// True damage dealt
-	TrueDamageDealt int64 `json:"trueDamageDealt,omitempty"`
-	// True damage dealt to champions
-	TrueDamageDealtToChampions int64 `json:"trueDamageDealtToChampions,omitempty"`
-	// True damage taken
-	TrueDamageTaken int64 `json:"trueDamageTaken,omitempty"`
-	// Number of unreal kills
-	UnrealKills int64 `json:"unrealKills,omitempty"`
-	// Vision wards purchased
-	VisionWardsBoughtInGame int64 `json:"visionWardsBoughtInGame,omitempty"`
-	// Number of wards killed
-	WardsKilled int64 `json:"wardsKilled,omitempty"`
-	// Number of wards placed
-	WardsPlaced int64 `json:"wardsPlaced,omitempty"`
-	// Flag indicating whether or not the participant won
-	Winner bool `json:"winner,omitempty"`
-}
-
-// ParticipantIdentity - This object contains participant identity information
-//
-// resource: "match", original name: "ParticipantIdentity"
-type MatchParticipantIdentity struct {
-	// Participant ID
-	ParticipantID int32 `json:"participantId,omitempty"`
-	// Player information
-	Player *MatchPlayer `json:"player,omitempty"`
-}
-
-// LevelTipDto - This object contains champion level tip data.
-//
-// resource: "lol-static-data", original name: "LevelTipDto"
-type LevelTip struct {
-	Effect []string `json:"effect,omitempty"`
-	Label  []string `json:"label,omitempty"`
-}
-
-// MetaDataDto - This object contains meta data.
-//
-// resource: "lol-static-data", original name: "MetaDataDto"
-type MetaData struct {
-	IsRune bool   `json:"isRune,omitempty"`
-	Tier   string `json:"tier,omitempty"`
-	Type   string `json:"type,omitempty"`
-}
-
-// Translation
-//
-// resource: "lol-status", original name: "Translation"
-type Translation struct {
-	Content   string `json:"content,omitempty"`
-	Locale    string `json:"locale,omitempty"`
-	UpdatedAt string `json:"updated_at,omitempty"`
-}
-
-// ParticipantTimeline - This object contains all timeline information
-//
-// resource: "match", original name: "ParticipantTimeline"
-type ParticipantTimeline struct {
-	// Ancient golem assists per minute timeline counts
-	AncientGolemAssistsPerMinCounts *ParticipantTimelineData `json:"ancientGolemAssistsPerMinCounts,omitempty"`
-	// Ancient golem kills per minute timeline counts
-	AncientGolemKillsPerMinCounts *ParticipantTimelineData `json:"ancientGolemKillsPerMinCounts,omitempty"`
-	// Assisted lane deaths per minute timeline data
-	AssistedLaneDeathsPerMinDeltas *ParticipantTimelineData `json:"assistedLaneDeathsPerMinDeltas,omitempty"`
-	// Assisted lane kills per minute timeline data
-	AssistedLaneKillsPerMinDeltas *ParticipantTimelineData `json:"assistedLaneKillsPerMinDeltas,omitempty"`
-	// Baron assists per minute timeline counts
-	BaronAssistsPerMinCounts *ParticipantTimelineData `json:"baronAssistsPerMinCounts,omitempty"`
-	// Baron kills per minute timeline counts
-	BaronKillsPerMinCounts *ParticipantTimelineData `json:"baronKillsPerMinCounts,omitempty"`
-	// Creeps per minute timeline data
-	CreepsPerMinDeltas *ParticipantTimelineData `json:"creepsPerMinDeltas,omitempty"`
-	// Creep score difference per minute timeline data
-	CsDiffPerMinDeltas *ParticipantTimelineData `json:"csDiffPerMinDeltas,omitempty"`
-	// Damage taken difference per minute timeline data
-	DamageTakenDiffPerMinDeltas *ParticipantTimelineData `json:"damageTakenDiffPerMinDeltas,omitempty"`
-	// Damage taken per minute timeline data
-	DamageTakenPerMinDeltas *ParticipantTimelineData `json:"damageTakenPerMinDeltas,omitempty"`
-	// Dragon assists per minute timeline counts
-	DragonAssistsPerMinCounts *ParticipantTimelineData `json:"dragonAssistsPerMinCounts,omitempty"`
-	// Dragon kills per minute timeline counts
-	DragonKillsPerMinCounts *ParticipantTimelineData `json:"dragonKillsPerMinCounts,omitempty"`
-	// Elder lizard assists per minute timeline counts
-	ElderLizardAssistsPerMinCounts *ParticipantTimelineData `json:"elderLizardAssistsPerMinCounts,omitempty"`
-	// Elder lizard kills per minute timeline counts
-	ElderLizardKillsPerMinCounts *ParticipantTimelineData `json:"elderLizardKillsPerMinCounts,omitempty"`
-	// Gold per minute timeline data
-	GoldPerMinDeltas *ParticipantTimelineData `json:"goldPerMinDeltas,omitempty"`
-	// Inhibitor assists per minute timeline counts
-	InhibitorAssistsPerMinCounts *ParticipantTimelineData `json:"inhibitorAssistsPerMinCounts,omitempty"`
-	// Inhibitor kills per minute timeline counts
-	InhibitorKillsPerMinCounts *ParticipantTimelineData `json:"inhibitorKillsPerMinCounts,omitempty"`
-	// Participant's lane (Legal values: MID, MIDDLE, TOP, JUNGLE, BOT, BOTTOM)
-	Lane string `json:"lane,omitempty"`
-	// Participant's role (Legal values: DUO, NONE, SOLO, DUO_CARRY, DUO_SUPPORT)
-	Role string `json:"role,omitempty"`
-	// Tower assists per minute timeline counts
-	TowerAssistsPerMinCounts *ParticipantTimelineData `json:"towerAssistsPerMinCounts,omitempty"`
-	// Tower kills per minute timeline counts
-	TowerKillsPerMinCounts *ParticipantTimelineData `json:"towerKillsPerMinCounts,omitempty"`
-	// Tower kills per minute timeline data
-	TowerKillsPerMinDeltas *ParticipantTimelineData `json:"towerKillsPerMinDeltas,omitempty"`
-	// Vilemaw assists per minute timeline counts
-	VilemawAssistsPerMinCounts *ParticipantTimelineData `json:"vilemawAssistsPerMinCounts,omitempty"`
-	// Vilemaw kills per minute timeline counts
-	VilemawKillsPerMinCounts *ParticipantTimelineData `json:"vilemawKillsPerMinCounts,omitempty"`
-	// Wards placed per minute timeline data
-	WardsPerMinDeltas *ParticipantTimelineData `json:"wardsPerMinDeltas,omitempty"`
-	// Experience difference per minute timeline data
-	XpDiffPerMinDeltas *ParticipantTimelineData `json:"xpDiffPerMinDeltas,omitempty"`
-	// Experience per minute timeline data
-	XpPerMinDeltas *ParticipantTimelineData `json:"xpPerMinDeltas,omitempty"`
-}
-
-// Mastery - This object contains mastery information
-//
-// resource: "match", original name: "Mastery"
-type UsedMastery struct {
-	// Mastery ID
-	MasteryID int32 `json:"masteryId,omitempty"`
-	// Mastery rank
-	Rank int64 `json:"rank,omitempty"`
-}
-
-// MatchList - This object contains match list information
-//
-// resource: "matchlist", original name: "MatchList"
-type MatchList struct {
-	EndIndex   int32             `json:"endIndex,omitempty"`
-	Matches    []*MatchReference `json:"matches,omitempty"`
-	StartIndex int32             `json:"startIndex,omitempty"`
-	TotalGames int32             `json:"totalGames,omitempty"`
-}
-
-// RunePagesDto - This object contains rune pages information.
-//
-// resource: "summoner", original name: "RunePagesDto"
-type RunePages struct {
-	// Collection of rune pages associated with the summoner.
-	Pages []*RunePage `json:"pages,omitempty"`
-	// Summoner ID.
-	SummonerID int64 `json:"summonerId,omitempty"`
-}
-
-// MasteryPagesDto - This object contains masteries information.
-//
-// resource: "summoner", original name: "MasteryPagesDto"
-type MasteryPages struct {
-	// Collection of mastery pages associated with the summoner.
-	Pages []*MasteryPage `json:"pages,omitempty"`
-	// Summoner ID.
-	SummonerID int64 `json:"summonerId,omitempty"`
-}
-
-// MasteryTreeItemDto - This object contains mastery tree item data.
-//
-// resource: "lol-static-data", original name: "MasteryTreeItemDto"
-type MasteryTreeItem struct {
-	MasteryID int32  `json:"masteryId,omitempty"`
-	Prereq    string `json:"prereq,omitempty"`
-}
-
-// PlayerDto - This object contains player information.
-//
-// resource: "game", original name: "PlayerDto"
-type Player struct {
-	// Champion id associated with player.
-	ChampionID int32 `json:"championId,omitempty"`
-	// Summoner id associated with player.
-	SummonerID int64 `json:"summonerId,omitempty"`
-	// Team id associated with player.
-	TeamID int32 `json:"teamId,omitempty"`
-}
-
-// LeagueDto - This object contains league information.
-//
-// resource: "league", original name: "LeagueDto"
-type League struct {
-	// The requested league entries.
-	Entries []*LeagueEntry `json:"entries,omitempty"`
-	// This name is an internal place-holder name only. Display and localization of names in the game client are handled client-side.
-	Name string `json:"name,omitempty"`
-	// Specifies the relevant participant that is a member of this league (i.e., a requested summoner ID, a requested team ID, or the ID of a team to which one of the requested summoners belongs). Only present when full league is requested so that participant's entry can be identified. Not present when individual entry is requested.
-	ParticipantID string `json:"participantId,omitempty"`
-	// The league's queue type. (Legal values: RANKED_SOLO_5x5, RANKED_TEAM_3x3, RANKED_TEAM_5x5)
-	Queue string `json:"queue,omitempty"`
-	// The league's tier. (Legal values: CHALLENGER, MASTER, DIAMOND, PLATINUM, GOLD, SILVER, BRONZE)
-	Tier string `json:"tier,omitempty"`
-}
-
-// ItemListDto - This object contains item list data.
-//
-// resource: "lol-static-data", original name: "ItemListDto"
-type ItemList struct {
-	Basic   *BasicData       `json:"basic,omitempty"`
-	Data    map[string]*Item `json:"data,omitempty"`
-	Groups  []*Group         `json:"groups,omitempty"`
-	Tree    []*ItemTree      `json:"tree,omitempty"`
-	Type    string           `json:"type,omitempty"`
-	Version string           `json:"version,omitempty"`
-}
-
-// BlockItemDto - This object contains champion recommended block item data.
-//
-// resource: "lol-static-data", original name: "BlockItemDto"
-type BlockItem struct {
-	Count int32 `json:"count,omitempty"`
-	ID    int32 `json:"id,omitempty"`
-}
-
-// PassiveDto - This object contains champion passive data.
-//
-// resource: "lol-static-data", original name: "PassiveDto"
-type Passive struct {
-	Description          string `json:"description,omitempty"`
-	Image                *Image `json:"image,omitempty"`
-	Name                 string `json:"name,omitempty"`
-	SanitizedDescription string `json:"sanitizedDescription,omitempty"`
-}
-
-// ChampionListDto - This object contains a collection of champion information.
-//
-// resource: "champion", original name: "ChampionListDto"
-type ChampionList struct {
-	// The collection of champion information.
-	Champions []*Champion `json:"champions,omitempty"`
-}
-
-// CurrentGameInfo
-//
-// resource: "current-game", original name: "CurrentGameInfo"
-type CurrentGameInfo struct {
-	// Banned champion information
-	BannedChampions []*CurrentGameBannedChampion `json:"bannedChampions,omitempty"`
-	// The ID of the game
-	GameID int64 `json:"gameId,omitempty"`
-	// The amount of time in seconds that has passed since the game started
-	GameLength int64 `json:"gameLength,omitempty"`
-	// The game mode (Legal values: CLASSIC, ODIN, ARAM, TUTORIAL, ONEFORALL, ASCENSION, FIRSTBLOOD, KINGPORO)
-	GameMode string `json:"gameMode,omitempty"`
-	// The queue type (queue types are documented on the Game Constants page)
-	GameQueueConfigID int64 `json:"gameQueueConfigId,omitempty"`
-	// The game start time represented in epoch milliseconds
-	GameStartTime int64 `json:"gameStartTime,omitempty"`
-	// The game type (Legal values: CUSTOM_GAME, MATCHED_GAME, TUTORIAL_GAME)
-	GameType string `json:"gameType,omitempty"`
-	// The ID of the map
-	MapID int32 `json:"mapId,omitempty"`
-	// The observer information
-	Observers *CurrentGameObserver `json:"observers,omitempty"`
-	// The participant information
-	Participants []*CurrentGameParticipant `json:"participants,omitempty"`
-	// The ID of the platform on which the game is being played
-	PlatformID string `json:"platformId,omitempty"`
-}
-
-// Participant
-//
-// resource: "featured-games", original name: "Participant"
-type FeaturedGameParticipant struct {
-	// Flag indicating whether or not this participant is a bot
-	Bot bool `json:"bot,omitempty"`
-	// The ID of the champion played by this participant
-	ChampionID int32 `json:"championId,omitempty"`
-	// The ID of the profile icon used by this participant
-	ProfileIconID int32 `json:"profileIconId,omitempty"`
-	// The ID of the first summoner spell used by this participant
-	Spell1Id int32 `json:"spell1Id,omitempty"`
-	// The ID of the second summoner spell used by this participant
-	Spell2Id int32 `json:"spell2Id,omitempty"`
-	// The summoner name of this participant
-	SummonerName string `json:"summonerName,omitempty"`
-	// The team ID of this participant, indicating the participant's team
-	TeamID int64 `json:"teamId,omitempty"`
-}
-
-// BasicDataDto - This object contains basic data.
-//
-// resource: "lol-static-data", original name: "BasicDataDto"
-type BasicData struct {
-	Colloq        string   `json:"colloq,omitempty"`
-	ConsumeOnFull bool     `json:"consumeOnFull,omitempty"`
-	Consumed      bool     `json:"consumed,omitempty"`
-	Depth         int32    `json:"depth,omitempty"`
-	Description   string   `json:"description,omitempty"`
-	From          []string `json:"from,omitempty"`
-	// Data Dragon includes the gold field for basic data, which is shared by both rune and item. However, only items have a gold field on them, representing their gold cost in the store. Since runes are not sold in the store, they have no gold cost.
-	Gold                 *Gold           `json:"gold,omitempty"`
-	Group                string          `json:"group,omitempty"`
-	HideFromAll          bool            `json:"hideFromAll,omitempty"`
-	ID                   int32           `json:"id,omitempty"`
-	Image                *Image          `json:"image,omitempty"`
-	InStore              bool            `json:"inStore,omitempty"`
-	Into                 []string        `json:"into,omitempty"`
-	Maps                 map[string]bool `json:"maps,omitempty"`
-	Name                 string          `json:"name,omitempty"`
-	Plaintext            string          `json:"plaintext,omitempty"`
-	RequiredChampion     string          `json:"requiredChampion,omitempty"`
-	Rune                 *MetaData       `json:"rune,omitempty"`
-	SanitizedDescription string          `json:"sanitizedDescription,omitempty"`
-	SpecialRecipe        int32           `json:"specialRecipe,omitempty"`
-	Stacks               int32           `json:"stacks,omitempty"`
-	Stats                *BasicDataStats `json:"stats,omitempty"`
-	Tags                 []string        `json:"tags,omitempty"`
-}
-
-// MasteryTreeDto - This object contains mastery tree data.
-//
-// resource: "lol-static-data", original name: "MasteryTreeDto"
-type MasteryTree struct {
-	Cunning  []*MasteryTreeList `json:"Cunning,omitempty"`
-	Ferocity []*MasteryTreeList `json:"Ferocity,omitempty"`
-	Resolve  []*MasteryTreeList `json:"Resolve,omitempty"`
-}
-
-// Rune - This object contains rune information
-//
-// resource: "match", original name: "Rune"
-type UsedRune struct {
-	// Rune rank
-	Rank int64 `json:"rank,omitempty"`
-	// Rune ID
-	RuneID int32 `json:"runeId,omitempty"`
-}
-
-// Participant - This object contains match participant information
-//
-// resource: "match", original name: "Participant"
-type MatchParticipant struct {
-	// Champion ID
-	ChampionID int32 `json:"championId,omitempty"`
-	// Highest ranked tier achieved for the previous season, if any, otherwise null. Used to display border in game loading screen. (Legal values: CHALLENGER, MASTER, DIAMOND, PLATINUM, GOLD, SILVER, BRONZE, UNRANKED)
-	HighestAchievedSeasonTier string `json:"highestAchievedSeasonTier,omitempty"`
-	// List of mastery information
-	Masteries []*UsedMastery `json:"masteries,omitempty"`
-	// Participant ID
-	ParticipantID int32 `json:"participantId,omitempty"`
-	// List of rune information
-	Runes []*UsedRune `json:"runes,omitempty"`
-	// First summoner spell ID
-	Spell1Id int32 `json:"spell1Id,omitempty"`
-	// Second summoner spell ID
-	Spell2Id int32 `json:"spell2Id,omitempty"`
-	// Participant statistics
-	Stats *ParticipantStats `json:"stats,omitempty"`
-	// Team ID
-	TeamID int32 `json:"teamId,omitempty"`
-	// Timeline data. Delta fields refer to values for the specified period (e.g., the gold per minute over the first 10 minutes of the game versus the second 20 minutes of the game. Diffs fields refer to the deltas versus the calculated lane opponent(s).
-	Timeline *ParticipantTimeline `json:"timeline,omitempty"`
-}
-
-// SummonerDto - This object contains summoner information.
-//
-// resource: "summoner", original name: "SummonerDto"
-type Summoner struct {
-	// Summoner ID.
-	ID int64 `json:"id,omitempty"`
-	// Summoner name.
-	Name string `json:"name,omitempty"`
-	// ID of the summoner icon associated with the summoner.
-	ProfileIconID int32 `json:"profileIconId,omitempty"`
-	// Date summoner was last modified specified as epoch milliseconds. The following events will update this timestamp: profile icon change, playing the tutorial or advanced tutorial, finishing a game, summoner name change
-	RevisionDate int64 `json:"revisionDate,omitempty"`
-	// Summoner level associated with the summoner.
-	SummonerLevel int64 `json:"summonerLevel,omitempty"`
-}
-
-// TeamMemberInfoDto - This object contains team member information.
-//
-// resource: "team", original name: "TeamMemberInfoDto"
-type RankTeamMemberInfo struct {
-	// Date that team member was invited to team specified as epoch milliseconds.
-	InviteDate int64 `json:"inviteDate,omitempty"`
-	// Date that team member joined team specified as epoch milliseconds.
-	JoinDate int64  `json:"joinDate,omitempty"`
-	PlayerID int64  `json:"playerId,omitempty"`
-	Status   string `json:"status,omitempty"`
-}
-
-// MatchHistorySummaryDto - This object contains match history summary information.
-//
-// resource: "team", original name: "MatchHistorySummaryDto"
-type MatchHistorySummary struct {
-	Assists int32 `json:"assists,omitempty"`
-	// Date that match was completed specified as epoch milliseconds.
-	Date              int64  `json:"date,omitempty"`
-	Deaths            int32  `json:"deaths,omitempty"`
-	GameID            int64  `json:"gameId,omitempty"`
-	GameMode          string `json:"gameMode,omitempty"`
-	Invalid           bool   `json:"invalid,omitempty"`
-	Kills             int32  `json:"kills,omitempty"`
-	MapID             int32  `json:"mapId,omitempty"`
-	OpposingTeamKills int32  `json:"opposingTeamKills,omitempty"`
-	OpposingTeamName  string `json:"opposingTeamName,omitempty"`
-	Win               bool   `json:"win,omitempty"`
-}
-
-// MiniSeriesDto - This object contains mini series information.
-//
-// resource: "league", original name: "MiniSeriesDto"
-type MiniSeries struct {
-	// Number of current losses in the mini series.
-	Losses int32 `json:"losses,omitempty"`
-	// String showing the current, sequential mini series progress where 'W' represents a win, 'L' represents a loss, and 'N' represents a game that hasn't been played yet.
-	Progress string `json:"progress,omitempty"`
-	// Number of wins required for promotion.
-	Target int32 `json:"target,omitempty"`
-	// Number of current wins in the mini series.
-	Wins int32 `json:"wins,omitempty"`
-}
-
-// Shard
-//
-// resource: "lol-status", original name: "Shard"
-type Shard struct {
-	Hostname  string   `json:"hostname,omitempty"`
-	Locales   []string `json:"locales,omitempty"`
-	Name      string   `json:"name,omitempty"`
-	RegionTag string   `json:"region_tag,omitempty"`
-	Slug      string   `json:"slug,omitempty"`
-}
-
-// ChampionListDto - This object contains champion list data.
-//
-// resource: "lol-static-data", original name: "ChampionListDto"
-type ChampionDataList struct {
-	Data    map[string]*ChampionData `json:"data,omitempty"`
-	Format  string                   `json:"format,omitempty"`
-	Keys    map[string]string        `json:"keys,omitempty"`
-	Type    string                   `json:"type,omitempty"`
-	Version string                   `json:"version,omitempty"`
-}
-
-// Timeline - This object contains game timeline information
-//
-// resource: "match", original name: "Timeline"
-type Timeline struct {
-	// Time between each returned frame in milliseconds.
-	FrameInterval int64 `json:"frameInterval,omitempty"`
-	// List of timeline frames for the game.
-	Frames []*Frame `json:"frames,omitempty"`
-}
-
-// ChampionSpellDto - This object contains champion spell data.
-//
-// resource: "lol-static-data", original name: "ChampionSpellDto"
-type ChampionSpell struct {
-	Altimages    []*Image  `json:"altimages,omitempty"`
-	Cooldown     []float64 `json:"cooldown,omitempty"`
-	CooldownBurn string    `json:"cooldownBurn,omitempty"`
-	Cost         []int32   `json:"cost,omitempty"`
-	CostBurn     string    `json:"costBurn,omitempty"`
-	CostType     string    `json:"costType,omitempty"`
-	Description  string    `json:"description,omitempty"`
-	// This field is a List of List of Double.
-	Effect     [][]float64 `json:"effect,omitempty"`
-	EffectBurn []string    `json:"effectBurn,omitempty"`
-	Image      *Image      `json:"image,omitempty"`
-	Key        string      `json:"key,omitempty"`
-	Leveltip   *LevelTip   `json:"leveltip,omitempty"`
-	Maxrank    int32       `json:"maxrank,omitempty"`
-	Name       string      `json:"name,omitempty"`
-	// This field is either a List of Integer or the String 'self' for spells that target one's own champion.
-	Range                *interface{} `json:"range,omitempty"`
-	RangeBurn            string       `json:"rangeBurn,omitempty"`
-	Resource             string       `json:"resource,omitempty"`
-	SanitizedDescription string       `json:"sanitizedDescription,omitempty"`
-	SanitizedTooltip     string       `json:"sanitizedTooltip,omitempty"`
-	Tooltip              string       `json:"tooltip,omitempty"`
-	Vars                 []*SpellVars `json:"vars,omitempty"`
-}
-
-// ChampionDto - This object contains champion data.
-//
-// resource: "lol-static-data", original name: "ChampionDto"
-type ChampionData struct {
-	Allytips    []string         `json:"allytips,omitempty"`
-	Blurb       string           `json:"blurb,omitempty"`
-	Enemytips   []string         `json:"enemytips,omitempty"`
-	ID          int32            `json:"id,omitempty"`
-	Image       *Image           `json:"image,omitempty"`
-	Info        *Info            `json:"info,omitempty"`
-	Key         string           `json:"key,omitempty"`
-	Lore        string           `json:"lore,omitempty"`
-	Name        string           `json:"name,omitempty"`
-	Partype     string           `json:"partype,omitempty"`
-	Passive     *Passive         `json:"passive,omitempty"`
-	Recommended []*Recommended   `json:"recommended,omitempty"`
-	Skins       []*Skin          `json:"skins,omitempty"`
-	Spells      []*ChampionSpell `json:"spells,omitempty"`
-	Stats       *Stats           `json:"stats,omitempty"`
-	Tags        []string         `json:"tags,omitempty"`
-	Title       string           `json:"title,omitempty"`
-}
-
-// StatsDto - This object contains champion stats data.
-//
-// resource: "lol-static-data", original name: "StatsDto"
-type Stats struct {
-	Armor                float64 `json:"armor,omitempty"`
-	Armorperlevel        float64 `json:"armorperlevel,omitempty"`
-	Attackdamage         float64 `json:"attackdamage,omitempty"`
-	Attackdamageperlevel float64 `json:"attackdamageperlevel,omitempty"`
-	Attackrange          float64 `json:"attackrange,omitempty"`
-	Attackspeedoffset    float64 `json:"attackspeedoffset,omitempty"`
-	Attackspeedperlevel  float64 `json:"attackspeedperlevel,omitempty"`
-	Crit                 float64 `json:"crit,omitempty"`
-	Critperlevel         float64 `json:"critperlevel,omitempty"`
-	Hp                   float64 `json:"hp,omitempty"`
-	Hpperlevel           float64 `json:"hpperlevel,omitempty"`
-	Hpregen              float64 `json:"hpregen,omitempty"`
-	Hpregenperlevel      float64 `json:"hpregenperlevel,omitempty"`
-	Movespeed            float64 `json:"movespeed,omitempty"`
-	Mp                   float64 `json:"mp,omitempty"`
-	Mpperlevel           float64 `json:"mpperlevel,omitempty"`
-	Mpregen              float64 `json:"mpregen,omitempty"`
-	Mpregenperlevel      float64 `json:"mpregenperlevel,omitempty"`
-	Spellblock           float64 `json:"spellblock,omitempty"`
-	Spellblockperlevel   float64 `json:"spellblockperlevel,omitempty"`
-}
-
-// CurrentGameParticipant
-//
-// resource: "current-game", original name: "CurrentGameParticipant"
-type CurrentGameParticipant struct {
-	// Flag indicating whether or not this participant is a bot
-	Bot bool `json:"bot,omitempty"`
-	// The ID of the champion played by this participant
-	ChampionID int32 `json:"championId,omitempty"`
-	// The masteries used by this participant
-	Masteries []*CurrentMastery `json:"masteries,omitempty"`
-	// The ID of the profile icon used by this participant
-	ProfileIconID int32 `json:"profileIconId,omitempty"`
-	// The runes used by this participant
-	Runes []*CurrentRune `json:"runes,omitempty"`
-	// The ID of the first summoner spell used by this participant
-	Spell1Id int32 `json:"spell1Id,omitempty"`
-	// The ID of the second summoner spell used by this participant
-	Spell2Id int32 `json:"spell2Id,omitempty"`
-	// The summoner ID of this participant
-	SummonerID int64 `json:"summonerId,omitempty"`
-	// The summoner name of this participant
-	SummonerName string `json:"summonerName,omitempty"`
-	// The team ID of this participant, indicating the participant's team
-	TeamID int64 `json:"teamId,omitempty"`
-}
-
-// ShardStatus
-//
-// resource: "lol-status", original name: "ShardStatus"
-type ShardStatus struct {
-	Hostname  string     `json:"hostname,omitempty"`
-	Locales   []string   `json:"locales,omitempty"`
-	Name      string     `json:"name,omitempty"`
-	RegionTag string     `json:"region_tag,omitempty"`
-	Services  []*Service `json:"services,omitempty"`
-	Slug      string     `json:"slug,omitempty"`
-}
-
-// Team - This object contains team information
-//
-// resource: "match", original name: "Team"
-type Team struct {
-	// If game was draft mode, contains banned champion data, otherwise null
-	Bans []*BannedChampion `json:"bans,omitempty"`
-	// Number of times the team killed baron
-	BaronKills int32 `json:"baronKills,omitempty"`
-	// If game was a dominion game, specifies the points the team had at game end, otherwise null
-	DominionVictoryScore int64 `json:"dominionVictoryScore,omitempty"`
-	// Number of times the team killed dragon
-	DragonKills int32 `json:"dragonKills,omitempty"`
-	// Flag indicating whether or not the team got the first baron kill
-	FirstBaron bool `json:"firstBaron,omitempty"`
-	// Flag indicating whether or not the team got first blood
-	FirstBlood bool `json:"firstBlood,omitempty"`
-	// Flag indicating whether or not the team got the first dragon kill
-	FirstDragon bool `json:"firstDragon,omitempty"`
-	// Flag indicating whether or not the team destroyed the first inhibitor
-	FirstInhibitor bool `json:"firstInhibitor,omitempty"`
-	// Flag indicating whether or not the team got the first rift herald kill
-	FirstRiftHerald bool `json:"firstRiftHerald,omitempty"`
-	// Flag indicating whether or not the team destroyed the first tower
-	FirstTower bool `json:"firstTower,omitempty"`
-	// Number of inhibitors the team destroyed
-	InhibitorKills int32 `json:"inhibitorKills,omitempty"`
-	// Number of times the team killed rift herald
-	RiftHeraldKills int32 `json:"riftHeraldKills,omitempty"`
-	// Team ID
-	TeamID int32 `json:"teamId,omitempty"`
-	// Number of towers the team destroyed
-	TowerKills int32 `json:"towerKills,omitempty"`
-	// Number of times the team killed vilemaw
-	VilemawKills int32 `json:"vilemawKills,omitempty"`
-	// Flag indicating whether or not the team won
-	Winner bool `json:"winner,omitempty"`
-}
-
-// SpellVarsDto - This object contains spell vars data.
-//
-// resource: "lol-static-data", original name: "SpellVarsDto"
-type SpellVars struct {
-	Coeff     []float64 `json:"coeff,omitempty"`
-	Dyn       string    `json:"dyn,omitempty"`
-	Key       string    `json:"key,omitempty"`
-	Link      string    `json:"link,omitempty"`
-	RanksWith string    `json:"ranksWith,omitempty"`
-}
-
-// ImageDto - This object contains image data.
-//
-// resource: "lol-static-data", original name: "ImageDto"
-type Image struct {
-	Full   string `json:"full,omitempty"`
-	Group  string `json:"group,omitempty"`
-	H      int32  `json:"h,omitempty"`
-	Sprite string `json:"sprite,omitempty"`
-	W      int32  `json:"w,omitempty"`
-	X      int32  `json:"x,omitempty"`
-	Y      int32  `json:"y,omitempty"`
-}
-
-// ParticipantFrame - This object contains participant frame information
-//
-// resource: "match", original name: "ParticipantFrame"
-type ParticipantFrame struct {
-	// Participant's current gold
-	CurrentGold int32 `json:"currentGold,omitempty"`
-	// Dominion score of the participant
-	DominionScore int32 `json:"dominionScore,omitempty"`
-	// Number of jungle minions killed by participant
-	JungleMinionsKilled int32 `json:"jungleMinionsKilled,omitempty"`
-	// Participant's current level
-	Level int32 `json:"level,omitempty"`
-	// Number of minions killed by participant
-	MinionsKilled int32 `json:"minionsKilled,omitempty"`
-	// Participant ID
-	ParticipantID int32 `json:"participantId,omitempty"`
-	// Participant's position
-	Position *Position `json:"position,omitempty"`
-	// Team score of the participant
-	TeamScore int32 `json:"teamScore,omitempty"`
-	// Participant's total gold
-	TotalGold int32 `json:"totalGold,omitempty"`
-	// Experience earned by participant
-	Xp int32 `json:"xp,omitempty"`
-}
-
-// AggregatedStatsDto - This object contains aggregated stat information.
-//
-// resource: "stats", original name: "AggregatedStatsDto"
-type AggregatedStats struct {
-	// Dominion only.
-	AverageAssists int32 `json:"averageAssists,omitempty"`
-	// Dominion only.
-	AverageChampionsKilled int32 `json:"averageChampionsKilled,omitempty"`
-	// Dominion only.
-	AverageCombatPlayerScore int32 `json:"averageCombatPlayerScore,omitempty"`
-	// Dominion only.
-	AverageNodeCapture int32 `json:"averageNodeCapture,omitempty"`
-	// Dominion only.
-	AverageNodeCaptureAssist int32 `json:"averageNodeCaptureAssist,omitempty"`
-	// Dominion only.
-	AverageNodeNeutralize int32 `json:"averageNodeNeutralize,omitempty"`
-	// Dominion only.
-	AverageNodeNeutralizeAssist int32 `json:"averageNodeNeutralizeAssist,omitempty"`
-	// Dominion only.
-	AverageNumDeaths int32 `json:"averageNumDeaths,omitempty"`
-	// Dominion only.
-	AverageObjectivePlayerScore int32 `json:"averageObjectivePlayerScore,omitempty"`
-	// Dominion only.
-	AverageTeamObjective int32 `json:"averageTeamObjective,omitempty"`
-	// Dominion only.
-	AverageTotalPlayerScore int32 `json:"averageTotalPlayerScore,omitempty"`
-	BotGamesPlayed          int32 `json:"botGamesPlayed,omitempty"`
-	KillingSpree            int32 `json:"killingSpree,omitempty"`
-	// Dominion only.
-	MaxAssists         int32 `json:"maxAssists,omitempty"`
-	MaxChampionsKilled int32 `json:"maxChampionsKilled,omitempty"`
-	// Dominion only.
-	MaxCombatPlayerScore     int32 `json:"maxCombatPlayerScore,omitempty"`
-	MaxLargestCriticalStrike int32 `json:"maxLargestCriticalStrike,omitempty"`
-	MaxLargestKillingSpree   int32 `json:"maxLargestKillingSpree,omitempty"`
-	// Dominion only.
-	MaxNodeCapture int32 `json:"maxNodeCapture,omitempty"`
-	// Dominion only.
-	MaxNodeCaptureAssist int32 `json:"maxNodeCaptureAssist,omitempty"`
-	// Dominion only.
-	MaxNodeNeutralize int32 `json:"maxNodeNeutralize,omitempty"`
-	// Dominion only.
-	MaxNodeNeutralizeAssist int32 `json:"maxNodeNeutralizeAssist,omitempty"`
-	// Only returned for ranked statistics.
-	MaxNumDeaths int32 `json:"maxNumDeaths,omitempty"`
-	// Dominion only.
-	MaxObjectivePlayerScore int32 `json:"maxObjectivePlayerScore,omitempty"`
-	// Dominion only.
-	MaxTeamObjective   int32 `json:"maxTeamObjective,omitempty"`
-	MaxTimePlayed      int32 `json:"maxTimePlayed,omitempty"`
-	MaxTimeSpentLiving int32 `json:"maxTimeSpentLiving,omitempty"`
-	// Dominion only.
-	MaxTotalPlayerScore         int32 `json:"maxTotalPlayerScore,omitempty"`
-	MostChampionKillsPerSession int32 `json:"mostChampionKillsPerSession,omitempty"`
-	MostSpellsCast              int32 `json:"mostSpellsCast,omitempty"`
-	NormalGamesPlayed           int32 `json:"normalGamesPlayed,omitempty"`
-	RankedPremadeGamesPlayed    int32 `json:"rankedPremadeGamesPlayed,omitempty"`
-	RankedSoloGamesPlayed       int32 `json:"rankedSoloGamesPlayed,omitempty"`
-	TotalAssists                int32 `json:"totalAssists,omitempty"`
-	TotalChampionKills          int32 `json:"totalChampionKills,omitempty"`
-	TotalDamageDealt            int32 `json:"totalDamageDealt,omitempty"`
-	TotalDamageTaken            int32 `json:"totalDamageTaken,omitempty"`
-	// Only returned for ranked statistics.
-	TotalDeathsPerSession     int32 `json:"totalDeathsPerSession,omitempty"`
-	TotalDoubleKills          int32 `json:"totalDoubleKills,omitempty"`
-	TotalFirstBlood           int32 `json:"totalFirstBlood,omitempty"`
-	TotalGoldEarned           int32 `json:"totalGoldEarned,omitempty"`
-	TotalHeal                 int32 `json:"totalHeal,omitempty"`
-	TotalMagicDamageDealt     int32 `json:"totalMagicDamageDealt,omitempty"`
-	TotalMinionKills          int32 `json:"totalMinionKills,omitempty"`
-	TotalNeutralMinionsKilled int32 `json:"totalNeutralMinionsKilled,omitempty"`
-	// Dominion only.
-	TotalNodeCapture int32 `json:"totalNodeCapture,omitempty"`
-	// Dominion only.
-	TotalNodeNeutralize      int32 `json:"totalNodeNeutralize,omitempty"`
-	TotalPentaKills          int32 `json:"totalPentaKills,omitempty"`
-	TotalPhysicalDamageDealt int32 `json:"totalPhysicalDamageDealt,omitempty"`
-	TotalQuadraKills         int32 `json:"totalQuadraKills,omitempty"`
-	TotalSessionsLost        int32 `json:"totalSessionsLost,omitempty"`
-	TotalSessionsPlayed      int32 `json:"totalSessionsPlayed,omitempty"`
-	TotalSessionsWon         int32 `json:"totalSessionsWon,omitempty"`
-	TotalTripleKills         int32 `json:"totalTripleKills,omitempty"`
-	TotalTurretsKilled       int32 `json:"totalTurretsKilled,omitempty"`
-	TotalUnrealKills         int32 `json:"totalUnrealKills,omitempty"`
-}
-
-// RunePageDto - This object contains rune page information.
-//
-// resource: "summoner", original name: "RunePageDto"
-type RunePage struct {
-	// Indicates if the page is the current page.
-	Current bool `json:"current,omitempty"`
-	// Rune page ID.
-	ID int64 `json:"id,omitempty"`
-	// Rune page name.
-	Name string `json:"name,omitempty"`
-	// Collection of rune slots associated with the rune page.
-	Slots []*RuneSlot `json:"slots,omitempty"`
-}
-
-// BasicDataStatsDto - This object contains basic data stats.
-//
-// resource: "lol-static-data", original name: "BasicDataStatsDto"
-type BasicDataStats struct {
-	FlatArmorMod                        float64 `json:"FlatArmorMod,omitempty"`
-	FlatAttackSpeedMod                  float64 `json:"FlatAttackSpeedMod,omitempty"`
-	FlatBlockMod                        float64 `json:"FlatBlockMod,omitempty"`
-	FlatCritChanceMod                   float64 `json:"FlatCritChanceMod,omitempty"`
-	FlatCritDamageMod                   float64 `json:"FlatCritDamageMod,omitempty"`
-	FlatEXPBonus                        float64 `json:"FlatEXPBonus,omitempty"`
-	FlatEnergyPoolMod                   float64 `json:"FlatEnergyPoolMod,omitempty"`
-	FlatEnergyRegenMod                  float64 `json:"FlatEnergyRegenMod,omitempty"`
-	FlatHPPoolMod                       float64 `json:"FlatHPPoolMod,omitempty"`
-	FlatHPRegenMod                      float64 `json:"FlatHPRegenMod,omitempty"`
-	FlatMPPoolMod                       float64 `json:"FlatMPPoolMod,omitempty"`
-	FlatMPRegenMod                      float64 `json:"FlatMPRegenMod,omitempty"`
-	FlatMagicDamageMod                  float64 `json:"FlatMagicDamageMod,omitempty"`
-	FlatMovementSpeedMod                float64 `json:"FlatMovementSpeedMod,omitempty"`
-	FlatPhysicalDamageMod               float64 `json:"FlatPhysicalDamageMod,omitempty"`
-	FlatSpellBlockMod                   float64 `json:"FlatSpellBlockMod,omitempty"`
-	PercentArmorMod                     float64 `json:"PercentArmorMod,omitempty"`
-	PercentAttackSpeedMod               float64 `json:"PercentAttackSpeedMod,omitempty"`
-	PercentBlockMod                     float64 `json:"PercentBlockMod,omitempty"`
-	PercentCritChanceMod                float64 `json:"PercentCritChanceMod,omitempty"`
-	PercentCritDamageMod                float64 `json:"PercentCritDamageMod,omitempty"`
-	PercentDodgeMod                     float64 `json:"PercentDodgeMod,omitempty"`
-	PercentEXPBonus                     float64 `json:"PercentEXPBonus,omitempty"`
-	PercentHPPoolMod                    float64 `json:"PercentHPPoolMod,omitempty"`
-	PercentHPRegenMod                   float64 `json:"PercentHPRegenMod,omitempty"`
-	PercentLifeStealMod                 float64 `json:"PercentLifeStealMod,omitempty"`
-	PercentMPPoolMod                    float64 `json:"PercentMPPoolMod,omitempty"`
-	PercentMPRegenMod                   float64 `json:"PercentMPRegenMod,omitempty"`
-	PercentMagicDamageMod               float64 `json:"PercentMagicDamageMod,omitempty"`
-	PercentMovementSpeedMod             float64 `json:"PercentMovementSpeedMod,omitempty"`
-	PercentPhysicalDamageMod            float64 `json:"PercentPhysicalDamageMod,omitempty"`
-	PercentSpellBlockMod                float64 `json:"PercentSpellBlockMod,omitempty"`
-	PercentSpellVampMod                 float64 `json:"PercentSpellVampMod,omitempty"`
-	RFlatArmorModPerLevel               float64 `json:"rFlatArmorModPerLevel,omitempty"`
-	RFlatArmorPenetrationMod            float64 `json:"rFlatArmorPenetrationMod,omitempty"`
-	RFlatArmorPenetrationModPerLevel    float64 `json:"rFlatArmorPenetrationModPerLevel,omitempty"`
-	RFlatCritChanceModPerLevel          float64 `json:"rFlatCritChanceModPerLevel,omitempty"`
-	RFlatCritDamageModPerLevel          float64 `json:"rFlatCritDamageModPerLevel,omitempty"`
-	RFlatDodgeMod                       float64 `json:"rFlatDodgeMod,omitempty"`
-	RFlatDodgeModPerLevel               float64 `json:"rFlatDodgeModPerLevel,omitempty"`
-	RFlatEnergyModPerLevel              float64 `json:"rFlatEnergyModPerLevel,omitempty"`
-	RFlatEnergyRegenModPerLevel         float64 `json:"rFlatEnergyRegenModPerLevel,omitempty"`
-	RFlatGoldPer10Mod                   float64 `json:"rFlatGoldPer10Mod,omitempty"`
-	RFlatHPModPerLevel                  float64 `json:"rFlatHPModPerLevel,omitempty"`
-	RFlatHPRegenModPerLevel             float64 `json:"rFlatHPRegenModPerLevel,omitempty"`
-	RFlatMPModPerLevel                  float64 `json:"rFlatMPModPerLevel,omitempty"`
-	RFlatMPRegenModPerLevel             float64 `json:"rFlatMPRegenModPerLevel,omitempty"`
-	RFlatMagicDamageModPerLevel         float64 `json:"rFlatMagicDamageModPerLevel,omitempty"`
-	RFlatMagicPenetrationMod            float64 `json:"rFlatMagicPenetrationMod,omitempty"`
-	RFlatMagicPenetrationModPerLevel    float64 `json:"rFlatMagicPenetrationModPerLevel,omitempty"`
-	RFlatMovementSpeedModPerLevel       float64 `json:"rFlatMovementSpeedModPerLevel,omitempty"`
-	RFlatPhysicalDamageModPerLevel      float64 `json:"rFlatPhysicalDamageModPerLevel,omitempty"`
-	RFlatSpellBlockModPerLevel          float64 `json:"rFlatSpellBlockModPerLevel,omitempty"`
-	RFlatTimeDeadMod                    float64 `json:"rFlatTimeDeadMod,omitempty"`
-	RFlatTimeDeadModPerLevel            float64 `json:"rFlatTimeDeadModPerLevel,omitempty"`
-	RPercentArmorPenetrationMod         float64 `json:"rPercentArmorPenetrationMod,omitempty"`
-	RPercentArmorPenetrationModPerLevel float64 `json:"rPercentArmorPenetrationModPerLevel,omitempty"`
-	RPercentAttackSpeedModPerLevel      float64 `json:"rPercentAttackSpeedModPerLevel,omitempty"`
-	RPercentCooldownMod                 float64 `json:"rPercentCooldownMod,omitempty"`
-	RPercentCooldownModPerLevel         float64 `json:"rPercentCooldownModPerLevel,omitempty"`
-	RPercentMagicPenetrationMod         float64 `json:"rPercentMagicPenetrationMod,omitempty"`
-	RPercentMagicPenetrationModPerLevel float64 `json:"rPercentMagicPenetrationModPerLevel,omitempty"`
-	RPercentMovementSpeedModPerLevel    float64 `json:"rPercentMovementSpeedModPerLevel,omitempty"`
-	RPercentTimeDeadMod                 float64 `json:"rPercentTimeDeadMod,omitempty"`
-	RPercentTimeDeadModPerLevel         float64 `json:"rPercentTimeDeadModPerLevel,omitempty"`
-}
-
-// FeaturedGameInfo
-//
-// resource: "featured-games", original name: "FeaturedGameInfo"
-type FeaturedGameInfo struct {
-	// Banned champion information
-	BannedChampions []*FeaturedGameBannedChampion `json:"bannedChampions,omitempty"`
-	// The ID of the game
-	GameID int64 `json:"gameId,omitempty"`
-	// The amount of time in seconds that has passed since the game started
-	GameLength int64 `json:"gameLength,omitempty"`
-	// The game mode (Legal values: CLASSIC, ODIN, ARAM, TUTORIAL, ONEFORALL, ASCENSION, FIRSTBLOOD, KINGPORO)
-	GameMode string `json:"gameMode,omitempty"`
-	// The queue type (queue types are documented on the Game Constants page)
-	GameQueueConfigID int64 `json:"gameQueueConfigId,omitempty"`
-	// The game start time represented in epoch milliseconds
-	GameStartTime int64 `json:"gameStartTime,omitempty"`
-	// The game type (Legal values: CUSTOM_GAME, MATCHED_GAME, TUTORIAL_GAME)
-	GameType string `json:"gameType,omitempty"`
-	// The ID of the map
-	MapID int32 `json:"mapId,omitempty"`
-	// The observer information
-	Observers *FeaturedGameObserver `json:"observers,omitempty"`
-	// The participant information
-	Participants []*FeaturedGameParticipant `json:"participants,omitempty"`
-	// The ID of the platform on which the game is being played
-	PlatformID string `json:"platformId,omitempty"`
-}
-
-// ItemTreeDto - This object contains item tree data.
-//
-// resource: "lol-static-data", original name: "ItemTreeDto"
-type ItemTree struct {
-	Header string   `json:"header,omitempty"`
-	Tags   []string `json:"tags,omitempty"`
-}
-
-// BlockDto - This object contains champion recommended block data.
-//
-// resource: "lol-static-data", original name: "BlockDto"
-type Block struct {
-	Items   []*BlockItem `json:"items,omitempty"`
-	RecMath bool         `json:"recMath,omitempty"`
-	Type    string       `json:"type,omitempty"`
-}
-
-// ChampionDto - This object contains champion information.
-//
-// resource: "champion", original name: "ChampionDto"
-type Champion struct {
-	// Indicates if the champion is active.
-	Active bool `json:"active,omitempty"`
-	// Bot enabled flag (for custom games).
-	BotEnabled bool `json:"botEnabled,omitempty"`
-	// Bot Match Made enabled flag (for Co-op vs. AI games).
-	BotMmEnabled bool `json:"botMmEnabled,omitempty"`
-	// Indicates if the champion is free to play. Free to play champions are rotated periodically.
-	FreeToPlay bool `json:"freeToPlay,omitempty"`
-	// Champion ID. For static information correlating to champion IDs, please refer to the LoL Static Data API.
-	ID int32 `json:"id,omitempty"`
-	// Ranked play enabled flag.
-	RankedPlayEnabled bool `json:"rankedPlayEnabled,omitempty"`
-}
-
-// RecentGamesDto - This object contains recent games information.
-//
-// resource: "game", original name: "RecentGamesDto"
-type RecentGames struct {
-	// Collection of recent games played (max 10).
-	Games []*Game `json:"games,omitempty"`
-	// Summoner ID.
-	SummonerID int64 `json:"summonerId,omitempty"`
-}
-
-// MatchReference - This object contains match reference information
-//
-// resource: "matchlist", original name: "MatchReference"
-type MatchReference struct {
-	Champion int32 `json:"champion,omitempty"`
-	// Legal values: MID, MIDDLE, TOP, JUNGLE, BOT, BOTTOM
-	Lane       string `json:"lane,omitempty"`
-	MatchID    int64  `json:"matchId,omitempty"`
-	PlatformID string `json:"platformId,omitempty"`
-	// Legal values: RANKED_SOLO_5x5, RANKED_TEAM_3x3, RANKED_TEAM_5x5
-	Queue  string `json:"queue,omitempty"`
-	Region string `json:"region,omitempty"`
-	// Legal values: DUO, NONE, SOLO, DUO_CARRY, DUO_SUPPORT
-	Role string `json:"role,omitempty"`
-	// Legal values: PRESEASON3, SEASON3, PRESEASON2014, SEASON2014, PRESEASON2015, SEASON2015, PRESEASON2016, SEASON2016
-	Season    string `json:"season,omitempty"`
-	Timestamp int64  `json:"timestamp,omitempty"`
-}
-
-// ChampionStatsDto - This object contains a collection of champion stats information.
-//
-// resource: "stats", original name: "ChampionStatsDto"
-type ChampionStats struct {
-	// Champion ID. Note that champion ID 0 represents the combined stats for all champions. For static information correlating to champion IDs, please refer to the LoL Static Data API.
-	ID int32 `json:"id,omitempty"`
-	// Aggregated stats associated with the champion.
-	Stats *AggregatedStats `json:"stats,omitempty"`
-}
-
-// MapDetailsDto - This object contains map details data.
-//
-// resource: "lol-static-data", original name: "MapDetailsDto"
-type MapDetails struct {
-	Image                 *Image  `json:"image,omitempty"`
-	MapID                 int32   `json:"mapId,omitempty"`
-	MapName               string  `json:"mapName,omitempty"`
-	UnpurchasableItemList []int64 `json:"unpurchasableItemList,omitempty"`
-}
-
-// MapDataDto - This object contains map data.
-//
-// resource: "lol-static-data", original name: "MapDataDto"
-type MapData struct {
-	Data    map[string]*MapDetails `json:"data,omitempty"`
-	Type    string                 `json:"type,omitempty"`
-	Version string                 `json:"version,omitempty"`
-}
-
-// RankedStatsDto - This object contains ranked stats information.
-//
-// resource: "stats", original name: "RankedStatsDto"
-type RankedStats struct {
-	// Collection of aggregated stats summarized by champion.
-	Champions []*ChampionStats `json:"champions,omitempty"`
-	// Date stats were last modified specified as epoch milliseconds.
-	ModifyDate int64 `json:"modifyDate,omitempty"`
-	// Summoner ID.
-	SummonerID int64 `json:"summonerId,omitempty"`
-}
-
-// MasteryListDto - This object contains mastery list data.
-//
-// resource: "lol-static-data", original name: "MasteryListDto"
-type MasteryList struct {
-	Data    map[string]*Mastery `json:"data,omitempty"`
-	Tree    *MasteryTree        `json:"tree,omitempty"`
-	Type    string              `json:"type,omitempty"`
-	Version string              `json:"version,omitempty"`
-}
-
-// SkinDto - This object contains champion skin data.
-//
-// resource: "lol-static-data", original name: "SkinDto"
-type Skin struct {
-	ID   int32  `json:"id,omitempty"`
-	Name string `json:"name,omitempty"`
-	Num  int32  `json:"num,omitempty"`
-}
-
-// Mastery
-//
-// resource: "current-game", original name: "Mastery"
-type CurrentMastery struct {
-	// The ID of the mastery
-	MasteryID int32 `json:"masteryId,omitempty"`
-	// The number of points put into this mastery by the user
-	Rank int32 `json:"rank,omitempty"`
-}
-
-// Observer
-//
-// resource: "current-game", original name: "Observer"
-type CurrentGameObserver struct {
-	// Key used to decrypt the spectator grid game data for playback
-	EncryptionKey string `json:"encryptionKey,omitempty"`
-}
-
-// GameDto - This object contains game information.
-//
-// resource: "game", original name: "GameDto"
-type Game struct {
-	// Champion ID associated with game.
-	ChampionID int32 `json:"championId,omitempty"`
-	// Date that end game data was recorded, specified as epoch milliseconds.
-	CreateDate int64 `json:"createDate,omitempty"`
-	// Other players associated with the game.
-	FellowPlayers []*Player `json:"fellowPlayers,omitempty"`
-	// Game ID.
-	GameID int64 `json:"gameId,omitempty"`
-	// Game mode. (Legal values: CLASSIC, ODIN, ARAM, TUTORIAL, ONEFORALL, ASCENSION, FIRSTBLOOD, KINGPORO)
-	GameMode string `json:"gameMode,omitempty"`
-	// Game type. (Legal values: CUSTOM_GAME, MATCHED_GAME, TUTORIAL_GAME)
-	GameType string `json:"gameType,omitempty"`
-	// Invalid flag.
-	Invalid bool `json:"invalid,omitempty"`
-	// IP Earned.
-	IPEarned int32 `json:"ipEarned,omitempty"`
-	// Level.
-	Level int32 `json:"level,omitempty"`
-	// Map ID.
-	MapID int32 `json:"mapId,omitempty"`
-	// ID of first summoner spell.
-	Spell1 int32 `json:"spell1,omitempty"`
-	// ID of second summoner spell.
-	Spell2 int32 `json:"spell2,omitempty"`
-	// Statistics associated with the game for this summoner.
-	Stats *RawStats `json:"stats,omitempty"`
-	// Game sub-type. (Legal values: NONE, NORMAL, BOT, RANKED_SOLO_5x5, RANKED_PREMADE_3x3, RANKED_PREMADE_5x5, ODIN_UNRANKED, RANKED_TEAM_3x3, RANKED_TEAM_5x5, NORMAL_3x3, BOT_3x3, CAP_5x5, ARAM_UNRANKED_5x5, ONEFORALL_5x5, FIRSTBLOOD_1x1, FIRSTBLOOD_2x2, SR_6x6, URF, URF_BOT, NIGHTMARE_BOT, ASCENSION, HEXAKILL, KING_PORO, COUNTER_PICK, BILGEWATER)
-	SubType string `json:"subType,omitempty"`
-	// Team ID associated with game. Team ID 100 is blue team. Team ID 200 is purple team.
-	TeamID int32 `json:"teamId,omitempty"`
-}
-
-// Incident
-//
-// resource: "lol-status", original name: "Incident"
-type Incident struct {
-	Active    bool       `json:"active,omitempty"`
-	CreatedAt string     `json:"created_at,omitempty"`
-	ID        int64      `json:"id,omitempty"`
-	Updates   []*Message `json:"updates,omitempty"`
-}
-
-// SummonerSpellDto - This object contains summoner spell data.
-//
-// resource: "lol-static-data", original name: "SummonerSpellDto"
-type SummonerSpell struct {
-	Cooldown     []float64 `json:"cooldown,omitempty"`
-	CooldownBurn string    `json:"cooldownBurn,omitempty"`
-	Cost         []int32   `json:"cost,omitempty"`
-	CostBurn     string    `json:"costBurn,omitempty"`
-	CostType     string    `json:"costType,omitempty"`
-	Description  string    `json:"description,omitempty"`
-	// This field is a List of List of Double.
-	Effect     [][]float64 `json:"effect,omitempty"`
-	EffectBurn []string    `json:"effectBurn,omitempty"`
-	ID         int32       `json:"id,omitempty"`
-	Image      *Image      `json:"image,omitempty"`
-	Key        string      `json:"key,omitempty"`
-	Leveltip   *LevelTip   `json:"leveltip,omitempty"`
-	Maxrank    int32       `json:"maxrank,omitempty"`
-	Modes      []string    `json:"modes,omitempty"`
-	Name       string      `json:"name,omitempty"`
-	// This field is either a List of Integer or the String 'self' for spells that target one's own champion.
-	Range                *interface{} `json:"range,omitempty"`
-	RangeBurn            string       `json:"rangeBurn,omitempty"`
-	Resource             string       `json:"resource,omitempty"`
-	SanitizedDescription string       `json:"sanitizedDescription,omitempty"`
-	SanitizedTooltip     string       `json:"sanitizedTooltip,omitempty"`
-	SummonerLevel        int32        `json:"summonerLevel,omitempty"`
-	Tooltip              string       `json:"tooltip,omitempty"`
-	Vars                 []*SpellVars `json:"vars,omitempty"`
-}
-
-// RuneListDto - This object contains rune list data.
-//
-// resource: "lol-static-data", original name: "RuneListDto"
-type RuneList struct {
-	Basic   *BasicData       `json:"basic,omitempty"`
-	Data    map[string]*Rune `json:"data,omitempty"`
-	Type    string           `json:"type,omitempty"`
-	Version string           `json:"version,omitempty"`
-}
-
-// BannedChampion
-//
-// resource: "current-game", original name: "BannedChampion"
-type CurrentGameBannedChampion struct {
-	// The ID of the banned champion
-	ChampionID int32 `json:"championId,omitempty"`
-	// The turn during which the champion was banned
-	PickTurn int32 `json:"pickTurn,omitempty"`
-	// The ID of the team that banned the champion
-	TeamID int64 `json:"teamId,omitempty"`
-}
-
-// PlayerStatsSummaryDto - This object contains player stats summary information.
-//
-// resource: "stats", original name: "PlayerStatsSummaryDto"
-type PlayerStatsSummary struct {
-	// Aggregated stats.
-	AggregatedStats *AggregatedStats `json:"aggregatedStats,omitempty"`
-	// Number of losses for this queue type. Returned for ranked queue types only.
-	Losses int32 `json:"losses,omitempty"`
-	// Date stats were last modified specified as epoch milliseconds.
-	ModifyDate int64 `json:"modifyDate,omitempty"`
-	// Player stats summary type. (Legal values: AramUnranked5x5, Ascension, CAP5x5, CoopVsAI, CoopVsAI3x3, CounterPick, FirstBlood1x1, FirstBlood2x2, Hexakill, KingPoro, NightmareBot, OdinUnranked, OneForAll5x5, RankedPremade3x3, RankedPremade5x5, RankedSolo5x5, RankedTeam3x3, RankedTeam5x5, SummonersRift6x6, Unranked, Unranked3x3, URF, URFBots, Bilgewater)
-	PlayerStatSummaryType string `json:"playerStatSummaryType,omitempty"`
-	// Number of wins for this queue type.
-	Wins int32 `json:"wins,omitempty"`
-}
-
-// RuneSlotDto - This object contains rune slot information.
-//
-// resource: "summoner", original name: "RuneSlotDto"
-type RuneSlot struct {
-	// Rune ID associated with the rune slot. For static information correlating to rune IDs, please refer to the LoL Static Data API.
-	RuneID int32 `json:"runeId,omitempty"`
-	// Rune slot ID.
-	RuneSlotID int32 `json:"runeSlotId,omitempty"`
-}
-
-// MasteryTreeListDto - This object contains mastery tree list data.
-//
-// resource: "lol-static-data", original name: "MasteryTreeListDto"
-type MasteryTreeList struct {
-	MasteryTreeItems []*MasteryTreeItem `json:"masteryTreeItems,omitempty"`
-}
-
-// InfoDto - This object contains champion information.
-//
-// resource: "lol-static-data", original name: "InfoDto"
-type Info struct {
-	Attack     int32 `json:"attack,omitempty"`
-	Defense    int32 `json:"defense,omitempty"`
-	Difficulty int32 `json:"difficulty,omitempty"`
-	Magic      int32 `json:"magic,omitempty"`
-}
-
-// Position - This object contains participant frame position information
-//
-// resource: "match", original name: "Position"
-type Position struct {
-	X int32 `json:"x,omitempty"`
-	Y int32 `json:"y,omitempty"`
-}
-
-// MatchDetail - This object contains match detail information
-//
-// resource: "match", original name: "MatchDetail"
-type MatchDetail struct {
-	// Match map ID
-	MapID int32 `json:"mapId,omitempty"`
-	// Match creation time. Designates when the team select lobby is created and/or the match is made through match making, not when the game actually starts.
-	MatchCreation int64 `json:"matchCreation,omitempty"`
-	// Match duration
-	MatchDuration int64 `json:"matchDuration,omitempty"`
-	// ID of the match
-	MatchID int64 `json:"matchId,omitempty"`
-	// Match mode (Legal values: CLASSIC, ODIN, ARAM, TUTORIAL, ONEFORALL, ASCENSION, FIRSTBLOOD, KINGPORO)
-	MatchMode string `json:"matchMode,omitempty"`
-	// Match type (Legal values: CUSTOM_GAME, MATCHED_GAME, TUTORIAL_GAME)
-	MatchType string `json:"matchType,omitempty"`
-	// Match version
-	MatchVersion string `json:"matchVersion,omitempty"`
-	// Participant identity information
-	ParticipantIdentities []*MatchParticipantIdentity `json:"participantIdentities,omitempty"`
-	// Participant information
-	Participants []*MatchParticipant `json:"participants,omitempty"`
-	// Platform ID of the match
-	PlatformID string `json:"platformId,omitempty"`
-	// Match queue type (Legal values: CUSTOM, NORMAL_5x5_BLIND, RANKED_SOLO_5x5, RANKED_PREMADE_5x5, BOT_5x5, NORMAL_3x3, RANKED_PREMADE_3x3, NORMAL_5x5_DRAFT, ODIN_5x5_BLIND, ODIN_5x5_DRAFT, BOT_ODIN_5x5, BOT_5x5_INTRO, BOT_5x5_BEGINNER, BOT_5x5_INTERMEDIATE, RANKED_TEAM_3x3, RANKED_TEAM_5x5, BOT_TT_3x3, GROUP_FINDER_5x5, ARAM_5x5, ONEFORALL_5x5, FIRSTBLOOD_1x1, FIRSTBLOOD_2x2, SR_6x6, URF_5x5, ONEFORALL_MIRRORMODE_5x5, BOT_URF_5x5, NIGHTMARE_BOT_5x5_RANK1, NIGHTMARE_BOT_5x5_RANK2, NIGHTMARE_BOT_5x5_RANK5, ASCENSION_5x5, HEXAKILL, BILGEWATER_ARAM_5x5, KING_PORO_5x5, COUNTER_PICK, BILGEWATER_5x5)
-	QueueType string `json:"queueType,omitempty"`
-	// Region where the match was played
-	Region string `json:"region,omitempty"`
-	// Season match was played (Legal values: PRESEASON3, SEASON3, PRESEASON2014, SEASON2014, PRESEASON2015, SEASON2015, PRESEASON2016, SEASON2016)
-	Season string `json:"season,omitempty"`
-	// Team information
-	Teams []*Team `json:"teams,omitempty"`
-	// Match timeline data (not included by default)
-	Timeline *Timeline `json:"timeline,omitempty"`
-}
-
-// PlayerStatsSummaryListDto - This object contains a collection of player stats summary information.
-//
-// resource: "stats", original name: "PlayerStatsSummaryListDto"
-type PlayerStatsSummaryList struct {
-	// Collection of player stats summaries associated with the summoner.
-	PlayerStatSummaries []*PlayerStatsSummary `json:"playerStatSummaries,omitempty"`
-	// Summoner ID.
-	SummonerID int64 `json:"summonerId,omitempty"`
-}
-
-// MasteryDto - This object contains mastery information.
-//
-// resource: "summoner", original name: "MasteryDto"
-type SummonerMastery struct {
-	// Mastery ID. For static information correlating to masteries, please refer to the LoL Static Data API.
-	ID int32 `json:"id,omitempty"`
-	// Mastery rank (i.e., the number of points put into this mastery).
-	Rank int32 `json:"rank,omitempty"`
-}
-
-// GoldDto - This object contains item gold data.
-//
-// resource: "lol-static-data", original name: "GoldDto"
-type Gold struct {
-	Base        int32 `json:"base,omitempty"`
-	Purchasable bool  `json:"purchasable,omitempty"`
-	Sell        int32 `json:"sell,omitempty"`
-	Total       int32 `json:"total,omitempty"`
-}
-
-// FeaturedGames
-//
-// resource: "featured-games", original name: "FeaturedGames"
-type FeaturedGames struct {
-	// The suggested interval to wait before requesting FeaturedGames again
-	ClientRefreshInterval int64 `json:"clientRefreshInterval,omitempty"`
-	// The list of featured games
-	GameList []*FeaturedGameInfo `json:"gameList,omitempty"`
-}
-
-// RecommendedDto - This object contains champion recommended data.
-//
-// resource: "lol-static-data", original name: "RecommendedDto"
-type Recommended struct {
-	Blocks   []*Block `json:"blocks,omitempty"`
-	Champion string   `json:"champion,omitempty"`
-	Map      string   `json:"map,omitempty"`
-	Mode     string   `json:"mode,omitempty"`
-	Priority bool     `json:"priority,omitempty"`
-	Title    string   `json:"title,omitempty"`
-	Type     string   `json:"type,omitempty"`
-}
-
-// Rune
-//
-// resource: "current-game", original name: "Rune"
-type CurrentRune struct {
-	// The count of this rune used by the participant
-	Count int32 `json:"count,omitempty"`
-	// The ID of the rune
-	RuneID int32 `json:"runeId,omitempty"`
-}
-
-// Observer
-//
-// resource: "featured-games", original name: "Observer"
-type FeaturedGameObserver struct {
-	// Key used to decrypt the spectator grid game data for playback
-	EncryptionKey string `json:"encryptionKey,omitempty"`
-}
-
-// RawStatsDto - This object contains raw stat information.
-//
-// resource: "game", original name: "RawStatsDto"
-type RawStats struct {
-	Assists int32 `json:"assists,omitempty"`
-	// Number of enemy inhibitors killed.
-	BarracksKilled        int32 `json:"barracksKilled,omitempty"`
-	ChampionsKilled       int32 `json:"championsKilled,omitempty"`
-	CombatPlayerScore     int32 `json:"combatPlayerScore,omitempty"`
-	ConsumablesPurchased  int32 `json:"consumablesPurchased,omitempty"`
-	DamageDealtPlayer     int32 `json:"damageDealtPlayer,omitempty"`
-	DoubleKills           int32 `json:"doubleKills,omitempty"`
-	FirstBlood            int32 `json:"firstBlood,omitempty"`
-	Gold                  int32 `json:"gold,omitempty"`
-	GoldEarned            int32 `json:"goldEarned,omitempty"`
-	GoldSpent             int32 `json:"goldSpent,omitempty"`
-	Item0                 int32 `json:"item0,omitempty"`
-	Item1                 int32 `json:"item1,omitempty"`
-	Item2                 int32 `json:"item2,omitempty"`
-	Item3                 int32 `json:"item3,omitempty"`
-	Item4                 int32 `json:"item4,omitempty"`
-	Item5                 int32 `json:"item5,omitempty"`
-	Item6                 int32 `json:"item6,omitempty"`
-	ItemsPurchased        int32 `json:"itemsPurchased,omitempty"`
-	KillingSprees         int32 `json:"killingSprees,omitempty"`
-	LargestCriticalStrike int32 `json:"largestCriticalStrike,omitempty"`
-	LargestKillingSpree   int32 `json:"largestKillingSpree,omitempty"`
-	LargestMultiKill      int32 `json:"largestMultiKill,omitempty"`
-	// Number of tier 3 items built.
-	LegendaryItemsCreated           int32 `json:"legendaryItemsCreated,omitempty"`
-	Level                           int32 `json:"level,omitempty"`
-	MagicDamageDealtPlayer          int32 `json:"magicDamageDealtPlayer,omitempty"`
-	MagicDamageDealtToChampions     int32 `json:"magicDamageDealtToChampions,omitempty"`
-	MagicDamageTaken                int32 `json:"magicDamageTaken,omitempty"`
-	MinionsDenied                   int32 `json:"minionsDenied,omitempty"`
-	MinionsKilled                   int32 `json:"minionsKilled,omitempty"`
-	NeutralMinionsKilled            int32 `json:"neutralMinionsKilled,omitempty"`
-	NeutralMinionsKilledEnemyJungle int32 `json:"neutralMinionsKilledEnemyJungle,omitempty"`
-	NeutralMinionsKilledYourJungle  int32 `json:"neutralMinionsKilledYourJungle,omitempty"`
-	// Flag specifying if the summoner got the killing blow on the nexus.
-	NexusKilled                    bool  `json:"nexusKilled,omitempty"`
-	NodeCapture                    int32 `json:"nodeCapture,omitempty"`
-	NodeCaptureAssist              int32 `json:"nodeCaptureAssist,omitempty"`
-	NodeNeutralize                 int32 `json:"nodeNeutralize,omitempty"`
-	NodeNeutralizeAssist           int32 `json:"nodeNeutralizeAssist,omitempty"`
-	NumDeaths                      int32 `json:"numDeaths,omitempty"`
-	NumItemsBought                 int32 `json:"numItemsBought,omitempty"`
-	ObjectivePlayerScore           int32 `json:"objectivePlayerScore,omitempty"`
-	PentaKills                     int32 `json:"pentaKills,omitempty"`
-	PhysicalDamageDealtPlayer      int32 `json:"physicalDamageDealtPlayer,omitempty"`
-	PhysicalDamageDealtToChampions int32 `json:"physicalDamageDealtToChampions,omitempty"`
-	PhysicalDamageTaken            int32 `json:"physicalDamageTaken,omitempty"`
-	// Player position (Legal values: TOP(1), MIDDLE(2), JUNGLE(3), BOT(4))
-	PlayerPosition int32 `json:"playerPosition,omitempty"`
-	// Player role (Legal values: DUO(1), SUPPORT(2), CARRY(3), SOLO(4))
-	PlayerRole       int32 `json:"playerRole,omitempty"`
-	QuadraKills      int32 `json:"quadraKills,omitempty"`
-	SightWardsBought int32 `json:"sightWardsBought,omitempty"`
-	// Number of times first champion spell was cast.
-	Spell1Cast int32 `json:"spell1Cast,omitempty"`
-	// Number of times second champion spell was cast.
-	Spell2Cast int32 `json:"spell2Cast,omitempty"`
-	// Number of times third champion spell was cast.
-	Spell3Cast int32 `json:"spell3Cast,omitempty"`
-	// Number of times fourth champion spell was cast.
-	Spell4Cast                  int32 `json:"spell4Cast,omitempty"`
-	SummonSpell1Cast            int32 `json:"summonSpell1Cast,omitempty"`
-	SummonSpell2Cast            int32 `json:"summonSpell2Cast,omitempty"`
-	SuperMonsterKilled          int32 `json:"superMonsterKilled,omitempty"`
-	Team                        int32 `json:"team,omitempty"`
-	TeamObjective               int32 `json:"teamObjective,omitempty"`
-	TimePlayed                  int32 `json:"timePlayed,omitempty"`
-	TotalDamageDealt            int32 `json:"totalDamageDealt,omitempty"`
-	TotalDamageDealtToChampions int32 `json:"totalDamageDealtToChampions,omitempty"`
-	TotalDamageTaken            int32 `json:"totalDamageTaken,omitempty"`
-	TotalHeal                   int32 `json:"totalHeal,omitempty"`
-	TotalPlayerScore            int32 `json:"totalPlayerScore,omitempty"`
-	TotalScoreRank              int32 `json:"totalScoreRank,omitempty"`
-	TotalTimeCrowdControlDealt  int32 `json:"totalTimeCrowdControlDealt,omitempty"`
-	TotalUnitsHealed            int32 `json:"totalUnitsHealed,omitempty"`
-	TripleKills                 int32 `json:"tripleKills,omitempty"`
-	TrueDamageDealtPlayer       int32 `json:"trueDamageDealtPlayer,omitempty"`
-	TrueDamageDealtToChampions  int32 `json:"trueDamageDealtToChampions,omitempty"`
-	TrueDamageTaken             int32 `json:"trueDamageTaken,omitempty"`
-	TurretsKilled               int32 `json:"turretsKilled,omitempty"`
-	UnrealKills                 int32 `json:"unrealKills,omitempty"`
-	VictoryPointTotal           int32 `json:"victoryPointTotal,omitempty"`
-	VisionWardsBought           int32 `json:"visionWardsBought,omitempty"`
-	WardKilled                  int32 `json:"wardKilled,omitempty"`
-	WardPlaced                  int32 `json:"wardPlaced,omitempty"`
-	// Flag specifying whether or not this game was won.
-	Win bool `json:"win,omitempty"`
-}
-
-// TeamDto - This object contains team information.
-//
-// resource: "team", original name: "TeamDto"
-type RankTeam struct {
-	// Date that team was created specified as epoch milliseconds.
-	CreateDate int64  `json:"createDate,omitempty"`
-	FullID     string `json:"fullId,omitempty"`
-	// Date that last game played by team ended specified as epoch milliseconds.
-	LastGameDate int64 `json:"lastGameDate,omitempty"`
-	// Date that last member joined specified as epoch milliseconds.
-	LastJoinDate int64 `json:"lastJoinDate,omitempty"`
-	// Date that team last joined the ranked team queue specified as epoch milliseconds.
-	LastJoinedRankedTeamQueueDate int64                  `json:"lastJoinedRankedTeamQueueDate,omitempty"`
-	MatchHistory                  []*MatchHistorySummary `json:"matchHistory,omitempty"`
-	// Date that team was last modified specified as epoch milliseconds.
-	ModifyDate int64   `json:"modifyDate,omitempty"`
-	Name       string  `json:"name,omitempty"`
-	Roster     *Roster `json:"roster,omitempty"`
-	// Date that second to last member joined specified as epoch milliseconds.
-	SecondLastJoinDate int64                 `json:"secondLastJoinDate,omitempty"`
-	Status             string                `json:"status,omitempty"`
-	Tag                string                `json:"tag,omitempty"`
-	TeamStatDetails    []*RankTeamStatDetail `json:"teamStatDetails,omitempty"`
-	// Date that third to last member joined specified as epoch milliseconds.
-	ThirdLastJoinDate int64 `json:"thirdLastJoinDate,omitempty"`
-}
-
-// RuneDto - This object contains rune data.
-//
-// resource: "lol-static-data", original name: "RuneDto"
-type Rune struct {
-	Colloq               string          `json:"colloq,omitempty"`
-	ConsumeOnFull        bool            `json:"consumeOnFull,omitempty"`
-	Consumed             bool            `json:"consumed,omitempty"`
-	Depth                int32           `json:"depth,omitempty"`
-	Description          string          `json:"description,omitempty"`
-	From                 []string        `json:"from,omitempty"`
-	Group                string          `json:"group,omitempty"`
-	HideFromAll          bool            `json:"hideFromAll,omitempty"`
-	ID                   int32           `json:"id,omitempty"`
-	Image                *Image          `json:"image,omitempty"`
-	InStore              bool            `json:"inStore,omitempty"`
-	Into                 []string        `json:"into,omitempty"`
-	Maps                 map[string]bool `json:"maps,omitempty"`
-	Name                 string          `json:"name,omitempty"`
-	Plaintext            string          `json:"plaintext,omitempty"`
-	RequiredChampion     string          `json:"requiredChampion,omitempty"`
-	Rune                 *MetaData       `json:"rune,omitempty"`
-	SanitizedDescription string          `json:"sanitizedDescription,omitempty"`
-	SpecialRecipe        int32           `json:"specialRecipe,omitempty"`
-	Stacks               int32           `json:"stacks,omitempty"`
-	Stats                *BasicDataStats `json:"stats,omitempty"`
-	Tags                 []string        `json:"tags,omitempty"`
-}
-
-// MasteryDto - This object contains mastery data.
-//
-// resource: "lol-static-data", original name: "MasteryDto"
-type Mastery struct {
-	Description []string `json:"description,omitempty"`
-	ID          int32    `json:"id,omitempty"`
-	Image       *Image   `json:"image,omitempty"`
-	// Legal values: Cunning, Ferocity, Resolve
-	MasteryTree          string   `json:"masteryTree,omitempty"`
-	Name                 string   `json:"name,omitempty"`
-	Prereq               string   `json:"prereq,omitempty"`
-	Ranks                int32    `json:"ranks,omitempty"`
-	SanitizedDescription []string `json:"sanitizedDescription,omitempty"`
-}
-
-// Player - This object contains match player information
-//
-// resource: "match", original name: "Player"
-type MatchPlayer struct {
-	// Match history URI
-	MatchHistoryURI string `json:"matchHistoryUri,omitempty"`
-	// Profile icon ID
-	ProfileIcon int32 `json:"profileIcon,omitempty"`
-	// Summoner ID
-	SummonerID int64 `json:"summonerId,omitempty"`
-	// Summoner name
-	SummonerName string `json:"summonerName,omitempty"`
-}
-
-// RosterDto - This object contains roster information.
-//
-// resource: "team", original name: "RosterDto"
-type Roster struct {
-	MemberList []*RankTeamMemberInfo `json:"memberList,omitempty"`
-	OwnerID    int64                 `json:"ownerId,omitempty"`
-}
-
-// BannedChampion
-//
-// resource: "featured-games", original name: "BannedChampion"
-type FeaturedGameBannedChampion struct {
-	// The ID of the banned champion
-	ChampionID int32 `json:"championId,omitempty"`
-	// The turn during which the champion was banned
-	PickTurn int32 `json:"pickTurn,omitempty"`
-	// The ID of the team that banned the champion
-	TeamID int64 `json:"teamId,omitempty"`
-}
-
-// Frame - This object contains game frame information
-//
-// resource: "match", original name: "Frame"
-type Frame struct {
-	// List of events for this frame.
-	Events []*Event `json:"events,omitempty"`
-	// Map of each participant ID to the participant's information for the frame.
-	ParticipantFrames map[string]*ParticipantFrame `json:"participantFrames,omitempty"`
-	// Represents how many milliseconds into the game the frame occurred.
-	Timestamp int64 `json:"timestamp,omitempty"`
-}
-
-// BannedChampion - This object contains information about banned champions
-//
-// resource: "match", original name: "BannedChampion"
-type BannedChampion struct {
-	// Banned champion ID
-	ChampionID int32 `json:"championId,omitempty"`
-	// Turn during which the champion was banned
-	PickTurn int32 `json:"pickTurn,omitempty"`
-}
-
-// MasteryPageDto - This object contains mastery page information.
-//
-// resource: "summoner", original name: "MasteryPageDto"
-type MasteryPage struct {
-	// Indicates if the mastery page is the current mastery page.
-	Current bool `json:"current,omitempty"`
-	// Mastery page ID.
-	ID int64 `json:"id,omitempty"`
-	// Collection of masteries associated with the mastery page.
-	Masteries []*SummonerMastery `json:"masteries,omitempty"`
-	// Mastery page name.
-	Name string `json:"name,omitempty"`
-}
-
-// TeamStatDetailDto - This object contains team statistics detail information.
-//
-// resource: "team", original name: "TeamStatDetailDto"
-type RankTeamStatDetail struct {
-	AverageGamesPlayed int32  `json:"averageGamesPlayed,omitempty"`
-	Losses             int32  `json:"losses,omitempty"`
-	TeamStatType       string `json:"teamStatType,omitempty"`
-	Wins               int32  `json:"wins,omitempty"`
-}
-
-// SummonerSpellListDto - This object contains summoner spell list data.
-//
-// resource: "lol-static-data", original name: "SummonerSpellListDto"
-type SummonerSpellList struct {
-	Data    map[string]*SummonerSpell `json:"data,omitempty"`
-	Type    string                    `json:"type,omitempty"`
-	Version string                    `json:"version,omitempty"`
-}
-
-// GroupDto - This object contains item group data.
-//
-// resource: "lol-static-data", original name: "GroupDto"
-type Group struct {
-	MaxGroupOwnable string `json:"MaxGroupOwnable,omitempty"`
-	Key             string `json:"key,omitempty"`
-}
-
-// Service
-//
-// resource: "lol-status", original name: "Service"
-type Service struct {
-	Incidents []*Incident `json:"incidents,omitempty"`
-	Name      string      `json:"name,omitempty"`
-	Slug      string      `json:"slug,omitempty"`
-	// Legal values: Online, Alert, Offline, Deploying
-	Status string `json:"status,omitempty"`
-}
-
-// LanguageStringsDto - This object contains language strings data.
-//
-// resource: "lol-static-data", original name: "LanguageStringsDto"
-type LanguageStrings struct {
-	Data    map[string]string `json:"data,omitempty"`
-	Type    string            `json:"type,omitempty"`
-	Version string            `json:"version,omitempty"`
-}
-
-// Message
-//
-// resource: "lol-status", original name: "Message"
-type Message struct {
-	Author    string `json:"author,omitempty"`
-	Content   string `json:"content,omitempty"`
-	CreatedAt string `json:"created_at,omitempty"`
-	ID        int64  `json:"id,omitempty"`
-	// Legal values: Info, Alert, Error
-	Severity     string         `json:"severity,omitempty"`
-	Translations []*Translation `json:"translations,omitempty"`
-	UpdatedAt    string         `json:"updated_at,omitempty"`
-}
-
-// ChampionDatasCall is a builder for "ChampionDatas"
-type ChampionDatasCall struct {
-	ctx        context.Context
-	client     *Client
-	query      url.Values
-	pathParams map[string]string
-	region     Region
-}
-
-// Retrieves champion list.
-//
-//
-// Implementation notes: Not all data specified below is returned by default. See the champData parameter for more information.
-//
-// Rate limit notes: Requests to this API will not be counted in your Rate Limit.
-//
-// GET: https://global.api.pvp.net/api/lol/static-data/{region}/v1.2/champion
-//
-// Reference: https://developer.riotgames.com/api/methods#!/1055/3633
-func (c *Client) ChampionDatas(ctx context.Context, region Region) *ChampionDatasCall {
-	path := make(map[string]string)
-	return &ChampionDatasCall{ctx: ctx, client: c, query: make(url.Values), pathParams: path, region: region}
-}
-
-// locale configures query parameter "locale".
-func (c *ChampionDatasCall) Locale(v string) *ChampionDatasCall {
-	c.query.Set("locale", convertToString(v))
-	return c
-}
-
-// version configures query parameter "version".
-func (c *ChampionDatasCall) Version(v string) *ChampionDatasCall {
-	c.query.Set("version", convertToString(v))
-	return c
-}
-
-// dataByID configures query parameter "dataById".
-func (c *ChampionDatasCall) DataByID(v bool) *ChampionDatasCall {
-	c.query.Set("dataByID", convertToString(v))
-	return c
-}
-
-// champData configures query parameter "champData".
-func (c *ChampionDatasCall) ChampData(v string) *ChampionDatasCall {
-	c.query.Set("champData", convertToString(v))
-	return c
-}
-
-func (c *ChampionDatasCall) doRequest() (*http.Response, error) {
-	var body io.Reader
-	switch c.region {
-	case BR, EUNE, EUW, KR, LAN, LAS, NA, OCE, PBE, RU, TR:
-	default:
-		return nil, ErrNotSupportedRegion
-	}
-	c.query.Set("api_key", c.client.apiKey)
-	c.pathParams["region"] = c.region.Name()
-
-	path, err := uritemplates.Expand("/api/lol/static-data/{region}/v1.2/champion", c.pathParams)
-	if err != nil {
-		return nil, err
-	}
-	urls := "https://global.api.pvp.net" + path + "?" + c.query.Encode()
-
-	return c.client.doRequest(c.ctx, "GET", urls, body)
-}
-
-// Do executes api request.
-//
-// API Errors:
-//  400 - Bad request
-//  401 - Unauthorized
-//  404 - Data not found
-//  429 - Rate limit exceeded
-//  500 - Internal server error
-//  503 - Service unavailable
-func (c *ChampionDatasCall) Do() (*ChampionDataList, error) {
-	res, err := c.doRequest()
-	if err != nil {
-		return nil, err
-	}
-	defer closeBody(res)
-	if err := verifyAPIResponse(res); err != nil {
-		return nil, err
-	}
-	ret := &ChampionDataList{}
-	if err := json.NewDecoder(res.Body).Decode(&ret); err != nil {
-		return nil, err
-	}
-	return ret, nil
-}
-
-// ChampionDataCall is a builder for "ChampionData"
-type ChampionDataCall struct {
-	ctx        context.Context
-	client     *Client
-	query      url.Values
-	pathParams map[string]string
-	region     Region
-}
-
-// Retrieves a champion by its id.
-//
-//
-// Implementation notes: Not all data specified below is returned by default. See the champData parameter for more information.
-//
-// Rate limit notes: Requests to this API will not be counted in your Rate Limit.
-//
-// GET: https://global.api.pvp.net/api/lol/static-data/{region}/v1.2/champion/{id}
-//
-// Reference: https://developer.riotgames.com/api/methods#!/1055/3622
-func (c *Client) ChampionData(ctx context.Context, region Region, id int32) *ChampionDataCall {
-	path := make(map[string]string)
-	path["id"] = convertToString(id)
-	return &ChampionDataCall{ctx: ctx, client: c, query: make(url.Values), pathParams: path, region: region}
-}
-
-// locale configures query parameter "locale".
-func (c *ChampionDataCall) Locale(v string) *ChampionDataCall {
-	c.query.Set("locale", convertToString(v))
-	return c
-}
-
-// version configures query parameter "version".
-func (c *ChampionDataCall) Version(v string) *ChampionDataCall {
-	c.query.Set("version", convertToString(v))
-	return c
-}
-
-// champData configures query parameter "champData".
-func (c *ChampionDataCall) ChampData(v string) *ChampionDataCall {
-	c.query.Set("champData", convertToString(v))
-	return c
-}
-
-func (c *ChampionDataCall) doRequest() (*http.Response, error) {
-	var body io.Reader
-	switch c.region {
-	case BR, EUNE, EUW, KR, LAN, LAS, NA, OCE, PBE, RU, TR:
-	default:
-		return nil, ErrNotSupportedRegion
-	}
-	c.query.Set("api_key", c.client.apiKey)
-	c.pathParams["region"] = c.region.Name()
-
-	path, err := uritemplates.Expand("/api/lol/static-data/{region}/v1.2/champion/{id}", c.pathParams)
-	if err != nil {
-		return nil, err
-	}
-	urls := "https://global.api.pvp.net" + path + "?" + c.query.Encode()
-
-	return c.client.doRequest(c.ctx, "GET", urls, body)
-}
-
-// Do executes api request.
-//
-// API Errors:
-//  400 - Bad request
-//  401 - Unauthorized
-//  404 - Champion not found
-//  429 - Rate limit exceeded
-//  500 - Internal server error
-//  503 - Service unavailable
-func (c *ChampionDataCall) Do() (*ChampionData, error) {
-	res, err := c.doRequest()
-	if err != nil {
-		return nil, err
-	}
-	defer closeBody(res)
-	if err := verifyAPIResponse(res); err != nil {
-		return nil, err
-	}
-	ret := &ChampionData{}
-	if err := json.NewDecoder(res.Body).Decode(&ret); err != nil {
-		return nil, err
-	}
-	return ret, nil
-}
-
-// ItemsCall is a builder for "Items"
-type ItemsCall struct {
-	ctx        context.Context
-	client     *Client
-	query      url.Values
-	pathParams map[string]string
-	region     Region
-}
-
-// Retrieves item list.
-//
-//
-// Implementation notes: Not all data specified below is returned by default. See the itemListData parameter for more information.
-//
-// Rate limit notes: Requests to this API will not be counted in your Rate Limit.
-//
-// GET: https://global.api.pvp.net/api/lol/static-data/{region}/v1.2/item
-//
-// Reference: https://developer.riotgames.com/api/methods#!/1055/3621
-func (c *Client) Items(ctx context.Context, region Region) *ItemsCall {
-	path := make(map[string]string)
-	return &ItemsCall{ctx: ctx, client: c, query: make(url.Values), pathParams: path, region: region}
-}
-
-// locale configures query parameter "locale".
-func (c *ItemsCall) Locale(v string) *ItemsCall {
-	c.query.Set("locale", convertToString(v))
-	return c
-}
-
-// version configures query parameter "version".
-func (c *ItemsCall) Version(v string) *ItemsCall {
-	c.query.Set("version", convertToString(v))
-	return c
-}
-
-// itemListData configures query parameter "itemListData".
-func (c *ItemsCall) ItemListData(v string) *ItemsCall {
-	c.query.Set("itemListData", convertToString(v))
-	return c
-}
-
-func (c *ItemsCall) doRequest() (*http.Response, error) {
-	var body io.Reader
-	switch c.region {
-	case BR, EUNE, EUW, KR, LAN, LAS, NA, OCE, PBE, RU, TR:
-	default:
-		return nil, ErrNotSupportedRegion
-	}
-	c.query.Set("api_key", c.client.apiKey)
-	c.pathParams["region"] = c.region.Name()
-
-	path, err := uritemplates.Expand("/api/lol/static-data/{region}/v1.2/item", c.pathParams)
-	if err != nil {
-		return nil, err
-	}
-	urls := "https://global.api.pvp.net" + path + "?" + c.query.Encode()
-
-	return c.client.doRequest(c.ctx, "GET", urls, body)
-}
-
-// Do executes api request.
-//
-// API Errors:
-//  400 - Bad request
-//  401 - Unauthorized
-//  404 - Data not found
-//  429 - Rate limit exceeded
-//  500 - Internal server error
-//  503 - Service unavailable
-func (c *ItemsCall) Do() (*ItemList, error) {
-	res, err := c.doRequest()
-	if err != nil {
-		return nil, err
-	}
-	defer closeBody(res)
-	if err := verifyAPIResponse(res); err != nil {
-		return nil, err
-	}
-	ret := &ItemList{}
-	if err := json.NewDecoder(res.Body).Decode(&ret); err != nil {
-		return nil, err
-	}
-	return ret, nil
-}
-
-// ItemCall is a builder for "Item"
-type ItemCall struct {
-	ctx        context.Context
-	client     *Client
-	query      url.Values
-	pathParams map[string]string
-	region     Region
-}
-
-// Retrieves item by its unique id.
-//
-//
-// Implementation notes: Not all data specified below is returned by default. See the itemData parameter for more information.
-//
-// Rate limit notes: Requests to this API will not be counted in your Rate Limit.
-//
-// GET: https://global.api.pvp.net/api/lol/static-data/{region}/v1.2/item/{id}
-//
-// Reference: https://developer.riotgames.com/api/methods#!/1055/3627
-func (c *Client) Item(ctx context.Context, region Region, id int32) *ItemCall {
-	path := make(map[string]string)
-	path["id"] = convertToString(id)
-	return &ItemCall{ctx: ctx, client: c, query: make(url.Values), pathParams: path, region: region}
-}
-
-// locale configures query parameter "locale".
-func (c *ItemCall) Locale(v string) *ItemCall {
-	c.query.Set("locale", convertToString(v))
-	return c
-}
-
-// version configures query parameter "version".
-func (c *ItemCall) Version(v string) *ItemCall {
-	c.query.Set("version", convertToString(v))
-	return c
-}
-
-// itemData configures query parameter "itemData".
-func (c *ItemCall) ItemData(v string) *ItemCall {
-	c.query.Set("itemData", convertToString(v))
-	return c
-}
-
-func (c *ItemCall) doRequest() (*http.Response, error) {
-	var body io.Reader
-	switch c.region {
-	case BR, EUNE, EUW, KR, LAN, LAS, NA, OCE, PBE, RU, TR:
-	default:
-		return nil, ErrNotSupportedRegion
-	}
-	c.query.Set("api_key", c.client.apiKey)
-	c.pathParams["region"] = c.region.Name()
-
-	path, err := uritemplates.Expand("/api/lol/static-data/{region}/v1.2/item/{id}", c.pathParams)
-	if err != nil {
-		return nil, err
-	}
-	urls := "https://global.api.pvp.net" + path + "?" + c.query.Encode()
-
-	return c.client.doRequest(c.ctx, "GET", urls, body)
-}
-
-// Do executes api request.
-//
-// API Errors:
-//  400 - Bad request
-//  401 - Unauthorized
-//  404 - Item not found
-//  429 - Rate limit exceeded
-//  500 - Internal server error
-//  503 - Service unavailable
-func (c *ItemCall) Do() (*Item, error) {
-	res, err := c.doRequest()
-	if err != nil {
-		return nil, err
-	}
-	defer closeBody(res)
-	if err := verifyAPIResponse(res); err != nil {
-		return nil, err
-	}
-	ret := &Item{}
-	if err := json.NewDecoder(res.Body).Decode(&ret); err != nil {
-		return nil, err
-	}
-	return ret, nil
-}
-
-// LanguageStringsCall is a builder for "LanguageStrings"
-type LanguageStringsCall struct {
-	ctx        context.Context
-	client     *Client
-	query      url.Values
-	pathParams map[string]string
-	region     Region
-}
-
-// Retrieve language strings data.
-//
-//
-// Rate limit notes: Requests to this API will not be counted in your Rate Limit.
-//
-// GET: https://global.api.pvp.net/api/lol/static-data/{region}/v1.2/language-strings
-//
-// Reference: https://developer.riotgames.com/api/methods#!/1055/3624
-func (c *Client) LanguageStrings(ctx context.Context, region Region) *LanguageStringsCall {
-	path := make(map[string]string)
-	return &LanguageStringsCall{ctx: ctx, client: c, query: make(url.Values), pathParams: path, region: region}
-}
-
-// locale configures query parameter "locale".
-func (c *LanguageStringsCall) Locale(v string) *LanguageStringsCall {
-	c.query.Set("locale", convertToString(v))
-	return c
-}
-
-// version configures query parameter "version".
-func (c *LanguageStringsCall) Version(v string) *LanguageStringsCall {
-	c.query.Set("version", convertToString(v))
-	return c
-}
-
-func (c *LanguageStringsCall) doRequest() (*http.Response, error) {
-	var body io.Reader
-	switch c.region {
-	case BR, EUNE, EUW, KR, LAN, LAS, NA, OCE, PBE, RU, TR:
-	default:
-		return nil, ErrNotSupportedRegion
-	}
-	c.query.Set("api_key", c.client.apiKey)
-	c.pathParams["region"] = c.region.Name()
-
-	path, err := uritemplates.Expand("/api/lol/static-data/{region}/v1.2/language-strings", c.pathParams)
-	if err != nil {
-		return nil, err
-	}
-	urls := "https://global.api.pvp.net" + path + "?" + c.query.Encode()
-
-	return c.client.doRequest(c.ctx, "GET", urls, body)
-}
-
-// Do executes api request.
-//
-// API Errors:
-//  400 - Bad request
-//  401 - Unauthorized
-//  404 - Data not found
-//  429 - Rate limit exceeded
-//  500 - Internal server error
-//  503 - Service unavailable
-func (c *LanguageStringsCall) Do() (*LanguageStrings, error) {
-	res, err := c.doRequest()
-	if err != nil {
-		return nil, err
-	}
-	defer closeBody(res)
-	if err := verifyAPIResponse(res); err != nil {
-		return nil, err
-	}
-	ret := &LanguageStrings{}
-	if err := json.NewDecoder(res.Body).Decode(&ret); err != nil {
-		return nil, err
-	}
-	return ret, nil
-}
-
-// LanguagesCall is a builder for "Languages"
-type LanguagesCall struct {
-	ctx        context.Context
-	client     *Client
-	query      url.Values
-	pathParams map[string]string
-	region     Region
-}
-
-// Retrieve supported languages data.
-//
-//
-// Rate limit notes: Requests to this API will not be counted in your Rate Limit.
-//
-// GET: https://global.api.pvp.net/api/lol/static-data/{region}/v1.2/languages
-//
-// Reference: https://developer.riotgames.com/api/methods#!/1055/3631
-func (c *Client) Languages(ctx context.Context, region Region) *LanguagesCall {
-	path := make(map[string]string)
-	return &LanguagesCall{ctx: ctx, client: c, query: make(url.Values), pathParams: path, region: region}
-}
-
-func (c *LanguagesCall) doRequest() (*http.Response, error) {
-	var body io.Reader
-	switch c.region {
-	case BR, EUNE, EUW, KR, LAN, LAS, NA, OCE, PBE, RU, TR:
-	default:
-		return nil, ErrNotSupportedRegion
-	}
-	c.query.Set("api_key", c.client.apiKey)
-	c.pathParams["region"] = c.region.Name()
-
-	path, err := uritemplates.Expand("/api/lol/static-data/{region}/v1.2/languages", c.pathParams)
-	if err != nil {
-		return nil, err
-	}
-	urls := "https://global.api.pvp.net" + path + "?" + c.query.Encode()
-
-	return c.client.doRequest(c.ctx, "GET", urls, body)
-}
-
-// Do executes api request.
-//
-// API Errors:
-//  400 - Bad request
-//  401 - Unauthorized
-//  404 - Data not found
-//  429 - Rate limit exceeded
-//  500 - Internal server error
-//  503 - Service unavailable
-func (c *LanguagesCall) Do() ([]string, error) {
-	res, err := c.doRequest()
-	if err != nil {
-		return nil, err
-	}
-	defer closeBody(res)
-	if err := verifyAPIResponse(res); err != nil {
-		return nil, err
-	}
-	ret := make([]string, 0)
-	if err := json.NewDecoder(res.Body).Decode(&ret); err != nil {
-		return nil, err
-	}
-	return ret, nil
-}
-
-// MapsCall is a builder for "Maps"
-type MapsCall struct {
-	ctx        context.Context
-	client     *Client
-	query      url.Values
-	pathParams map[string]string
-	region     Region
-}
-
-// Retrieve map data.
-//
-//
-// Rate limit notes: Requests to this API will not be counted in your Rate Limit.
-//
-// GET: https://global.api.pvp.net/api/lol/static-data/{region}/v1.2/map
-//
-// Reference: https://developer.riotgames.com/api/methods#!/1055/3635
-func (c *Client) Maps(ctx context.Context, region Region) *MapsCall {
-	path := make(map[string]string)
-	return &MapsCall{ctx: ctx, client: c, query: make(url.Values), pathParams: path, region: region}
-}
-
-// locale configures query parameter "locale".
-func (c *MapsCall) Locale(v string) *MapsCall {
-	c.query.Set("locale", convertToString(v))
-	return c
-}
-
-// version configures query parameter "version".
-func (c *MapsCall) Version(v string) *MapsCall {
-	c.query.Set("version", convertToString(v))
-	return c
-}
-
-func (c *MapsCall) doRequest() (*http.Response, error) {
-	var body io.Reader
-	switch c.region {
-	case BR, EUNE, EUW, KR, LAN, LAS, NA, OCE, PBE, RU, TR:
-	default:
-		return nil, ErrNotSupportedRegion
-	}
-	c.query.Set("api_key", c.client.apiKey)
-	c.pathParams["region"] = c.region.Name()
-
-	path, err := uritemplates.Expand("/api/lol/static-data/{region}/v1.2/map", c.pathParams)
-	if err != nil {
-		return nil, err
-	}
-	urls := "https://global.api.pvp.net" + path + "?" + c.query.Encode()
-
-	return c.client.doRequest(c.ctx, "GET", urls, body)
-}
-
-// Do executes api request.
-//
-// API Errors:
-//  400 - Bad request
-//  401 - Unauthorized
-//  404 - Data not found
-//  429 - Rate limit exceeded
-//  500 - Internal server error
-//  503 - Service unavailable
-func (c *MapsCall) Do() (*MapData, error) {
-	res, err := c.doRequest()
-	if err != nil {
-		return nil, err
-	}
-	defer closeBody(res)
-	if err := verifyAPIResponse(res); err != nil {
-		return nil, err
-	}
-	ret := &MapData{}
-	if err := json.NewDecoder(res.Body).Decode(&ret); err != nil {
-		return nil, err
-	}
-	return ret, nil
-}
-
-// MasteriesCall is a builder for "Masteries"
-type MasteriesCall struct {
-	ctx        context.Context
-	client     *Client
-	query      url.Values
-	pathParams map[string]string
-	region     Region
-}
-
-// Retrieves mastery list.
-//
-//
-// Implementation notes: Not all data specified below is returned by default. See the masteryListData parameter for more information.
-//
-// Rate limit notes: Requests to this API will not be counted in your Rate Limit.
-//
-// GET: https://global.api.pvp.net/api/lol/static-data/{region}/v1.2/mastery
-//
-// Reference: https://developer.riotgames.com/api/methods#!/1055/3625
-func (c *Client) Masteries(ctx context.Context, region Region) *MasteriesCall {
-	path := make(map[string]string)
-	return &MasteriesCall{ctx: ctx, client: c, query: make(url.Values), pathParams: path, region: region}
-}
-
-// locale configures query parameter "locale".
-func (c *MasteriesCall) Locale(v string) *MasteriesCall {
-	c.query.Set("locale", convertToString(v))
-	return c
-}
-
-// version configures query parameter "version".
-func (c *MasteriesCall) Version(v string) *MasteriesCall {
-	c.query.Set("version", convertToString(v))
-	return c
-}
-
-// masteryListData configures query parameter "masteryListData".
-func (c *MasteriesCall) MasteryListData(v string) *MasteriesCall {
-	c.query.Set("masteryListData", convertToString(v))
-	return c
-}
-
-func (c *MasteriesCall) doRequest() (*http.Response, error) {
-	var body io.Reader
-	switch c.region {
-	case BR, EUNE, EUW, KR, LAN, LAS, NA, OCE, PBE, RU, TR:
-	default:
-		return nil, ErrNotSupportedRegion
-	}
-	c.query.Set("api_key", c.client.apiKey)
-	c.pathParams["region"] = c.region.Name()
-
-	path, err := uritemplates.Expand("/api/lol/static-data/{region}/v1.2/mastery", c.pathParams)
-	if err != nil {
-		return nil, err
-	}
-	urls := "https://global.api.pvp.net" + path + "?" + c.query.Encode()
-
-	return c.client.doRequest(c.ctx, "GET", urls, body)
-}
-
-// Do executes api request.
-//
-// API Errors:
-//  400 - Bad request
-//  401 - Unauthorized
-//  404 - Data not found
-//  429 - Rate limit exceeded
-//  500 - Internal server error
-//  503 - Service unavailable
-func (c *MasteriesCall) Do() (*MasteryList, error) {
-	res, err := c.doRequest()
-	if err != nil {
-		return nil, err
-	}
-	defer closeBody(res)
-	if err := verifyAPIResponse(res); err != nil {
-		return nil, err
-	}
-	ret := &MasteryList{}
-	if err := json.NewDecoder(res.Body).Decode(&ret); err != nil {
-		return nil, err
-	}
-	return ret, nil
-}
-
-// MasteryCall is a builder for "Mastery"
-type MasteryCall struct {
-	ctx        context.Context
-	client     *Client
-	query      url.Values
-	pathParams map[string]string
-	region     Region
-}
-
-// Retrieves mastery item by its unique id.
-//
-//
-// Implementation notes: Not all data specified below is returned by default. See the masteryData parameter for more information.
-//
-// Rate limit notes: Requests to this API will not be counted in your Rate Limit.
-//
-// GET: https://global.api.pvp.net/api/lol/static-data/{region}/v1.2/mastery/{id}
-//
-// Reference: https://developer.riotgames.com/api/methods#!/1055/3626
-func (c *Client) Mastery(ctx context.Context, region Region, id int32) *MasteryCall {
-	path := make(map[string]string)
-	path["id"] = convertToString(id)
-	return &MasteryCall{ctx: ctx, client: c, query: make(url.Values), pathParams: path, region: region}
-}
-
-// locale configures query parameter "locale".
-func (c *MasteryCall) Locale(v string) *MasteryCall {
-	c.query.Set("locale", convertToString(v))
-	return c
-}
-
-// version configures query parameter "version".
-func (c *MasteryCall) Version(v string) *MasteryCall {
-	c.query.Set("version", convertToString(v))
-	return c
-}
-
-// masteryData configures query parameter "masteryData".
-func (c *MasteryCall) MasteryData(v string) *MasteryCall {
-	c.query.Set("masteryData", convertToString(v))
-	return c
-}
-
-func (c *MasteryCall) doRequest() (*http.Response, error) {
-	var body io.Reader
-	switch c.region {
-	case BR, EUNE, EUW, KR, LAN, LAS, NA, OCE, PBE, RU, TR:
-	default:
-		return nil, ErrNotSupportedRegion
-	}
-	c.query.Set("api_key", c.client.apiKey)
-	c.pathParams["region"] = c.region.Name()
-
-	path, err := uritemplates.Expand("/api/lol/static-data/{region}/v1.2/mastery/{id}", c.pathParams)
-	if err != nil {
-		return nil, err
-	}
-	urls := "https://global.api.pvp.net" + path + "?" + c.query.Encode()
-
-	return c.client.doRequest(c.ctx, "GET", urls, body)
-}
-
-// Do executes api request.
-//
-// API Errors:
-//  400 - Bad request
-//  401 - Unauthorized
-//  404 - Mastery not found
-//  429 - Rate limit exceeded
-//  500 - Internal server error
-//  503 - Service unavailable
-func (c *MasteryCall) Do() (*Mastery, error) {
-	res, err := c.doRequest()
-	if err != nil {
-		return nil, err
-	}
-	defer closeBody(res)
-	if err := verifyAPIResponse(res); err != nil {
-		return nil, err
-	}
-	ret := &Mastery{}
-	if err := json.NewDecoder(res.Body).Decode(&ret); err != nil {
-		return nil, err
-	}
-	return ret, nil
-}
-
-// RealmCall is a builder for "Realm"
-type RealmCall struct {
-	ctx        context.Context
-	client     *Client
-	query      url.Values
-	pathParams map[string]string
-	region     Region
-}
-
-// Retrieve realm data.
-//
-//
-// Rate limit notes: Requests to this API will not be counted in your Rate Limit.
-//
-// GET: https://global.api.pvp.net/api/lol/static-data/{region}/v1.2/realm
-//
-// Reference: https://developer.riotgames.com/api/methods#!/1055/3632
-func (c *Client) Realm(ctx context.Context, region Region) *RealmCall {
-	path := make(map[string]string)
-	return &RealmCall{ctx: ctx, client: c, query: make(url.Values), pathParams: path, region: region}
-}
-
-func (c *RealmCall) doRequest() (*http.Response, error) {
-	var body io.Reader
-	switch c.region {
-	case BR, EUNE, EUW, KR, LAN, LAS, NA, OCE, PBE, RU, TR:
-	default:
-		return nil, ErrNotSupportedRegion
-	}
-	c.query.Set("api_key", c.client.apiKey)
-	c.pathParams["region"] = c.region.Name()
-
-	path, err := uritemplates.Expand("/api/lol/static-data/{region}/v1.2/realm", c.pathParams)
-	if err != nil {
-		return nil, err
-	}
-	urls := "https://global.api.pvp.net" + path + "?" + c.query.Encode()
-
-	return c.client.doRequest(c.ctx, "GET", urls, body)
-}
-
-// Do executes api request.
-//
-// API Errors:
-//  400 - Bad request
-//  401 - Unauthorized
-//  404 - Data not found
-//  429 - Rate limit exceeded
-//  500 - Internal server error
-//  503 - Service unavailable
-func (c *RealmCall) Do() (*Realm, error) {
-	res, err := c.doRequest()
-	if err != nil {
-		return nil, err
-	}
-	defer closeBody(res)
-	if err := verifyAPIResponse(res); err != nil {
-		return nil, err
-	}
-	ret := &Realm{}
-	if err := json.NewDecoder(res.Body).Decode(&ret); err != nil {
-		return nil, err
-	}
-	return ret, nil
-}
-
-// RunesCall is a builder for "Runes"
-type RunesCall struct {
-	ctx        context.Context
-	client     *Client
-	query      url.Values
-	pathParams map[string]string
-	region     Region
-}
-
-// Retrieves rune list.
-//
-//
-// Implementation notes: Not all data specified below is returned by default. See the runeListData parameter for more information.
-//
-// Rate limit notes: Requests to this API will not be counted in your Rate Limit.
-//
-// GET: https://global.api.pvp.net/api/lol/static-data/{region}/v1.2/rune
-//
-// Reference: https://developer.riotgames.com/api/methods#!/1055/3623
-func (c *Client) Runes(ctx context.Context, region Region) *RunesCall {
-	path := make(map[string]string)
-	return &RunesCall{ctx: ctx, client: c, query: make(url.Values), pathParams: path, region: region}
-}
-
-// locale configures query parameter "locale".
-func (c *RunesCall) Locale(v string) *RunesCall {
-	c.query.Set("locale", convertToString(v))
-	return c
-}
-
-// version configures query parameter "version".
-func (c *RunesCall) Version(v string) *RunesCall {
-	c.query.Set("version", convertToString(v))
-	return c
-}
-
-// runeListData configures query parameter "runeListData".
-func (c *RunesCall) RuneListData(v string) *RunesCall {
-	c.query.Set("runeListData", convertToString(v))
-	return c
-}
-
-func (c *RunesCall) doRequest() (*http.Response, error) {
-	var body io.Reader
-	switch c.region {
-	case BR, EUNE, EUW, KR, LAN, LAS, NA, OCE, PBE, RU, TR:
-	default:
-		return nil, ErrNotSupportedRegion
-	}
-	c.query.Set("api_key", c.client.apiKey)
-	c.pathParams["region"] = c.region.Name()
-
-	path, err := uritemplates.Expand("/api/lol/static-data/{region}/v1.2/rune", c.pathParams)
-	if err != nil {
-		return nil, err
-	}
-	urls := "https://global.api.pvp.net" + path + "?" + c.query.Encode()
-
-	return c.client.doRequest(c.ctx, "GET", urls, body)
-}
-
-// Do executes api request.
-//
-// API Errors:
-//  400 - Bad request
-//  401 - Unauthorized
-//  404 - Data not found
-//  429 - Rate limit exceeded
-//  500 - Internal server error
-//  503 - Service unavailable
-func (c *RunesCall) Do() (*RuneList, error) {
-	res, err := c.doRequest()
-	if err != nil {
-		return nil, err
-	}
-	defer closeBody(res)
-	if err := verifyAPIResponse(res); err != nil {
-		return nil, err
-	}
-	ret := &RuneList{}
-	if err := json.NewDecoder(res.Body).Decode(&ret); err != nil {
-		return nil, err
-	}
-	return ret, nil
-}
-
-// RuneCall is a builder for "Rune"
-type RuneCall struct {
-	ctx        context.Context
-	client     *Client
-	query      url.Values
-	pathParams map[string]string
-	region     Region
-}
-
-// Retrieves rune by its unique id.
-//
-//
-// Implementation notes: Not all data specified below is returned by default. See the runeData parameter for more information.
-//
-// Rate limit notes: Requests to this API will not be counted in your Rate Limit.
-//
-// GET: https://global.api.pvp.net/api/lol/static-data/{region}/v1.2/rune/{id}
-//
-// Reference: https://developer.riotgames.com/api/methods#!/1055/3629
-func (c *Client) Rune(ctx context.Context, region Region, id int32) *RuneCall {
-	path := make(map[string]string)
-	path["id"] = convertToString(id)
-	return &RuneCall{ctx: ctx, client: c, query: make(url.Values), pathParams: path, region: region}
-}
-
-// locale configures query parameter "locale".
-func (c *RuneCall) Locale(v string) *RuneCall {
-	c.query.Set("locale", convertToString(v))
-	return c
-}
-
-// version configures query parameter "version".
-func (c *RuneCall) Version(v string) *RuneCall {
-	c.query.Set("version", convertToString(v))
-	return c
-}
-
-// runeData configures query parameter "runeData".
-func (c *RuneCall) RuneData(v string) *RuneCall {
-	c.query.Set("runeData", convertToString(v))
-	return c
-}
-
-func (c *RuneCall) doRequest() (*http.Response, error) {
-	var body io.Reader
-	switch c.region {
-	case BR, EUNE, EUW, KR, LAN, LAS, NA, OCE, PBE, RU, TR:
-	default:
-		return nil, ErrNotSupportedRegion
-	}
-	c.query.Set("api_key", c.client.apiKey)
-	c.pathParams["region"] = c.region.Name()
-
-	path, err := uritemplates.Expand("/api/lol/static-data/{region}/v1.2/rune/{id}", c.pathParams)
-	if err != nil {
-		return nil, err
-	}
-	urls := "https://global.api.pvp.net" + path + "?" + c.query.Encode()
-
-	return c.client.doRequest(c.ctx, "GET", urls, body)
-}
-
-// Do executes api request.
-//
-// API Errors:
-//  400 - Bad request
-//  401 - Unauthorized
-//  404 - Rune not found
-//  429 - Rate limit exceeded
-//  500 - Internal server error
-//  503 - Service unavailable
-func (c *RuneCall) Do() (*Rune, error) {
-	res, err := c.doRequest()
-	if err != nil {
-		return nil, err
-	}
-	defer closeBody(res)
-	if err := verifyAPIResponse(res); err != nil {
-		return nil, err
-	}
-	ret := &Rune{}
-	if err := json.NewDecoder(res.Body).Decode(&ret); err != nil {
-		return nil, err
-	}
-	return ret, nil
-}
-
-// SummonerSpellsCall is a builder for "SummonerSpells"
-type SummonerSpellsCall struct {
-	ctx        context.Context
-	client     *Client
-	query      url.Values
-	pathParams map[string]string
-	region     Region
-}
-
-// Retrieves summoner spell list.
-//
-//
-// Implementation notes: Not all data specified below is returned by default. See the spellData parameter for more information.
-//
-// Rate limit notes: Requests to this API will not be counted in your Rate Limit.
-//
-// GET: https://global.api.pvp.net/api/lol/static-data/{region}/v1.2/summoner-spell
-//
-// Reference: https://developer.riotgames.com/api/methods#!/1055/3634
-func (c *Client) SummonerSpells(ctx context.Context, region Region) *SummonerSpellsCall {
-	path := make(map[string]string)
-	return &SummonerSpellsCall{ctx: ctx, client: c, query: make(url.Values), pathParams: path, region: region}
-}
-
-// locale configures query parameter "locale".
-func (c *SummonerSpellsCall) Locale(v string) *SummonerSpellsCall {
-	c.query.Set("locale", convertToString(v))
-	return c
-}
-
-// version configures query parameter "version".
-func (c *SummonerSpellsCall) Version(v string) *SummonerSpellsCall {
-	c.query.Set("version", convertToString(v))
-	return c
-}
-
-// dataByID configures query parameter "dataById".
-func (c *SummonerSpellsCall) DataByID(v bool) *SummonerSpellsCall {
-	c.query.Set("dataByID", convertToString(v))
-	return c
-}
-
-// spellData configures query parameter "spellData".
-func (c *SummonerSpellsCall) SpellData(v string) *SummonerSpellsCall {
-	c.query.Set("spellData", convertToString(v))
-	return c
-}
-
-func (c *SummonerSpellsCall) doRequest() (*http.Response, error) {
-	var body io.Reader
-	switch c.region {
-	case BR, EUNE, EUW, KR, LAN, LAS, NA, OCE, PBE, RU, TR:
-	default:
-		return nil, ErrNotSupportedRegion
-	}
-	c.query.Set("api_key", c.client.apiKey)
-	c.pathParams["region"] = c.region.Name()
-
-	path, err := uritemplates.Expand("/api/lol/static-data/{region}/v1.2/summoner-spell", c.pathParams)
-	if err != nil {
-		return nil, err
-	}
-	urls := "https://global.api.pvp.net" + path + "?" + c.query.Encode()
-
-	return c.client.doRequest(c.ctx, "GET", urls, body)
-}
-
-// Do executes api request.
-//
-// API Errors:
-//  400 - Bad request
-//  401 - Unauthorized
-//  404 - Data not found
-//  429 - Rate limit exceeded
-//  500 - Internal server error
-//  503 - Service unavailable
-func (c *SummonerSpellsCall) Do() (*SummonerSpellList, error) {
-	res, err := c.doRequest()
-	if err != nil {
-		return nil, err
-	}
-	defer closeBody(res)
-	if err := verifyAPIResponse(res); err != nil {
-		return nil, err
-	}
-	ret := &SummonerSpellList{}
-	if err := json.NewDecoder(res.Body).Decode(&ret); err != nil {
-		return nil, err
-	}
-	return ret, nil
-}
-
-// SummonerSpellCall is a builder for "SummonerSpell"
-type SummonerSpellCall struct {
-	ctx        context.Context
-	client     *Client
-	query      url.Values
-	pathParams map[string]string
-	region     Region
-}
-
-// Retrieves summoner spell by its unique id.
-//
-//
-// Implementation notes: Not all data specified below is returned by default. See the spellData parameter for more information.
-//
-// Rate limit notes: Requests to this API will not be counted in your Rate Limit.
-//
-// GET: https://global.api.pvp.net/api/lol/static-data/{region}/v1.2/summoner-spell/{id}
-//
-// Reference: https://developer.riotgames.com/api/methods#!/1055/3628
-func (c *Client) SummonerSpell(ctx context.Context, region Region, id int32) *SummonerSpellCall {
-	path := make(map[string]string)
-	path["id"] = convertToString(id)
-	return &SummonerSpellCall{ctx: ctx, client: c, query: make(url.Values), pathParams: path, region: region}
-}
-
-// locale configures query parameter "locale".
-func (c *SummonerSpellCall) Locale(v string) *SummonerSpellCall {
-	c.query.Set("locale", convertToString(v))
-	return c
-}
-
-// version configures query parameter "version".
-func (c *SummonerSpellCall) Version(v string) *SummonerSpellCall {
-	c.query.Set("version", convertToString(v))
-	return c
-}
-
-// spellData configures query parameter "spellData".
-func (c *SummonerSpellCall) SpellData(v string) *SummonerSpellCall {
-	c.query.Set("spellData", convertToString(v))
-	return c
-}
-
-func (c *SummonerSpellCall) doRequest() (*http.Response, error) {
-	var body io.Reader
-	switch c.region {
-	case BR, EUNE, EUW, KR, LAN, LAS, NA, OCE, PBE, RU, TR:
-	default:
-		return nil, ErrNotSupportedRegion
-	}
-	c.query.Set("api_key", c.client.apiKey)
-	c.pathParams["region"] = c.region.Name()
-
-	path, err := uritemplates.Expand("/api/lol/static-data/{region}/v1.2/summoner-spell/{id}", c.pathParams)
-	if err != nil {
-		return nil, err
-	}
-	urls := "https://global.api.pvp.net" + path + "?" + c.query.Encode()
-
-	return c.client.doRequest(c.ctx, "GET", urls, body)
-}
-
-// Do executes api request.
-//
-// API Errors:
-//  400 - Bad request
-//  401 - Unauthorized
-//  404 - Spell not found
-//  429 - Rate limit exceeded
-//  500 - Internal server error
-//  503 - Service unavailable
-func (c *SummonerSpellCall) Do() (*SummonerSpell, error) {
-	res, err := c.doRequest()
-	if err != nil {
-		return nil, err
-	}
-	defer closeBody(res)
-	if err := verifyAPIResponse(res); err != nil {
-		return nil, err
-	}
-	ret := &SummonerSpell{}
-	if err := json.NewDecoder(res.Body).Decode(&ret); err != nil {
-		return nil, err
-	}
-	return ret, nil
-}
-
-// VersionsCall is a builder for "Versions"
-type VersionsCall struct {
-	ctx        context.Context
-	client     *Client
-	query      url.Values
-	pathParams map[string]string
-	region     Region
-}
-
-// Retrieve version data.
-//
-//
-// Rate limit notes: Requests to this API will not be counted in your Rate Limit.
-//
-// GET: https://global.api.pvp.net/api/lol/static-data/{region}/v1.2/versions
-//
-// Reference: https://developer.riotgames.com/api/methods#!/1055/3630
-func (c *Client) Versions(ctx context.Context, region Region) *VersionsCall {
-	path := make(map[string]string)
-	return &VersionsCall{ctx: ctx, client: c, query: make(url.Values), pathParams: path, region: region}
-}
-
-func (c *VersionsCall) doRequest() (*http.Response, error) {
-	var body io.Reader
-	switch c.region {
-	case BR, EUNE, EUW, KR, LAN, LAS, NA, OCE, PBE, RU, TR:
-	default:
-		return nil, ErrNotSupportedRegion
-	}
-	c.query.Set("api_key", c.client.apiKey)
-	c.pathParams["region"] = c.region.Name()
-
-	path, err := uritemplates.Expand("/api/lol/static-data/{region}/v1.2/versions", c.pathParams)
-	if err != nil {
-		return nil, err
-	}
-	urls := "https://global.api.pvp.net" + path + "?" + c.query.Encode()
-
-	return c.client.doRequest(c.ctx, "GET", urls, body)
-}
-
-// Do executes api request.
-//
-// API Errors:
-//  400 - Bad request
-//  401 - Unauthorized
-//  404 - Data not found
-//  429 - Rate limit exceeded
-//  500 - Internal server error
-//  503 - Service unavailable
-func (c *VersionsCall) Do() ([]string, error) {
-	res, err := c.doRequest()
-	if err != nil {
-		return nil, err
-	}
-	defer closeBody(res)
-	if err := verifyAPIResponse(res); err != nil {
-		return nil, err
-	}
-	ret := make([]string, 0)
-	if err := json.NewDecoder(res.Body).Decode(&ret); err != nil {
-		return nil, err
-	}
-	return ret, nil
-}
-
-// ChampionsCall is a builder for "Champions"
-type ChampionsCall struct {
-	ctx        context.Context
-	client     *Client
-	query      url.Values
-	pathParams map[string]string
-	region     Region
-}
-
-// Retrieve all champions.
-//
-//
-// GET: /api/lol/{region}/v1.2/champion
-//
-// Reference: https://developer.riotgames.com/api/methods#!/1015/3444
-func (c *Client) Champions(ctx context.Context, region Region) *ChampionsCall {
-	path := make(map[string]string)
-	return &ChampionsCall{ctx: ctx, client: c, query: make(url.Values), pathParams: path, region: region}
-}
-
-// freeToPlay configures query parameter "freeToPlay".
-func (c *ChampionsCall) FreeToPlay(v bool) *ChampionsCall {
-	c.query.Set("freeToPlay", convertToString(v))
-	return c
-}
-
-func (c *ChampionsCall) doRequest() (*http.Response, error) {
-	var body io.Reader
-	switch c.region {
-	case BR, EUNE, EUW, KR, LAN, LAS, NA, OCE, RU, TR:
-	default:
-		return nil, ErrNotSupportedRegion
-	}
-	c.query.Set("api_key", c.client.apiKey)
-	c.pathParams["region"] = c.region.Name()
-
-	path, err := uritemplates.Expand("/api/lol/{region}/v1.2/champion", c.pathParams)
-	if err != nil {
-		return nil, err
-	}
-	urls := c.region.baseURL() + path + "?" + c.query.Encode()
-
-	return c.client.doRequest(c.ctx, "GET", urls, body)
-}
-
-// Do executes api request.
-//
-// API Errors:
-//  400 - Bad request
-//  401 - Unauthorized
-//  429 - Rate limit exceeded
-//  500 - Internal server error
-//  503 - Service unavailable
-func (c *ChampionsCall) Do() (*ChampionList, error) {
-	res, err := c.doRequest()
-	if err != nil {
-		return nil, err
-	}
-	defer closeBody(res)
-	if err := verifyAPIResponse(res); err != nil {
-		return nil, err
-	}
-	ret := &ChampionList{}
-	if err := json.NewDecoder(res.Body).Decode(&ret); err != nil {
-		return nil, err
-	}
-	return ret, nil
-}
-
-// ChampionCall is a builder for "Champion"
-type ChampionCall struct {
-	ctx        context.Context
-	client     *Client
-	query      url.Values
-	pathParams map[string]string
-	region     Region
-}
-
-// Retrieve champion by ID.
-//
-//
-// GET: /api/lol/{region}/v1.2/champion/{id}
-//
-// Reference: https://developer.riotgames.com/api/methods#!/1015/3443
-func (c *Client) Champion(ctx context.Context, region Region, id int32) *ChampionCall {
-	path := make(map[string]string)
-	path["id"] = convertToString(id)
-	return &ChampionCall{ctx: ctx, client: c, query: make(url.Values), pathParams: path, region: region}
-}
-
-func (c *ChampionCall) doRequest() (*http.Response, error) {
-	var body io.Reader
-	switch c.region {
-	case BR, EUNE, EUW, KR, LAN, LAS, NA, OCE, RU, TR:
-	default:
-		return nil, ErrNotSupportedRegion
-	}
-	c.query.Set("api_key", c.client.apiKey)
-	c.pathParams["region"] = c.region.Name()
-
-	path, err := uritemplates.Expand("/api/lol/{region}/v1.2/champion/{id}", c.pathParams)
-	if err != nil {
-		return nil, err
-	}
-	urls := c.region.baseURL() + path + "?" + c.query.Encode()
-
-	return c.client.doRequest(c.ctx, "GET", urls, body)
-}
-
-// Do executes api request.
-//
-// API Errors:
-//  400 - Bad request
-//  401 - Unauthorized
-//  429 - Rate limit exceeded
-//  500 - Internal server error
-//  503 - Service unavailable
-func (c *ChampionCall) Do() (*Champion, error) {
-	res, err := c.doRequest()
-	if err != nil {
-		return nil, err
-	}
-	defer closeBody(res)
-	if err := verifyAPIResponse(res); err != nil {
-		return nil, err
-	}
-	ret := &Champion{}
-	if err := json.NewDecoder(res.Body).Decode(&ret); err != nil {
-		return nil, err
-	}
-	return ret, nil
-}
-
-// SpectatorGameInfoCall is a builder for "SpectatorGameInfo"
-type SpectatorGameInfoCall struct {
-	ctx        context.Context
-	client     *Client
-	query      url.Values
-	pathParams map[string]string
-	region     Region
-}
-
-// Get current game information for the given summoner ID.
-//
-//
-// GET: /observer-mode/rest/consumer/getSpectatorGameInfo/{platformId}/{summonerId}
-//
-// Reference: https://developer.riotgames.com/api/methods#!/976/3336
-func (c *Client) SpectatorGameInfo(ctx context.Context, region Region, summonerID int64) *SpectatorGameInfoCall {
-	path := make(map[string]string)
-	path["summonerId"] = convertToString(summonerID)
-	return &SpectatorGameInfoCall{ctx: ctx, client: c, query: make(url.Values), pathParams: path, region: region}
-}
-
-func (c *SpectatorGameInfoCall) doRequest() (*http.Response, error) {
-	var body io.Reader
-	switch c.region {
-	case BR, EUNE, EUW, KR, LAN, LAS, NA, OCE, PBE, RU, TR:
-	default:
-		return nil, ErrNotSupportedRegion
-	}
-	c.query.Set("api_key", c.client.apiKey)
-
-	path, err := uritemplates.Expand("/observer-mode/rest/consumer/getSpectatorGameInfo/{platformId}/{summonerId}", c.pathParams)
-	if err != nil {
-		return nil, err
-	}
-	urls := c.region.baseURL() + path + "?" + c.query.Encode()
-
-	return c.client.doRequest(c.ctx, "GET", urls, body)
-}
-
-// Do executes api request.
-//
-// API Errors:
-//  403 - Forbidden
-//  429 - Rate limit exceeded
-func (c *SpectatorGameInfoCall) Do() (*CurrentGameInfo, error) {
-	res, err := c.doRequest()
-	if err != nil {
-		return nil, err
-	}
-	defer closeBody(res)
-	if err := verifyAPIResponse(res); err != nil {
-		return nil, err
-	}
-	ret := &CurrentGameInfo{}
-	if err := json.NewDecoder(res.Body).Decode(&ret); err != nil {
-		return nil, err
-	}
-	return ret, nil
-}
-
-// FeaturedGamesCall is a builder for "FeaturedGames"
-type FeaturedGamesCall struct {
-	ctx        context.Context
-	client     *Client
-	query      url.Values
-	pathParams map[string]string
-	region     Region
-}
-
-// Get list of featured games.
-//
-//
-// GET: /observer-mode/rest/featured
-//
-// Reference: https://developer.riotgames.com/api/methods#!/977/3337
-func (c *Client) FeaturedGames(ctx context.Context, region Region) *FeaturedGamesCall {
-	path := make(map[string]string)
-	return &FeaturedGamesCall{ctx: ctx, client: c, query: make(url.Values), pathParams: path, region: region}
-}
-
-func (c *FeaturedGamesCall) doRequest() (*http.Response, error) {
-	var body io.Reader
-	switch c.region {
-	case BR, EUNE, EUW, KR, LAN, LAS, NA, OCE, PBE, RU, TR:
-	default:
-		return nil, ErrNotSupportedRegion
-	}
-	c.query.Set("api_key", c.client.apiKey)
-
-	path, err := uritemplates.Expand("/observer-mode/rest/featured", c.pathParams)
-	if err != nil {
-		return nil, err
-	}
-	urls := c.region.baseURL() + path + "?" + c.query.Encode()
-
-	return c.client.doRequest(c.ctx, "GET", urls, body)
-}
-
-// Do executes api request.
-//
-// API Errors:
-//  403 - Forbidden
-//  429 - Rate limit exceeded
-func (c *FeaturedGamesCall) Do() (*FeaturedGames, error) {
-	res, err := c.doRequest()
-	if err != nil {
-		return nil, err
-	}
-	defer closeBody(res)
-	if err := verifyAPIResponse(res); err != nil {
-		return nil, err
-	}
-	ret := &FeaturedGames{}
-	if err := json.NewDecoder(res.Body).Decode(&ret); err != nil {
-		return nil, err
-	}
-	return ret, nil
-}
-
-// RecentGamesCall is a builder for "RecentGames"
-type RecentGamesCall struct {
-	ctx        context.Context
-	client     *Client
-	query      url.Values
-	pathParams map[string]string
-	region     Region
-}
-
-// Get recent games by summoner ID.
-//
-//
-// GET: /api/lol/{region}/v1.3/game/by-summoner/{summonerId}/recent
-//
-// Reference: https://developer.riotgames.com/api/methods#!/1016/3445
-func (c *Client) RecentGames(ctx context.Context, region Region, summonerID int64) *RecentGamesCall {
-	path := make(map[string]string)
-	path["summonerId"] = convertToString(summonerID)
-	return &RecentGamesCall{ctx: ctx, client: c, query: make(url.Values), pathParams: path, region: region}
-}
-
-func (c *RecentGamesCall) doRequest() (*http.Response, error) {
-	var body io.Reader
-	switch c.region {
-	case BR, EUNE, EUW, KR, LAN, LAS, NA, OCE, RU, TR:
-	default:
-		return nil, ErrNotSupportedRegion
-	}
-	c.query.Set("api_key", c.client.apiKey)
-	c.pathParams["region"] = c.region.Name()
-
-	path, err := uritemplates.Expand("/api/lol/{region}/v1.3/game/by-summoner/{summonerId}/recent", c.pathParams)
-	if err != nil {
-		return nil, err
-	}
-	urls := c.region.baseURL() + path + "?" + c.query.Encode()
-
-	return c.client.doRequest(c.ctx, "GET", urls, body)
-}
-
-// Do executes api request.
-//
-// API Errors:
-//  400 - Bad request
-//  401 - Unauthorized
-//  404 - Game data not found
-//  429 - Rate limit exceeded
-//  500 - Internal server error
-//  503 - Service unavailable
-func (c *RecentGamesCall) Do() (*RecentGames, error) {
-	res, err := c.doRequest()
-	if err != nil {
-		return nil, err
-	}
-	defer closeBody(res)
-	if err := verifyAPIResponse(res); err != nil {
-		return nil, err
-	}
-	ret := &RecentGames{}
-	if err := json.NewDecoder(res.Body).Decode(&ret); err != nil {
-		return nil, err
-	}
-	return ret, nil
-}
-
-// LeaguesBySummonerIDCall is a builder for "LeaguesBySummonerID"
-type LeaguesBySummonerIDCall struct {
-	ctx        context.Context
-	client     *Client
-	query      url.Values
-	pathParams map[string]string
-	region     Region
-}
-
-// Get leagues mapped by summoner ID for a given list of summoner IDs.
-//
-//
-// Implementation notes: Returns all leagues for specified summoners and summoners' teams. Entries for each requested participant (i.e., each summoner and related teams) will be included in the returned leagues data, whether or not the participant is inactive. However, no entries for other inactive summoners or teams in the leagues will be included.
-//
-// GET: /api/lol/{region}/v2.5/league/by-summoner/{summonerIds}
-//
-// Reference: https://developer.riotgames.com/api/methods#!/985/3351
-func (c *Client) LeaguesBySummonerID(ctx context.Context, region Region, summonerIDs []int64) *LeaguesBySummonerIDCall {
-	path := make(map[string]string)
-	path["summonerIds"] = convertToString(summonerIDs)
-	return &LeaguesBySummonerIDCall{ctx: ctx, client: c, query: make(url.Values), pathParams: path, region: region}
-}
-
-func (c *LeaguesBySummonerIDCall) doRequest() (*http.Response, error) {
-	var body io.Reader
-	switch c.region {
-	case BR, EUNE, EUW, KR, LAN, LAS, NA, OCE, RU, TR:
-	default:
-		return nil, ErrNotSupportedRegion
-	}
-	c.query.Set("api_key", c.client.apiKey)
-	c.pathParams["region"] = c.region.Name()
-
-	path, err := uritemplates.Expand("/api/lol/{region}/v2.5/league/by-summoner/{summonerIds}", c.pathParams)
-	if err != nil {
-		return nil, err
-	}
-	urls := c.region.baseURL() + path + "?" + c.query.Encode()
-
-	return c.client.doRequest(c.ctx, "GET", urls, body)
-}
-
-// Do executes api request.
-//
-// API Errors:
-//  400 - Bad request
-//  401 - Unauthorized
-//  404 - League not found
-//  429 - Rate limit exceeded
-//  500 - Internal server error
-//  503 - Service unavailable
-func (c *LeaguesBySummonerIDCall) Do() (map[string][]*League, error) {
-	res, err := c.doRequest()
-	if err != nil {
-		return nil, err
-	}
-	defer closeBody(res)
-	if err := verifyAPIResponse(res); err != nil {
-		return nil, err
-	}
-	ret := make(map[string][]*League)
-	if err := json.NewDecoder(res.Body).Decode(&ret); err != nil {
-		return nil, err
-	}
-	return ret, nil
-}
-
-// LeagueEntriesBySummonerIDCall is a builder for "LeagueEntriesBySummonerID"
-type LeagueEntriesBySummonerIDCall struct {
-	ctx        context.Context
-	client     *Client
-	query      url.Values
-	pathParams map[string]string
-	region     Region
-}
-
-// Get league entries mapped by summoner ID for a given list of summoner IDs.
-//
-//
-// Implementation notes: Returns all league entries for specified summoners and summoners' teams.
-//
-// GET: /api/lol/{region}/v2.5/league/by-summoner/{summonerIds}/entry
-//
-// Reference: https://developer.riotgames.com/api/methods#!/985/3356
-func (c *Client) LeagueEntriesBySummonerID(ctx context.Context, region Region, summonerIDs []int64) *LeagueEntriesBySummonerIDCall {
-	path := make(map[string]string)
-	path["summonerIds"] = convertToString(summonerIDs)
-	return &LeagueEntriesBySummonerIDCall{ctx: ctx, client: c, query: make(url.Values), pathParams: path, region: region}
-}
-
-func (c *LeagueEntriesBySummonerIDCall) doRequest() (*http.Response, error) {
-	var body io.Reader
-	switch c.region {
-	case BR, EUNE, EUW, KR, LAN, LAS, NA, OCE, RU, TR:
-	default:
-		return nil, ErrNotSupportedRegion
-	}
-	c.query.Set("api_key", c.client.apiKey)
-	c.pathParams["region"] = c.region.Name()
-
-	path, err := uritemplates.Expand("/api/lol/{region}/v2.5/league/by-summoner/{summonerIds}/entry", c.pathParams)
-	if err != nil {
-		return nil, err
-	}
-	urls := c.region.baseURL() + path + "?" + c.query.Encode()
-
-	return c.client.doRequest(c.ctx, "GET", urls, body)
-}
-
-// Do executes api request.
-//
-// API Errors:
-//  400 - Bad request
-//  401 - Unauthorized
-//  404 - League not found
-//  429 - Rate limit exceeded
-//  500 - Internal server error
-//  503 - Service unavailable
-func (c *LeagueEntriesBySummonerIDCall) Do() (map[string][]*League, error) {
-	res, err := c.doRequest()
-	if err != nil {
-		return nil, err
-	}
-	defer closeBody(res)
-	if err := verifyAPIResponse(res); err != nil {
-		return nil, err
-	}
-	ret := make(map[string][]*League)
-	if err := json.NewDecoder(res.Body).Decode(&ret); err != nil {
-		return nil, err
-	}
-	return ret, nil
-}
-
-// LeaguesByTeamIDCall is a builder for "LeaguesByTeamID"
-type LeaguesByTeamIDCall struct {
-	ctx        context.Context
-	client     *Client
-	query      url.Values
-	pathParams map[string]string
-	region     Region
-}
-
-// Get leagues mapped by team ID for a given list of team IDs.
-//
-//
-// Implementation notes: Returns all leagues for specified teams. Entries for each requested team will be included in the returned leagues data, whether or not the team is inactive. However, no entries for other inactive teams in the leagues will be included.
-//
-// GET: /api/lol/{region}/v2.5/league/by-team/{teamIds}
-//
-// Reference: https://developer.riotgames.com/api/methods#!/985/3352
-func (c *Client) LeaguesByTeamID(ctx context.Context, region Region, teamIDs []string) *LeaguesByTeamIDCall {
-	path := make(map[string]string)
-	path["teamIds"] = convertToString(teamIDs)
-	return &LeaguesByTeamIDCall{ctx: ctx, client: c, query: make(url.Values), pathParams: path, region: region}
-}
-
-func (c *LeaguesByTeamIDCall) doRequest() (*http.Response, error) {
-	var body io.Reader
-	switch c.region {
-	case BR, EUNE, EUW, KR, LAN, LAS, NA, OCE, RU, TR:
-	default:
-		return nil, ErrNotSupportedRegion
-	}
-	c.query.Set("api_key", c.client.apiKey)
-	c.pathParams["region"] = c.region.Name()
-
-	path, err := uritemplates.Expand("/api/lol/{region}/v2.5/league/by-team/{teamIds}", c.pathParams)
-	if err != nil {
-		return nil, err
-	}
-	urls := c.region.baseURL() + path + "?" + c.query.Encode()
-
-	return c.client.doRequest(c.ctx, "GET", urls, body)
-}
-
-// Do executes api request.
-//
-// API Errors:
-//  400 - Bad request
-//  401 - Unauthorized
-//  404 - League not found
-//  429 - Rate limit exceeded
-//  500 - Internal server error
-//  503 - Service unavailable
-func (c *LeaguesByTeamIDCall) Do() (map[string][]*League, error) {
-	res, err := c.doRequest()
-	if err != nil {
-		return nil, err
-	}
-	defer closeBody(res)
-	if err := verifyAPIResponse(res); err != nil {
-		return nil, err
-	}
-	ret := make(map[string][]*League)
-	if err := json.NewDecoder(res.Body).Decode(&ret); err != nil {
-		return nil, err
-	}
-	return ret, nil
-}
-
-// LeagueEntriesByTeamIDCall is a builder for "LeagueEntriesByTeamID"
-type LeagueEntriesByTeamIDCall struct {
-	ctx        context.Context
-	client     *Client
-	query      url.Values
-	pathParams map[string]string
-	region     Region
-}
-
-// Get league entries mapped by team ID for a given list of team IDs.
-//
-//
-// Implementation notes: Returns all league entries for specified teams.
-//
-// GET: /api/lol/{region}/v2.5/league/by-team/{teamIds}/entry
-//
-// Reference: https://developer.riotgames.com/api/methods#!/985/3355
-func (c *Client) LeagueEntriesByTeamID(ctx context.Context, region Region, teamIDs []string) *LeagueEntriesByTeamIDCall {
-	path := make(map[string]string)
-	path["teamIds"] = convertToString(teamIDs)
-	return &LeagueEntriesByTeamIDCall{ctx: ctx, client: c, query: make(url.Values), pathParams: path, region: region}
-}
-
-func (c *LeagueEntriesByTeamIDCall) doRequest() (*http.Response, error) {
-	var body io.Reader
-	switch c.region {
-	case BR, EUNE, EUW, KR, LAN, LAS, NA, OCE, RU, TR:
-	default:
-		return nil, ErrNotSupportedRegion
-	}
-	c.query.Set("api_key", c.client.apiKey)
-	c.pathParams["region"] = c.region.Name()
-
-	path, err := uritemplates.Expand("/api/lol/{region}/v2.5/league/by-team/{teamIds}/entry", c.pathParams)
-	if err != nil {
-		return nil, err
-	}
-	urls := c.region.baseURL() + path + "?" + c.query.Encode()
-
-	return c.client.doRequest(c.ctx, "GET", urls, body)
-}
-
-// Do executes api request.
-//
-// API Errors:
-//  400 - Bad request
-//  401 - Unauthorized
-//  404 - League not found
-//  429 - Rate limit exceeded
-//  500 - Internal server error
-//  503 - Service unavailable
-func (c *LeagueEntriesByTeamIDCall) Do() (map[string][]*League, error) {
-	res, err := c.doRequest()
-	if err != nil {
-		return nil, err
-	}
-	defer closeBody(res)
-	if err := verifyAPIResponse(res); err != nil {
-		return nil, err
-	}
-	ret := make(map[string][]*League)
-	if err := json.NewDecoder(res.Body).Decode(&ret); err != nil {
-		return nil, err
-	}
-	return ret, nil
-}
-
-// ChallengerCall is a builder for "Challenger"
-type ChallengerCall struct {
-	ctx        context.Context
-	client     *Client
-	query      url.Values
-	pathParams map[string]string
-	region     Region
-}
-
-// Get challenger tier leagues.
-//
-//
-// GET: /api/lol/{region}/v2.5/league/challenger
-//
-// Reference: https://developer.riotgames.com/api/methods#!/985/3353
-func (c *Client) Challenger(ctx context.Context, region Region) *ChallengerCall {
-	path := make(map[string]string)
-	return &ChallengerCall{ctx: ctx, client: c, query: make(url.Values), pathParams: path, region: region}
-}
-
-// type configures query parameter "type".
-func (c *ChallengerCall) Type(v string) *ChallengerCall {
-	c.query.Set("type", convertToString(v))
-	return c
-}
-
-func (c *ChallengerCall) doRequest() (*http.Response, error) {
-	var body io.Reader
-	switch c.region {
-	case BR, EUNE, EUW, KR, LAN, LAS, NA, OCE, RU, TR:
-	default:
-		return nil, ErrNotSupportedRegion
-	}
-	c.query.Set("api_key", c.client.apiKey)
-	c.pathParams["region"] = c.region.Name()
-
-	path, err := uritemplates.Expand("/api/lol/{region}/v2.5/league/challenger", c.pathParams)
-	if err != nil {
-		return nil, err
-	}
-	urls := c.region.baseURL() + path + "?" + c.query.Encode()
-
-	return c.client.doRequest(c.ctx, "GET", urls, body)
-}
-
-// Do executes api request.
-//
-// API Errors:
-//  400 - Bad request
-//  401 - Unauthorized
-//  404 - League not found
-//  429 - Rate limit exceeded
-//  500 - Internal server error
-//  503 - Service unavailable
-func (c *ChallengerCall) Do() (*League, error) {
-	res, err := c.doRequest()
-	if err != nil {
-		return nil, err
-	}
-	defer closeBody(res)
-	if err := verifyAPIResponse(res); err != nil {
-		return nil, err
-	}
-	ret := &League{}
-	if err := json.NewDecoder(res.Body).Decode(&ret); err != nil {
-		return nil, err
-	}
-	return ret, nil
-}
-
-// MasterCall is a builder for "Master"
-type MasterCall struct {
-	ctx        context.Context
-	client     *Client
-	query      url.Values
-	pathParams map[string]string
-	region     Region
-}
-
-// Get master tier leagues.
-//
-//
-// GET: /api/lol/{region}/v2.5/league/master
-//
-// Reference: https://developer.riotgames.com/api/methods#!/985/3354
-func (c *Client) Master(ctx context.Context, region Region) *MasterCall {
-	path := make(map[string]string)
-	return &MasterCall{ctx: ctx, client: c, query: make(url.Values), pathParams: path, region: region}
-}
-
-// type configures query parameter "type".
-func (c *MasterCall) Type(v string) *MasterCall {
-	c.query.Set("type", convertToString(v))
-	return c
-}
-
-func (c *MasterCall) doRequest() (*http.Response, error) {
-	var body io.Reader
-	switch c.region {
-	case BR, EUNE, EUW, KR, LAN, LAS, NA, OCE, RU, TR:
-	default:
-		return nil, ErrNotSupportedRegion
-	}
-	c.query.Set("api_key", c.client.apiKey)
-	c.pathParams["region"] = c.region.Name()
-
-	path, err := uritemplates.Expand("/api/lol/{region}/v2.5/league/master", c.pathParams)
-	if err != nil {
-		return nil, err
-	}
-	urls := c.region.baseURL() + path + "?" + c.query.Encode()
-
-	return c.client.doRequest(c.ctx, "GET", urls, body)
-}
-
-// Do executes api request.
-//
-// API Errors:
-//  400 - Bad request
-//  401 - Unauthorized
-//  404 - League not found
-//  429 - Rate limit exceeded
-//  500 - Internal server error
-//  503 - Service unavailable
-func (c *MasterCall) Do() (*League, error) {
-	res, err := c.doRequest()
-	if err != nil {
-		return nil, err
-	}
-	defer closeBody(res)
-	if err := verifyAPIResponse(res); err != nil {
-		return nil, err
-	}
-	ret := &League{}
-	if err := json.NewDecoder(res.Body).Decode(&ret); err != nil {
-		return nil, err
-	}
-	return ret, nil
-}
-
-// ShardsCall is a builder for "Shards"
-type ShardsCall struct {
-	ctx        context.Context
-	client     *Client
-	query      url.Values
-	pathParams map[string]string
-}
-
-// Get shard list.
-//
-//
-// Rate limit notes: Requests to this API will not be counted in your Rate Limit.
-//
-// GET: http://status.leagueoflegends.com/shards
-//
-// Reference: https://developer.riotgames.com/api/methods#!/908/3143
-func (c *Client) Shards(ctx context.Context) *ShardsCall {
-	path := make(map[string]string)
-	return &ShardsCall{ctx: ctx, client: c, query: make(url.Values), pathParams: path}
-}
-
-func (c *ShardsCall) doRequest() (*http.Response, error) {
-	var body io.Reader
-
-	path, err := uritemplates.Expand("/shards", c.pathParams)
-	if err != nil {
-		return nil, err
-	}
-	urls := "http://status.leagueoflegends.com" + path + "?" + c.query.Encode()
-
-	return c.client.doRequest(c.ctx, "GET", urls, body)
-}
-
-// Do executes api request.
-//
-// API Errors:
-//  403 - Forbidden
-//  429 - Rate limit exceeded
-func (c *ShardsCall) Do() ([]*Shard, error) {
-	res, err := c.doRequest()
-	if err != nil {
-		return nil, err
-	}
-	defer closeBody(res)
-	if err := verifyAPIResponse(res); err != nil {
-		return nil, err
-	}
-	ret := make([]*Shard, 0)
-	if err := json.NewDecoder(res.Body).Decode(&ret); err != nil {
-		return nil, err
-	}
-	return ret, nil
-}
-
-// ShardsInRegionCall is a builder for "ShardsInRegion"
-type ShardsInRegionCall struct {
-	ctx        context.Context
-	client     *Client
-	query      url.Values
-	pathParams map[string]string
-	region     Region
-}
-
-// Get shard status. Returns the data available on the status.leagueoflegends.com website for the given region.
-//
-//
-// Rate limit notes: Requests to this API will not be counted in your Rate Limit.
-//
-// GET: http://status.leagueoflegends.com/shards/{region}
-//
-// Reference: https://developer.riotgames.com/api/methods#!/908/3142
-func (c *Client) ShardsInRegion(ctx context.Context, region Region) *ShardsInRegionCall {
-	path := make(map[string]string)
-	return &ShardsInRegionCall{ctx: ctx, client: c, query: make(url.Values), pathParams: path, region: region}
-}
-
-func (c *ShardsInRegionCall) doRequest() (*http.Response, error) {
-	var body io.Reader
-	switch c.region {
-	case BR, EUNE, EUW, LAN, LAS, NA, OCE, PBE, RU, TR:
-	default:
-		return nil, ErrNotSupportedRegion
-	}
-	c.pathParams["region"] = c.region.Name()
-
-	path, err := uritemplates.Expand("/shards/{region}", c.pathParams)
-	if err != nil {
-		return nil, err
-	}
-	urls := "http://status.leagueoflegends.com" + path + "?" + c.query.Encode()
-
-	return c.client.doRequest(c.ctx, "GET", urls, body)
-}
-
-// Do executes api request.
-//
-// API Errors:
-//  403 - Forbidden
-//  429 - Rate limit exceeded
-func (c *ShardsInRegionCall) Do() (*ShardStatus, error) {
-	res, err := c.doRequest()
-	if err != nil {
-		return nil, err
-	}
-	defer closeBody(res)
-	if err := verifyAPIResponse(res); err != nil {
-		return nil, err
-	}
-	ret := &ShardStatus{}
-	if err := json.NewDecoder(res.Body).Decode(&ret); err != nil {
-		return nil, err
-	}
-	return ret, nil
-}
-
-// MatchesByTournementCall is a builder for "MatchesByTournement"
-type MatchesByTournementCall struct {
-	ctx        context.Context
-	client     *Client
-	query      url.Values
-	pathParams map[string]string
-	region     Region
-}
-
-// Retrieve match IDs by tournament code.
-//
-//
-// GET: /api/lol/{region}/v2.2/match/by-tournament/{tournamentCode}/ids
-//
-// Reference: https://developer.riotgames.com/api/methods#!/1058/3654
-func (c *Client) MatchesByTournement(ctx context.Context, region Region, tournamentCode string) *MatchesByTournementCall {
-	path := make(map[string]string)
-	path["tournamentCode"] = convertToString(tournamentCode)
-	return &MatchesByTournementCall{ctx: ctx, client: c, query: make(url.Values), pathParams: path, region: region}
-}
-
-func (c *MatchesByTournementCall) doRequest() (*http.Response, error) {
-	var body io.Reader
-	switch c.region {
-	case BR, EUNE, EUW, KR, LAN, LAS, NA, OCE, RU, TR:
-	default:
-		return nil, ErrNotSupportedRegion
-	}
-	c.query.Set("api_key", c.client.apiKey)
-	c.pathParams["region"] = c.region.Name()
-
-	path, err := uritemplates.Expand("/api/lol/{region}/v2.2/match/by-tournament/{tournamentCode}/ids", c.pathParams)
-	if err != nil {
-		return nil, err
-	}
-	urls := c.region.baseURL() + path + "?" + c.query.Encode()
-
-	return c.client.doRequest(c.ctx, "GET", urls, body)
-}
-
-// Do executes api request.
-//
-// API Errors:
-//  400 - Bad request
-//  401 - Unauthorized
-//  404 - No matches found
-//  429 - Rate limit exceeded
-//  500 - Internal server error
-//  503 - Service unavailable
-func (c *MatchesByTournementCall) Do() ([]int64, error) {
-	res, err := c.doRequest()
-	if err != nil {
-		return nil, err
-	}
-	defer closeBody(res)
-	if err := verifyAPIResponse(res); err != nil {
-		return nil, err
-	}
-	ret := make([]int64, 0)
-	if err := json.NewDecoder(res.Body).Decode(&ret); err != nil {
-		return nil, err
-	}
-	return ret, nil
-}
-
-// MatchForTournementCall is a builder for "MatchForTournement"
-type MatchForTournementCall struct {
-	ctx        context.Context
-	client     *Client
-	query      url.Values
-	pathParams map[string]string
-	region     Region
-}
-
-// Retrieve match by match ID and tournament code.
-//
-//
-// GET: /api/lol/{region}/v2.2/match/for-tournament/{matchId}
-//
-// Reference: https://developer.riotgames.com/api/methods#!/1058/3655
-func (c *Client) MatchForTournement(ctx context.Context, region Region, matchID int64) *MatchForTournementCall {
-	path := make(map[string]string)
-	path["matchId"] = convertToString(matchID)
-	return &MatchForTournementCall{ctx: ctx, client: c, query: make(url.Values), pathParams: path, region: region}
-}
-
-// tournamentCode configures query parameter "tournamentCode".
-func (c *MatchForTournementCall) TournamentCode(v string) *MatchForTournementCall {
-	c.query.Set("tournamentCode", convertToString(v))
-	return c
-}
-
-// includeTimeline configures query parameter "includeTimeline".
-func (c *MatchForTournementCall) IncludeTimeline(v bool) *MatchForTournementCall {
-	c.query.Set("includeTimeline", convertToString(v))
-	return c
-}
-
-func (c *MatchForTournementCall) doRequest() (*http.Response, error) {
-	var body io.Reader
-	switch c.region {
-	case BR, EUNE, EUW, KR, LAN, LAS, NA, OCE, RU, TR:
-	default:
-		return nil, ErrNotSupportedRegion
-	}
-	c.query.Set("api_key", c.client.apiKey)
-	c.pathParams["region"] = c.region.Name()
-
-	path, err := uritemplates.Expand("/api/lol/{region}/v2.2/match/for-tournament/{matchId}", c.pathParams)
-	if err != nil {
-		return nil, err
-	}
-	urls := c.region.baseURL() + path + "?" + c.query.Encode()
-
-	return c.client.doRequest(c.ctx, "GET", urls, body)
-}
-
-// Do executes api request.
-//
-// API Errors:
-//  400 - Invalid tournament code
-//  401 - Unauthorized
-//  404 - Match not found
-//  429 - Rate limit exceeded
-//  500 - Internal server error
-//  503 - Service unavailable
-func (c *MatchForTournementCall) Do() (*MatchDetail, error) {
-	res, err := c.doRequest()
-	if err != nil {
-		return nil, err
-	}
-	defer closeBody(res)
-	if err := verifyAPIResponse(res); err != nil {
-		return nil, err
-	}
-	ret := &MatchDetail{}
-	if err := json.NewDecoder(res.Body).Decode(&ret); err != nil {
-		return nil, err
-	}
-	return ret, nil
-}
-
-// MatchCall is a builder for "Match"
-type MatchCall struct {
-	ctx        context.Context
-	client     *Client
-	query      url.Values
-	pathParams map[string]string
-	region     Region
-}
-
-// Retrieve match by match ID.
-//
-//
-// Implementation notes: Not all matches have timeline data. If timeline data is requested, but doesn't exist, then the response won't include it.
-//
-// GET: /api/lol/{region}/v2.2/match/{matchId}
-//
-// Reference: https://developer.riotgames.com/api/methods#!/1058/3656
-func (c *Client) Match(ctx context.Context, region Region, matchID int64) *MatchCall {
-	path := make(map[string]string)
-	path["matchId"] = convertToString(matchID)
-	return &MatchCall{ctx: ctx, client: c, query: make(url.Values), pathParams: path, region: region}
-}
-
-// includeTimeline configures query parameter "includeTimeline".
-func (c *MatchCall) IncludeTimeline(v bool) *MatchCall {
-	c.query.Set("includeTimeline", convertToString(v))
-	return c
-}
-
-func (c *MatchCall) doRequest() (*http.Response, error) {
-	var body io.Reader
-	switch c.region {
-	case BR, EUNE, EUW, KR, LAN, LAS, NA, OCE, RU, TR:
-	default:
-		return nil, ErrNotSupportedRegion
-	}
-	c.query.Set("api_key", c.client.apiKey)
-	c.pathParams["region"] = c.region.Name()
-
-	path, err := uritemplates.Expand("/api/lol/{region}/v2.2/match/{matchId}", c.pathParams)
-	if err != nil {
-		return nil, err
-	}
-	urls := c.region.baseURL() + path + "?" + c.query.Encode()
-
-	return c.client.doRequest(c.ctx, "GET", urls, body)
-}
-
-// Do executes api request.
-//
-// API Errors:
-//  400 - Bad request
-//  401 - Unauthorized
-//  404 - Match not found
-//  429 - Rate limit exceeded
-//  500 - Internal server error
-//  503 - Service unavailable
-func (c *MatchCall) Do() (*MatchDetail, error) {
-	res, err := c.doRequest()
-	if err != nil {
-		return nil, err
-	}
-	defer closeBody(res)
-	if err := verifyAPIResponse(res); err != nil {
-		return nil, err
-	}
-	ret := &MatchDetail{}
-	if err := json.NewDecoder(res.Body).Decode(&ret); err != nil {
-		return nil, err
-	}
-	return ret, nil
-}
-
-// MatchesBySummonerIDCall is a builder for "MatchesBySummonerID"
-type MatchesBySummonerIDCall struct {
-	ctx        context.Context
-	client     *Client
-	query      url.Values
-	pathParams map[string]string
-	region     Region
-}
-
-// Retrieve match list by summoner ID.
-//
-//
-// Implementation notes: A number of optional parameters are provided for filtering. It is up to the caller to ensure that the combination of filter parameters provided is valid for the requested summoner, otherwise, no matches may be returned. If either of the beginTime or endTime parameters is set, they must both be set, although there is no maximum limit on their range. If the beginTime parameter is specified on its own, endTime is assumed to be the current time. If the endTime parameter is specified on its own, beginTime is assumed to be the start of the summoner's match history.
-//
-// GET: /api/lol/{region}/v2.2/matchlist/by-summoner/{summonerId}
-//
-// Reference: https://developer.riotgames.com/api/methods#!/1053/3617
-func (c *Client) MatchesBySummonerID(ctx context.Context, region Region, summonerID int64) *MatchesBySummonerIDCall {
-	path := make(map[string]string)
-	path["summonerId"] = convertToString(summonerID)
-	return &MatchesBySummonerIDCall{ctx: ctx, client: c, query: make(url.Values), pathParams: path, region: region}
-}
-
-// championIDs configures query parameter "championIds".
-func (c *MatchesBySummonerIDCall) ChampionIDs(v string) *MatchesBySummonerIDCall {
-	c.query.Set("championIDs", convertToString(v))
-	return c
-}
-
-// rankedQueues configures query parameter "rankedQueues".
-func (c *MatchesBySummonerIDCall) RankedQueues(v string) *MatchesBySummonerIDCall {
-	c.query.Set("rankedQueues", convertToString(v))
-	return c
-}
-
-// seasons configures query parameter "seasons".
-func (c *MatchesBySummonerIDCall) Seasons(v string) *MatchesBySummonerIDCall {
-	c.query.Set("seasons", convertToString(v))
-	return c
-}
-
-// beginTime configures query parameter "beginTime".
-func (c *MatchesBySummonerIDCall) BeginTime(v int64) *MatchesBySummonerIDCall {
-	c.query.Set("beginTime", convertToString(v))
-	return c
-}
-
-// endTime configures query parameter "endTime".
-func (c *MatchesBySummonerIDCall) EndTime(v int64) *MatchesBySummonerIDCall {
-	c.query.Set("endTime", convertToString(v))
-	return c
-}
-
-// beginIndex configures query parameter "beginIndex".
-func (c *MatchesBySummonerIDCall) BeginIndex(v int32) *MatchesBySummonerIDCall {
-	c.query.Set("beginIndex", convertToString(v))
-	return c
-}
-
-// endIndex configures query parameter "endIndex".
-func (c *MatchesBySummonerIDCall) EndIndex(v int32) *MatchesBySummonerIDCall {
-	c.query.Set("endIndex", convertToString(v))
-	return c
-}
-
-func (c *MatchesBySummonerIDCall) doRequest() (*http.Response, error) {
-	var body io.Reader
-	switch c.region {
-	case BR, EUNE, EUW, KR, LAN, LAS, NA, OCE, RU, TR:
-	default:
-		return nil, ErrNotSupportedRegion
-	}
-	c.query.Set("api_key", c.client.apiKey)
-	c.pathParams["region"] = c.region.Name()
-
-	path, err := uritemplates.Expand("/api/lol/{region}/v2.2/matchlist/by-summoner/{summonerId}", c.pathParams)
-	if err != nil {
-		return nil, err
-	}
-	urls := c.region.baseURL() + path + "?" + c.query.Encode()
-
-	return c.client.doRequest(c.ctx, "GET", urls, body)
-}
-
-// Do executes api request.
-//
-// API Errors:
-//  400 - Bad request
-//  401 - Unauthorized
-//  404 - Game not found
-//  422 - Summoner has an entry, but hasn't played since the start of 2013
-//  429 - Rate limit exceeded
-//  500 - Internal server error
-//  503 - Service unavailable
-func (c *MatchesBySummonerIDCall) Do() (*MatchList, error) {
-	res, err := c.doRequest()
-	if err != nil {
-		return nil, err
-	}
-	defer closeBody(res)
-	if err := verifyAPIResponse(res); err != nil {
-		return nil, err
-	}
-	ret := &MatchList{}
-	if err := json.NewDecoder(res.Body).Decode(&ret); err != nil {
-		return nil, err
-	}
-	return ret, nil
-}
-
-// RankedStatsCall is a builder for "RankedStats"
-type RankedStatsCall struct {
-	ctx        context.Context
-	client     *Client
-	query      url.Values
-	pathParams map[string]string
-	region     Region
-}
-
-// Get ranked stats by summoner ID.
-//
-//
-// Implementation notes: Includes ranked stats for Twisted Treeline and Summoner's Rift.
-//
-// GET: /api/lol/{region}/v1.3/stats/by-summoner/{summonerId}/ranked
-//
-// Reference: https://developer.riotgames.com/api/methods#!/1018/3452
-func (c *Client) RankedStats(ctx context.Context, region Region, summonerID int64) *RankedStatsCall {
-	path := make(map[string]string)
-	path["summonerId"] = convertToString(summonerID)
-	return &RankedStatsCall{ctx: ctx, client: c, query: make(url.Values), pathParams: path, region: region}
-}
-
-// season configures query parameter "season".
-func (c *RankedStatsCall) Season(v string) *RankedStatsCall {
-	c.query.Set("season", convertToString(v))
-	return c
-}
-
-func (c *RankedStatsCall) doRequest() (*http.Response, error) {
-	var body io.Reader
-	switch c.region {
-	case BR, EUNE, EUW, KR, LAN, LAS, NA, OCE, RU, TR:
-	default:
-		return nil, ErrNotSupportedRegion
-	}
-	c.query.Set("api_key", c.client.apiKey)
-	c.pathParams["region"] = c.region.Name()
-
-	path, err := uritemplates.Expand("/api/lol/{region}/v1.3/stats/by-summoner/{summonerId}/ranked", c.pathParams)
-	if err != nil {
-		return nil, err
-	}
-	urls := c.region.baseURL() + path + "?" + c.query.Encode()
-
-	return c.client.doRequest(c.ctx, "GET", urls, body)
-}
-
-// Do executes api request.
-//
-// API Errors:
-//  400 - Bad request
-//  401 - Unauthorized
-//  404 - Stats data not found
-//  429 - Rate limit exceeded
-//  500 - Internal server error
-//  503 - Service unavailable
-func (c *RankedStatsCall) Do() (*RankedStats, error) {
-	res, err := c.doRequest()
-	if err != nil {
-		return nil, err
-	}
-	defer closeBody(res)
-	if err := verifyAPIResponse(res); err != nil {
-		return nil, err
-	}
-	ret := &RankedStats{}
-	if err := json.NewDecoder(res.Body).Decode(&ret); err != nil {
-		return nil, err
-	}
-	return ret, nil
-}
-
-// StatsSummaryCall is a builder for "StatsSummary"
-type StatsSummaryCall struct {
-	ctx        context.Context
-	client     *Client
-	query      url.Values
-	pathParams map[string]string
-	region     Region
-}
-
-// Get player stats summaries by summoner ID.
-//
-//
-// Implementation notes: One summary is returned per queue type.
-//
-// GET: /api/lol/{region}/v1.3/stats/by-summoner/{summonerId}/summary
-//
-// Reference: https://developer.riotgames.com/api/methods#!/1018/3453
-func (c *Client) StatsSummary(ctx context.Context, region Region, summonerID int64) *StatsSummaryCall {
-	path := make(map[string]string)
-	path["summonerId"] = convertToString(summonerID)
-	return &StatsSummaryCall{ctx: ctx, client: c, query: make(url.Values), pathParams: path, region: region}
-}
-
-// season configures query parameter "season".
-func (c *StatsSummaryCall) Season(v string) *StatsSummaryCall {
-	c.query.Set("season", convertToString(v))
-	return c
-}
-
-func (c *StatsSummaryCall) doRequest() (*http.Response, error) {
-	var body io.Reader
-	switch c.region {
-	case BR, EUNE, EUW, KR, LAN, LAS, NA, OCE, RU, TR:
-	default:
-		return nil, ErrNotSupportedRegion
-	}
-	c.query.Set("api_key", c.client.apiKey)
-	c.pathParams["region"] = c.region.Name()
-
-	path, err := uritemplates.Expand("/api/lol/{region}/v1.3/stats/by-summoner/{summonerId}/summary", c.pathParams)
-	if err != nil {
-		return nil, err
-	}
-	urls := c.region.baseURL() + path + "?" + c.query.Encode()
-
-	return c.client.doRequest(c.ctx, "GET", urls, body)
-}
-
-// Do executes api request.
-//
-// API Errors:
-//  400 - Bad request
-//  401 - Unauthorized
-//  404 - Stats data not found
-//  429 - Rate limit exceeded
-//  500 - Internal server error
-//  503 - Service unavailable
-func (c *StatsSummaryCall) Do() (*PlayerStatsSummaryList, error) {
-	res, err := c.doRequest()
-	if err != nil {
-		return nil, err
-	}
-	defer closeBody(res)
-	if err := verifyAPIResponse(res); err != nil {
-		return nil, err
-	}
-	ret := &PlayerStatsSummaryList{}
-	if err := json.NewDecoder(res.Body).Decode(&ret); err != nil {
-		return nil, err
-	}
-	return ret, nil
-}
-
-// SummonersByNameCall is a builder for "SummonersByName"
-type SummonersByNameCall struct {
-	ctx        context.Context
-	client     *Client
-	query      url.Values
-	pathParams map[string]string
-	region     Region
-}
-
-// Get summoner objects mapped by standardized summoner name for a given list of summoner names.
-//
-//
-// Implementation notes: The response object contains the summoner objects mapped by the standardized summoner name, which is the summoner name in all lower case and with spaces removed. Use this version of the name when checking if the returned object contains the data for a given summoner. This API will also accept standardized summoner names as valid parameters, although they are not required.
-//
-// GET: /api/lol/{region}/v1.4/summoner/by-name/{summonerNames}
-//
-// Reference: https://developer.riotgames.com/api/methods#!/1017/3446
-func (c *Client) SummonersByName(ctx context.Context, region Region, summonerNames []string) *SummonersByNameCall {
-	path := make(map[string]string)
-	path["summonerNames"] = convertToString(summonerNames)
-	return &SummonersByNameCall{ctx: ctx, client: c, query: make(url.Values), pathParams: path, region: region}
-}
-
-func (c *SummonersByNameCall) doRequest() (*http.Response, error) {
-	var body io.Reader
-	switch c.region {
-	case BR, EUNE, EUW, KR, LAN, LAS, NA, OCE, RU, TR:
-	default:
-		return nil, ErrNotSupportedRegion
-	}
-	c.query.Set("api_key", c.client.apiKey)
-	c.pathParams["region"] = c.region.Name()
-
-	path, err := uritemplates.Expand("/api/lol/{region}/v1.4/summoner/by-name/{summonerNames}", c.pathParams)
-	if err != nil {
-		return nil, err
-	}
-	urls := c.region.baseURL() + path + "?" + c.query.Encode()
-
-	return c.client.doRequest(c.ctx, "GET", urls, body)
-}
-
-// Do executes api request.
-//
-// API Errors:
-//  400 - Bad request
-//  401 - Unauthorized
-//  404 - No summoner data found for any specified inputs
-//  429 - Rate limit exceeded
-//  500 - Internal server error
-//  503 - Service unavailable
-func (c *SummonersByNameCall) Do() (map[string]*Summoner, error) {
-	res, err := c.doRequest()
-	if err != nil {
-		return nil, err
-	}
-	defer closeBody(res)
-	if err := verifyAPIResponse(res); err != nil {
-		return nil, err
-	}
-	ret := make(map[string]*Summoner)
-	if err := json.NewDecoder(res.Body).Decode(&ret); err != nil {
-		return nil, err
-	}
-	return ret, nil
-}
-
-// SummonersCall is a builder for "Summoners"
-type SummonersCall struct {
-	ctx        context.Context
-	client     *Client
-	query      url.Values
-	pathParams map[string]string
-	region     Region
-}
-
-// Get summoner objects mapped by summoner ID for a given list of summoner IDs.
-//
-//
-// GET: /api/lol/{region}/v1.4/summoner/{summonerIds}
-//
-// Reference: https://developer.riotgames.com/api/methods#!/1017/3447
-func (c *Client) Summoners(ctx context.Context, region Region, summonerIDs []int64) *SummonersCall {
-	path := make(map[string]string)
-	path["summonerIds"] = convertToString(summonerIDs)
-	return &SummonersCall{ctx: ctx, client: c, query: make(url.Values), pathParams: path, region: region}
-}
-
-func (c *SummonersCall) doRequest() (*http.Response, error) {
-	var body io.Reader
-	switch c.region {
-	case BR, EUNE, EUW, KR, LAN, LAS, NA, OCE, RU, TR:
-	default:
-		return nil, ErrNotSupportedRegion
-	}
-	c.query.Set("api_key", c.client.apiKey)
-	c.pathParams["region"] = c.region.Name()
-
-	path, err := uritemplates.Expand("/api/lol/{region}/v1.4/summoner/{summonerIds}", c.pathParams)
-	if err != nil {
-		return nil, err
-	}
-	urls := c.region.baseURL() + path + "?" + c.query.Encode()
-
-	return c.client.doRequest(c.ctx, "GET", urls, body)
-}
-
-// Do executes api request.
-//
-// API Errors:
-//  400 - Bad request
-//  401 - Unauthorized
-//  404 - No summoner data found for any specified inputs
-//  429 - Rate limit exceeded
-//  500 - Internal server error
-//  503 - Service unavailable
-func (c *SummonersCall) Do() (map[int64]*Summoner, error) {
-	res, err := c.doRequest()
-	if err != nil {
-		return nil, err
-	}
-	defer closeBody(res)
-	if err := verifyAPIResponse(res); err != nil {
-		return nil, err
-	}
-	ret := make(map[string]*Summoner)
-	if err := json.NewDecoder(res.Body).Decode(&ret); err != nil {
-		return nil, err
-	}
-	data := make(map[int64]*Summoner)
-	for k, v := range ret {
-		i, err := strconv.ParseInt(k, 10, 64)
-		if err != nil {
-			return nil, err
-		}
-		data[i] = v
-	}
-	return data, nil
-}
-
-// SummonerMasteriesCall is a builder for "SummonerMasteries"
-type SummonerMasteriesCall struct {
-	ctx        context.Context
-	client     *Client
-	query      url.Values
-	pathParams map[string]string
-	region     Region
-}
-
-// Get mastery pages mapped by summoner ID for a given list of summoner IDs
-//
-//
-// GET: /api/lol/{region}/v1.4/summoner/{summonerIds}/masteries
-//
-// Reference: https://developer.riotgames.com/api/methods#!/1017/3450
-func (c *Client) SummonerMasteries(ctx context.Context, region Region, summonerIDs []int64) *SummonerMasteriesCall {
-	path := make(map[string]string)
-	path["summonerIds"] = convertToString(summonerIDs)
-	return &SummonerMasteriesCall{ctx: ctx, client: c, query: make(url.Values), pathParams: path, region: region}
-}
-
-func (c *SummonerMasteriesCall) doRequest() (*http.Response, error) {
-	var body io.Reader
-	switch c.region {
-	case BR, EUNE, EUW, KR, LAN, LAS, NA, OCE, RU, TR:
-	default:
-		return nil, ErrNotSupportedRegion
-	}
-	c.query.Set("api_key", c.client.apiKey)
-	c.pathParams["region"] = c.region.Name()
-
-	path, err := uritemplates.Expand("/api/lol/{region}/v1.4/summoner/{summonerIds}/masteries", c.pathParams)
-	if err != nil {
-		return nil, err
-	}
-	urls := c.region.baseURL() + path + "?" + c.query.Encode()
-
-	return c.client.doRequest(c.ctx, "GET", urls, body)
-}
-
-// Do executes api request.
-//
-// API Errors:
-//  400 - Bad request
-//  401 - Unauthorized
-//  404 - No summoner data found for any specified inputs
-//  429 - Rate limit exceeded
-//  500 - Internal server error
-//  503 - Service unavailable
-func (c *SummonerMasteriesCall) Do() (map[int64]*MasteryPages, error) {
-	res, err := c.doRequest()
-	if err != nil {
-		return nil, err
-	}
-	defer closeBody(res)
-	if err := verifyAPIResponse(res); err != nil {
-		return nil, err
-	}
-	ret := make(map[string]*MasteryPages)
-	if err := json.NewDecoder(res.Body).Decode(&ret); err != nil {
-		return nil, err
-	}
-	data := make(map[int64]*MasteryPages)
-	for k, v := range ret {
-		i, err := strconv.ParseInt(k, 10, 64)
-		if err != nil {
-			return nil, err
-		}
-		data[i] = v
-	}
-	return data, nil
-}
-
-// SummonerNamesCall is a builder for "SummonerNames"
-type SummonerNamesCall struct {
-	ctx        context.Context
-	client     *Client
-	query      url.Values
-	pathParams map[string]string
-	region     Region
-}
-
-// Get summoner names mapped by summoner ID for a given list of summoner IDs.
-//
-//
-// GET: /api/lol/{region}/v1.4/summoner/{summonerIds}/name
-//
-// Reference: https://developer.riotgames.com/api/methods#!/1017/3451
-func (c *Client) SummonerNames(ctx context.Context, region Region, summonerIDs []int64) *SummonerNamesCall {
-	path := make(map[string]string)
-	path["summonerIds"] = convertToString(summonerIDs)
-	return &SummonerNamesCall{ctx: ctx, client: c, query: make(url.Values), pathParams: path, region: region}
-}
-
-func (c *SummonerNamesCall) doRequest() (*http.Response, error) {
-	var body io.Reader
-	switch c.region {
-	case BR, EUNE, EUW, KR, LAN, LAS, NA, OCE, RU, TR:
-	default:
-		return nil, ErrNotSupportedRegion
-	}
-	c.query.Set("api_key", c.client.apiKey)
-	c.pathParams["region"] = c.region.Name()
-
-	path, err := uritemplates.Expand("/api/lol/{region}/v1.4/summoner/{summonerIds}/name", c.pathParams)
-	if err != nil {
-		return nil, err
-	}
-	urls := c.region.baseURL() + path + "?" + c.query.Encode()
-
-	return c.client.doRequest(c.ctx, "GET", urls, body)
-}
-
-// Do executes api request.
-//
-// API Errors:
-//  400 - Bad request
-//  401 - Unauthorized
-//  404 - No summoner data found for any specified inputs
-//  429 - Rate limit exceeded
-//  500 - Internal server error
-//  503 - Service unavailable
-func (c *SummonerNamesCall) Do() (map[int64]string, error) {
-	res, err := c.doRequest()
-	if err != nil {
-		return nil, err
-	}
-	defer closeBody(res)
-	if err := verifyAPIResponse(res); err != nil {
-		return nil, err
-	}
-	ret := make(map[string]string)
-	if err := json.NewDecoder(res.Body).Decode(&ret); err != nil {
-		return nil, err
-	}
-	data := make(map[int64]string)
-	for k, v := range ret {
-		i, err := strconv.ParseInt(k, 10, 64)
-		if err != nil {
-			return nil, err
-		}
-		data[i] = v
-	}
-	return data, nil
-}
-
-// SummonerRunesCall is a builder for "SummonerRunes"
-type SummonerRunesCall struct {
-	ctx        context.Context
-	client     *Client
-	query      url.Values
-	pathParams map[string]string
-	region     Region
-}
-
-// Get rune pages mapped by summoner ID for a given list of summoner IDs.
-//
-//
-// GET: /api/lol/{region}/v1.4/summoner/{summonerIds}/runes
-//
-// Reference: https://developer.riotgames.com/api/methods#!/1017/3449
-func (c *Client) SummonerRunes(ctx context.Context, region Region, summonerIDs []int64) *SummonerRunesCall {
-	path := make(map[string]string)
-	path["summonerIds"] = convertToString(summonerIDs)
-	return &SummonerRunesCall{ctx: ctx, client: c, query: make(url.Values), pathParams: path, region: region}
-}
-
-func (c *SummonerRunesCall) doRequest() (*http.Response, error) {
-	var body io.Reader
-	switch c.region {
-	case BR, EUNE, EUW, KR, LAN, LAS, NA, OCE, RU, TR:
-	default:
-		return nil, ErrNotSupportedRegion
-	}
-	c.query.Set("api_key", c.client.apiKey)
-	c.pathParams["region"] = c.region.Name()
-
-	path, err := uritemplates.Expand("/api/lol/{region}/v1.4/summoner/{summonerIds}/runes", c.pathParams)
-	if err != nil {
-		return nil, err
-	}
-	urls := c.region.baseURL() + path + "?" + c.query.Encode()
-
-	return c.client.doRequest(c.ctx, "GET", urls, body)
-}
-
-// Do executes api request.
-//
-// API Errors:
-//  400 - Bad request
-//  401 - Unauthorized
-//  404 - No summoner data found for any specified inputs
-//  429 - Rate limit exceeded
-//  500 - Internal server error
-//  503 - Service unavailable
-func (c *SummonerRunesCall) Do() (map[int64]*RunePages, error) {
-	res, err := c.doRequest()
-	if err != nil {
-		return nil, err
-	}
-	defer closeBody(res)
-	if err := verifyAPIResponse(res); err != nil {
-		return nil, err
-	}
-	ret := make(map[string]*RunePages)
-	if err := json.NewDecoder(res.Body).Decode(&ret); err != nil {
-		return nil, err
-	}
-	data := make(map[int64]*RunePages)
-	for k, v := range ret {
-		i, err := strconv.ParseInt(k, 10, 64)
-		if err != nil {
-			return nil, err
-		}
-		data[i] = v
-	}
-	return data, nil
-}
-
-// TeamsBySummonerIDCall is a builder for "TeamsBySummonerID"
-type TeamsBySummonerIDCall struct {
-	ctx        context.Context
-	client     *Client
-	query      url.Values
-	pathParams map[string]string
-	region     Region
-}
-
-// Get teams mapped by summoner ID for a given list of summoner IDs.
-//
-//
-// GET: /api/lol/{region}/v2.4/team/by-summoner/{summonerIds}
-//
-// Reference: https://developer.riotgames.com/api/methods#!/986/3358
-func (c *Client) TeamsBySummonerID(ctx context.Context, region Region, summonerIDs []int64) *TeamsBySummonerIDCall {
-	path := make(map[string]string)
-	path["summonerIds"] = convertToString(summonerIDs)
-	return &TeamsBySummonerIDCall{ctx: ctx, client: c, query: make(url.Values), pathParams: path, region: region}
-}
-
-func (c *TeamsBySummonerIDCall) doRequest() (*http.Response, error) {
-	var body io.Reader
-	switch c.region {
-	case BR, EUNE, EUW, KR, LAN, LAS, NA, OCE, RU, TR:
-	default:
-		return nil, ErrNotSupportedRegion
-	}
-	c.query.Set("api_key", c.client.apiKey)
-	c.pathParams["region"] = c.region.Name()
-
-	path, err := uritemplates.Expand("/api/lol/{region}/v2.4/team/by-summoner/{summonerIds}", c.pathParams)
-	if err != nil {
-		return nil, err
-	}
-	urls := c.region.baseURL() + path + "?" + c.query.Encode()
-
-	return c.client.doRequest(c.ctx, "GET", urls, body)
-}
-
-// Do executes api request.
-//
-// API Errors:
-//  400 - Bad request
-//  401 - Unauthorized
-//  404 - Team not found
-//  429 - Rate limit exceeded
-//  500 - Internal server error
-//  503 - Service unavailable
-func (c *TeamsBySummonerIDCall) Do() (map[int64][]*RankTeam, error) {
-	res, err := c.doRequest()
-	if err != nil {
-		return nil, err
-	}
-	defer closeBody(res)
-	if err := verifyAPIResponse(res); err != nil {
-		return nil, err
-	}
-	ret := make(map[string][]*RankTeam)
-	if err := json.NewDecoder(res.Body).Decode(&ret); err != nil {
-		return nil, err
-	}
-	data := make(map[int64][]*RankTeam)
-	for k, v := range ret {
-		i, err := strconv.ParseInt(k, 10, 64)
-		if err != nil {
-			return nil, err
-		}
-		data[i] = v
-	}
-	return data, nil
-}
-
-// TeamsCall is a builder for "Teams"
-type TeamsCall struct {
-	ctx        context.Context
-	client     *Client
-	query      url.Values
-	pathParams map[string]string
-	region     Region
-}
-
-// Get teams mapped by team ID for a given list of team IDs.
-//
-//
-// GET: /api/lol/{region}/v2.4/team/{teamIds}
-//
-// Reference: https://developer.riotgames.com/api/methods#!/986/3357
-func (c *Client) Teams(ctx context.Context, region Region, teamIDs []string) *TeamsCall {
-	path := make(map[string]string)
-	path["teamIds"] = convertToString(teamIDs)
-	return &TeamsCall{ctx: ctx, client: c, query: make(url.Values), pathParams: path, region: region}
-}
-
-func (c *TeamsCall) doRequest() (*http.Response, error) {
-	var body io.Reader
-	switch c.region {
-	case BR, EUNE, EUW, KR, LAN, LAS, NA, OCE, RU, TR:
-	default:
-		return nil, ErrNotSupportedRegion
-	}
-	c.query.Set("api_key", c.client.apiKey)
-	c.pathParams["region"] = c.region.Name()
-
-	path, err := uritemplates.Expand("/api/lol/{region}/v2.4/team/{teamIds}", c.pathParams)
-	if err != nil {
-		return nil, err
-	}
-	urls := c.region.baseURL() + path + "?" + c.query.Encode()
-
-	return c.client.doRequest(c.ctx, "GET", urls, body)
-}
-
-// Do executes api request.
-//
-// API Errors:
-//  400 - Bad request
-//  401 - Unauthorized
-//  404 - Team not found
-//  429 - Rate limit exceeded
-//  500 - Internal server error
-//  503 - Service unavailable
-func (c *TeamsCall) Do() (map[string]*RankTeam, error) {
-	res, err := c.doRequest()
-	if err != nil {
-		return nil, err
-	}
-	defer closeBody(res)
-	if err := verifyAPIResponse(res); err != nil {
-		return nil, err
-	}
-	ret := make(map[string]*RankTeam)
-	if err := json.NewDecoder(res.Body).Decode(&ret); err != nil {
-		return nil, err
-	}
-	return ret, nil
-}
@@ -0,0 +1,209 @@
+// Generated by go-lol-generator. DO NOT EDIT.
+
+package lol
+
+import "encoding/json"
+import "io"
+import "net/http"
+import "net/url"
+import "golang.org/x/net/context"
+import "github.com/go-lol/lol/uritemplates"
+
+// ChampionDto - This object contains champion information.
+//
+// resource: "champion", original name: "ChampionDto"
+type Champion struct {
+	// Indicates if the champion is active.
+	Active bool `json:"active,omitempty"`
+	// Bot enabled flag (for custom games).
+	BotEnabled bool `json:"botEnabled,omitempty"`
+	// Bot Match Made enabled flag (for Co-op vs. AI games).
+	BotMmEnabled bool `json:"botMmEnabled,omitempty"`
+	// Indicates if the champion is free to play. Free to play champions are rotated periodically.
+	FreeToPlay bool `json:"freeToPlay,omitempty"`
+	// Champion ID. For static information correlating to champion IDs, please refer to the LoL Static Data API.
+	ID int32 `json:"id,omitempty"`
+	// Ranked play enabled flag.
+	RankedPlayEnabled bool `json:"rankedPlayEnabled,omitempty"`
+}
+
+// ChampionListDto - This object contains a collection of champion information.
+//
+// resource: "champion", original name: "ChampionListDto"
+type ChampionList struct {
+	// The collection of champion information.
+	Champions []*Champion `json:"champions,omitempty"`
+}
+
+// ChampionsCall is a builder for "Champions"
+type ChampionsCall struct {
+	ctx        context.Context
+	client     *Client
+	query      url.Values
+	pathParams map[string]string
+	region     Region
+}
+
+var championsInfo = &OperationInfo{
+	Name:     "Champions",
+	Resource: "champion",
+	Version:  "v1.2",
+	Method:   "GET",
+	Path:     "/api/lol/{region}/v1.2/champion",
+	DocURL:   "https://developer.riotgames.com/api/methods#!/1015/3444",
+	Regions:  []Region{BR, EUNE, EUW, KR, LAN, LAS, NA, OCE, RU, TR},
+	Errors: []OperationError{
+		{400, "Bad request"},
+		{401, "Unauthorized"},
+		{429, "Rate limit exceeded"},
+		{500, "Internal server error"},
+		{503, "Service unavailable"},
+	},
+}
+
+// Info returns information of the operation.
+func (c *ChampionsCall) Info() *OperationInfo { return championsInfo }
+
+// Retrieve all champions.
+//
+//
+// GET: /api/lol/{region}/v1.2/champion
+//
+// Reference: https://developer.riotgames.com/api/methods#!/1015/3444
+func (c *Client) Champions(ctx context.Context, region Region) *ChampionsCall {
+	path := make(map[string]string)
+	return &ChampionsCall{ctx: ctx, client: c, query: make(url.Values), pathParams: path, region: region}
+}
+
+// freeToPlay configures query parameter "freeToPlay".
+func (c *ChampionsCall) FreeToPlay(v bool) *ChampionsCall {
+	c.query.Set("freeToPlay", convertToString(v))
+	return c
+}
+
+func (c *ChampionsCall) doRequest() (*http.Response, error) {
+	var body io.Reader
+	switch c.region {
+	case BR, EUNE, EUW, KR, LAN, LAS, NA, OCE, RU, TR:
+	default:
+		return nil, ErrNotSupportedRegion
+	}
+	c.query.Set("api_key", c.client.apiKey)
+	c.pathParams["region"] = c.region.Name()
+
+	path, err := uritemplates.Expand("/api/lol/{region}/v1.2/champion", c.pathParams)
+	if err != nil {
+		return nil, err
+	}
+	urls := c.region.baseURL() + path + "?" + c.query.Encode()
+
+	return c.client.doRequest(c.ctx, championsInfo, urls, body)
+}
+
+// Do executes api request.
+//
+// API Errors:
+//  400 - Bad request
+//  401 - Unauthorized
+//  429 - Rate limit exceeded
+//  500 - Internal server error
+//  503 - Service unavailable
+func (c *ChampionsCall) Do() (*ChampionList, error) {
+	res, err := c.doRequest()
+	if err != nil {
+		return nil, err
+	}
+	defer closeBody(res)
+	if err := verifyAPIResponse(res); err != nil {
+		return nil, err
+	}
+	ret := &ChampionList{}
+	if err := json.NewDecoder(res.Body).Decode(&ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// ChampionCall is a builder for "Champion"
+type ChampionCall struct {
+	ctx        context.Context
+	client     *Client
+	query      url.Values
+	pathParams map[string]string
+	region     Region
+}
+
+var championInfo = &OperationInfo{
+	Name:     "Champion",
+	Resource: "champion",
+	Version:  "v1.2",
+	Method:   "GET",
+	Path:     "/api/lol/{region}/v1.2/champion/{id}",
+	DocURL:   "https://developer.riotgames.com/api/methods#!/1015/3443",
+	Regions:  []Region{BR, EUNE, EUW, KR, LAN, LAS, NA, OCE, RU, TR},
+	Errors: []OperationError{
+		{400, "Bad request"},
+		{401, "Unauthorized"},
+		{429, "Rate limit exceeded"},
+		{500, "Internal server error"},
+		{503, "Service unavailable"},
+	},
+}
+
+// Info returns information of the operation.
+func (c *ChampionCall) Info() *OperationInfo { return championInfo }
+
+// Retrieve champion by ID.
+//
+//
+// GET: /api/lol/{region}/v1.2/champion/{id}
+//
+// Reference: https://developer.riotgames.com/api/methods#!/1015/3443
+func (c *Client) Champion(ctx context.Context, region Region, id int32) *ChampionCall {
+	path := make(map[string]string)
+	path["id"] = convertToString(id)
+	return &ChampionCall{ctx: ctx, client: c, query: make(url.Values), pathParams: path, region: region}
+}
+
+func (c *ChampionCall) doRequest() (*http.Response, error) {
+	var body io.Reader
+	switch c.region {
+	case BR, EUNE, EUW, KR, LAN, LAS, NA, OCE, RU, TR:
+	default:
+		return nil, ErrNotSupportedRegion
+	}
+	c.query.Set("api_key", c.client.apiKey)
+	c.pathParams["region"] = c.region.Name()
+
+	path, err := uritemplates.Expand("/api/lol/{region}/v1.2/champion/{id}", c.pathParams)
+	if err != nil {
+		return nil, err
+	}
+	urls := c.region.baseURL() + path + "?" + c.query.Encode()
+
+	return c.client.doRequest(c.ctx, championInfo, urls, body)
+}
+
+// Do executes api request.
+//
+// API Errors:
+//  400 - Bad request
+//  401 - Unauthorized
+//  429 - Rate limit exceeded
+//  500 - Internal server error
+//  503 - Service unavailable
+func (c *ChampionCall) Do() (*Champion, error) {
+	res, err := c.doRequest()
+	if err != nil {
+		return nil, err
+	}
+	defer closeBody(res)
+	if err := verifyAPIResponse(res); err != nil {
+		return nil, err
+	}
+	ret := &Champion{}
+	if err := json.NewDecoder(res.Body).Decode(&ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
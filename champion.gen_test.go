@@ -0,0 +1,33 @@
+// Generated by go-lol-generator. DO NOT EDIT.
+
+package lol
+
+import "testing"
+import "golang.org/x/net/context"
+
+func TestChampionOperations(t *testing.T) {
+	runOperationTests(t, []operationTest{
+		{
+			name:        "Champions",
+			region:      NA,
+			unsupported: Global,
+			path:        "/api/lol/na/v1.2/champion",
+			apiKey:      true,
+			fixture:     "{\"champions\":[{\"active\":true,\"botEnabled\":true,\"botMmEnabled\":true,\"freeToPlay\":true,\"id\":1,\"rankedPlayEnabled\":true}]}",
+			do: func(c *Client, region Region) (interface{}, error) {
+				return c.Champions(context.TODO(), region).Do()
+			},
+		},
+		{
+			name:        "Champion",
+			region:      NA,
+			unsupported: Global,
+			path:        "/api/lol/na/v1.2/champion/1",
+			apiKey:      true,
+			fixture:     "{\"active\":true,\"botEnabled\":true,\"botMmEnabled\":true,\"freeToPlay\":true,\"id\":1,\"rankedPlayEnabled\":true}",
+			do: func(c *Client, region Region) (interface{}, error) {
+				return c.Champion(context.TODO(), region, 1).Do()
+			},
+		},
+	})
+}
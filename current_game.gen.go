@@ -0,0 +1,182 @@
+// Generated by go-lol-generator. DO NOT EDIT.
+
+package lol
+
+import "encoding/json"
+import "io"
+import "net/http"
+import "net/url"
+import "golang.org/x/net/context"
+import "github.com/go-lol/lol/uritemplates"
+
+// BannedChampion
+//
+// resource: "current-game", original name: "BannedChampion"
+type CurrentGameBannedChampion struct {
+	// The ID of the banned champion
+	ChampionID int32 `json:"championId,omitempty"`
+	// The turn during which the champion was banned
+	PickTurn int32 `json:"pickTurn,omitempty"`
+	// The ID of the team that banned the champion
+	TeamID int64 `json:"teamId,omitempty"`
+}
+
+// CurrentGameInfo
+//
+// resource: "current-game", original name: "CurrentGameInfo"
+type CurrentGameInfo struct {
+	// Banned champion information
+	BannedChampions []*CurrentGameBannedChampion `json:"bannedChampions,omitempty"`
+	// The ID of the game
+	GameID int64 `json:"gameId,omitempty"`
+	// The amount of time in seconds that has passed since the game started
+	GameLength Seconds `json:"gameLength,omitempty"`
+	// The game mode (Legal values: CLASSIC, ODIN, ARAM, TUTORIAL, ONEFORALL, ASCENSION, FIRSTBLOOD, KINGPORO)
+	GameMode string `json:"gameMode,omitempty"`
+	// The queue type (queue types are documented on the Game Constants page)
+	GameQueueConfigID int64 `json:"gameQueueConfigId,omitempty"`
+	// The game start time represented in epoch milliseconds
+	GameStartTime EpochMillis `json:"gameStartTime,omitempty"`
+	// The game type (Legal values: CUSTOM_GAME, MATCHED_GAME, TUTORIAL_GAME)
+	GameType string `json:"gameType,omitempty"`
+	// The ID of the map
+	MapID int32 `json:"mapId,omitempty"`
+	// The observer information
+	Observers *CurrentGameObserver `json:"observers,omitempty"`
+	// The participant information
+	Participants []*CurrentGameParticipant `json:"participants,omitempty"`
+	// The ID of the platform on which the game is being played
+	PlatformID string `json:"platformId,omitempty"`
+}
+
+// Observer
+//
+// resource: "current-game", original name: "Observer"
+type CurrentGameObserver struct {
+	// Key used to decrypt the spectator grid game data for playback
+	EncryptionKey string `json:"encryptionKey,omitempty"`
+}
+
+// CurrentGameParticipant
+//
+// resource: "current-game", original name: "CurrentGameParticipant"
+type CurrentGameParticipant struct {
+	// Flag indicating whether or not this participant is a bot
+	Bot bool `json:"bot,omitempty"`
+	// The ID of the champion played by this participant
+	ChampionID int32 `json:"championId,omitempty"`
+	// The masteries used by this participant
+	Masteries []*CurrentMastery `json:"masteries,omitempty"`
+	// The ID of the profile icon used by this participant
+	ProfileIconID int32 `json:"profileIconId,omitempty"`
+	// The runes used by this participant
+	Runes []*CurrentRune `json:"runes,omitempty"`
+	// The ID of the first summoner spell used by this participant
+	Spell1Id int32 `json:"spell1Id,omitempty"`
+	// The ID of the second summoner spell used by this participant
+	Spell2Id int32 `json:"spell2Id,omitempty"`
+	// The summoner ID of this participant
+	SummonerID int64 `json:"summonerId,omitempty"`
+	// The summoner name of this participant
+	SummonerName string `json:"summonerName,omitempty"`
+	// The team ID of this participant, indicating the participant's team
+	TeamID int64 `json:"teamId,omitempty"`
+}
+
+// Mastery
+//
+// resource: "current-game", original name: "Mastery"
+type CurrentMastery struct {
+	// The ID of the mastery
+	MasteryID int32 `json:"masteryId,omitempty"`
+	// The number of points put into this mastery by the user
+	Rank int32 `json:"rank,omitempty"`
+}
+
+// Rune
+//
+// resource: "current-game", original name: "Rune"
+type CurrentRune struct {
+	// The count of this rune used by the participant
+	Count int32 `json:"count,omitempty"`
+	// The ID of the rune
+	RuneID int32 `json:"runeId,omitempty"`
+}
+
+// SpectatorGameInfoCall is a builder for "SpectatorGameInfo"
+type SpectatorGameInfoCall struct {
+	ctx        context.Context
+	client     *Client
+	query      url.Values
+	pathParams map[string]string
+	region     Region
+}
+
+var spectatorGameInfoInfo = &OperationInfo{
+	Name:     "SpectatorGameInfo",
+	Resource: "current-game",
+	Version:  "v1.0",
+	Method:   "GET",
+	Path:     "/observer-mode/rest/consumer/getSpectatorGameInfo/{platformId}/{summonerId}",
+	DocURL:   "https://developer.riotgames.com/api/methods#!/976/3336",
+	Regions:  []Region{BR, EUNE, EUW, KR, LAN, LAS, NA, OCE, PBE, RU, TR},
+	Errors: []OperationError{
+		{403, "Forbidden"},
+		{429, "Rate limit exceeded"},
+	},
+}
+
+// Info returns information of the operation.
+func (c *SpectatorGameInfoCall) Info() *OperationInfo { return spectatorGameInfoInfo }
+
+// Get current game information for the given summoner ID.
+//
+//
+// GET: /observer-mode/rest/consumer/getSpectatorGameInfo/{platformId}/{summonerId}
+//
+// Reference: https://developer.riotgames.com/api/methods#!/976/3336
+func (c *Client) SpectatorGameInfo(ctx context.Context, region Region, summonerID int64) *SpectatorGameInfoCall {
+	path := make(map[string]string)
+	path["summonerId"] = convertToString(summonerID)
+	return &SpectatorGameInfoCall{ctx: ctx, client: c, query: make(url.Values), pathParams: path, region: region}
+}
+
+func (c *SpectatorGameInfoCall) doRequest() (*http.Response, error) {
+	var body io.Reader
+	switch c.region {
+	case BR, EUNE, EUW, KR, LAN, LAS, NA, OCE, PBE, RU, TR:
+	default:
+		return nil, ErrNotSupportedRegion
+	}
+	c.query.Set("api_key", c.client.apiKey)
+	c.pathParams["platformId"] = c.region.PlatformID()
+
+	path, err := uritemplates.Expand("/observer-mode/rest/consumer/getSpectatorGameInfo/{platformId}/{summonerId}", c.pathParams)
+	if err != nil {
+		return nil, err
+	}
+	urls := c.region.baseURL() + path + "?" + c.query.Encode()
+
+	return c.client.doRequest(c.ctx, spectatorGameInfoInfo, urls, body)
+}
+
+// Do executes api request.
+//
+// API Errors:
+//  403 - Forbidden
+//  429 - Rate limit exceeded
+func (c *SpectatorGameInfoCall) Do() (*CurrentGameInfo, error) {
+	res, err := c.doRequest()
+	if err != nil {
+		return nil, err
+	}
+	defer closeBody(res)
+	if err := verifyAPIResponse(res); err != nil {
+		return nil, err
+	}
+	ret := &CurrentGameInfo{}
+	if err := json.NewDecoder(res.Body).Decode(&ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
@@ -0,0 +1,164 @@
+// Generated by go-lol-generator. DO NOT EDIT.
+
+package lol
+
+import "encoding/json"
+import "io"
+import "net/http"
+import "net/url"
+import "golang.org/x/net/context"
+import "github.com/go-lol/lol/uritemplates"
+
+// BannedChampion
+//
+// resource: "featured-games", original name: "BannedChampion"
+type FeaturedGameBannedChampion struct {
+	// The ID of the banned champion
+	ChampionID int32 `json:"championId,omitempty"`
+	// The turn during which the champion was banned
+	PickTurn int32 `json:"pickTurn,omitempty"`
+	// The ID of the team that banned the champion
+	TeamID int64 `json:"teamId,omitempty"`
+}
+
+// FeaturedGameInfo
+//
+// resource: "featured-games", original name: "FeaturedGameInfo"
+type FeaturedGameInfo struct {
+	// Banned champion information
+	BannedChampions []*FeaturedGameBannedChampion `json:"bannedChampions,omitempty"`
+	// The ID of the game
+	GameID int64 `json:"gameId,omitempty"`
+	// The amount of time in seconds that has passed since the game started
+	GameLength Seconds `json:"gameLength,omitempty"`
+	// The game mode (Legal values: CLASSIC, ODIN, ARAM, TUTORIAL, ONEFORALL, ASCENSION, FIRSTBLOOD, KINGPORO)
+	GameMode string `json:"gameMode,omitempty"`
+	// The queue type (queue types are documented on the Game Constants page)
+	GameQueueConfigID int64 `json:"gameQueueConfigId,omitempty"`
+	// The game start time represented in epoch milliseconds
+	GameStartTime EpochMillis `json:"gameStartTime,omitempty"`
+	// The game type (Legal values: CUSTOM_GAME, MATCHED_GAME, TUTORIAL_GAME)
+	GameType string `json:"gameType,omitempty"`
+	// The ID of the map
+	MapID int32 `json:"mapId,omitempty"`
+	// The observer information
+	Observers *FeaturedGameObserver `json:"observers,omitempty"`
+	// The participant information
+	Participants []*FeaturedGameParticipant `json:"participants,omitempty"`
+	// The ID of the platform on which the game is being played
+	PlatformID string `json:"platformId,omitempty"`
+}
+
+// Observer
+//
+// resource: "featured-games", original name: "Observer"
+type FeaturedGameObserver struct {
+	// Key used to decrypt the spectator grid game data for playback
+	EncryptionKey string `json:"encryptionKey,omitempty"`
+}
+
+// Participant
+//
+// resource: "featured-games", original name: "Participant"
+type FeaturedGameParticipant struct {
+	// Flag indicating whether or not this participant is a bot
+	Bot bool `json:"bot,omitempty"`
+	// The ID of the champion played by this participant
+	ChampionID int32 `json:"championId,omitempty"`
+	// The ID of the profile icon used by this participant
+	ProfileIconID int32 `json:"profileIconId,omitempty"`
+	// The ID of the first summoner spell used by this participant
+	Spell1Id int32 `json:"spell1Id,omitempty"`
+	// The ID of the second summoner spell used by this participant
+	Spell2Id int32 `json:"spell2Id,omitempty"`
+	// The summoner name of this participant
+	SummonerName string `json:"summonerName,omitempty"`
+	// The team ID of this participant, indicating the participant's team
+	TeamID int64 `json:"teamId,omitempty"`
+}
+
+// FeaturedGames
+//
+// resource: "featured-games", original name: "FeaturedGames"
+type FeaturedGames struct {
+	// The suggested interval to wait before requesting FeaturedGames again
+	ClientRefreshInterval int64 `json:"clientRefreshInterval,omitempty"`
+	// The list of featured games
+	GameList []*FeaturedGameInfo `json:"gameList,omitempty"`
+}
+
+// FeaturedGamesCall is a builder for "FeaturedGames"
+type FeaturedGamesCall struct {
+	ctx        context.Context
+	client     *Client
+	query      url.Values
+	pathParams map[string]string
+	region     Region
+}
+
+var featuredGamesInfo = &OperationInfo{
+	Name:     "FeaturedGames",
+	Resource: "featured-games",
+	Version:  "v1.0",
+	Method:   "GET",
+	Path:     "/observer-mode/rest/featured",
+	DocURL:   "https://developer.riotgames.com/api/methods#!/977/3337",
+	Regions:  []Region{BR, EUNE, EUW, KR, LAN, LAS, NA, OCE, PBE, RU, TR},
+	Errors: []OperationError{
+		{403, "Forbidden"},
+		{429, "Rate limit exceeded"},
+	},
+}
+
+// Info returns information of the operation.
+func (c *FeaturedGamesCall) Info() *OperationInfo { return featuredGamesInfo }
+
+// Get list of featured games.
+//
+//
+// GET: /observer-mode/rest/featured
+//
+// Reference: https://developer.riotgames.com/api/methods#!/977/3337
+func (c *Client) FeaturedGames(ctx context.Context, region Region) *FeaturedGamesCall {
+	path := make(map[string]string)
+	return &FeaturedGamesCall{ctx: ctx, client: c, query: make(url.Values), pathParams: path, region: region}
+}
+
+func (c *FeaturedGamesCall) doRequest() (*http.Response, error) {
+	var body io.Reader
+	switch c.region {
+	case BR, EUNE, EUW, KR, LAN, LAS, NA, OCE, PBE, RU, TR:
+	default:
+		return nil, ErrNotSupportedRegion
+	}
+	c.query.Set("api_key", c.client.apiKey)
+
+	path, err := uritemplates.Expand("/observer-mode/rest/featured", c.pathParams)
+	if err != nil {
+		return nil, err
+	}
+	urls := c.region.baseURL() + path + "?" + c.query.Encode()
+
+	return c.client.doRequest(c.ctx, featuredGamesInfo, urls, body)
+}
+
+// Do executes api request.
+//
+// API Errors:
+//  403 - Forbidden
+//  429 - Rate limit exceeded
+func (c *FeaturedGamesCall) Do() (*FeaturedGames, error) {
+	res, err := c.doRequest()
+	if err != nil {
+		return nil, err
+	}
+	defer closeBody(res)
+	if err := verifyAPIResponse(res); err != nil {
+		return nil, err
+	}
+	ret := &FeaturedGames{}
+	if err := json.NewDecoder(res.Body).Decode(&ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
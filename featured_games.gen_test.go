@@ -0,0 +1,22 @@
+// Generated by go-lol-generator. DO NOT EDIT.
+
+package lol
+
+import "testing"
+import "golang.org/x/net/context"
+
+func TestFeaturedGamesOperations(t *testing.T) {
+	runOperationTests(t, []operationTest{
+		{
+			name:        "FeaturedGames",
+			region:      NA,
+			unsupported: Global,
+			path:        "/observer-mode/rest/featured",
+			apiKey:      true,
+			fixture:     "{\"clientRefreshInterval\":1,\"gameList\":[{\"bannedChampions\":[{\"championId\":1,\"pickTurn\":1,\"teamId\":1}],\"gameId\":1,\"gameLength\":1,\"gameMode\":\"example\",\"gameQueueConfigId\":1,\"gameStartTime\":1,\"gameType\":\"example\",\"mapId\":1,\"observers\":{\"encryptionKey\":\"example\"},\"participants\":[{\"bot\":true,\"championId\":1,\"profileIconId\":1,\"spell1Id\":1,\"spell2Id\":1,\"summonerName\":\"example\",\"teamId\":1}],\"platformId\":\"example\"}]}",
+			do: func(c *Client, region Region) (interface{}, error) {
+				return c.FeaturedGames(context.TODO(), region).Do()
+			},
+		},
+	})
+}
@@ -0,0 +1,22 @@
+// Generated by go-lol-generator. DO NOT EDIT.
+
+package lol_test
+
+import "fmt"
+import "log"
+import "os"
+import "golang.org/x/net/context"
+import "github.com/go-lol/lol"
+
+func ExampleClient_FeaturedGames() {
+	client, err := lol.New(nil, os.Getenv("RIOT_API_KEY"))
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	ret, err := client.FeaturedGames(context.TODO(), lol.NA).Do()
+	if err != nil {
+		log.Fatal(err)
+	}
+	fmt.Println(ret)
+}
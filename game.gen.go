@@ -0,0 +1,248 @@
+// Generated by go-lol-generator. DO NOT EDIT.
+
+package lol
+
+import "encoding/json"
+import "io"
+import "net/http"
+import "net/url"
+import "golang.org/x/net/context"
+import "github.com/go-lol/lol/uritemplates"
+
+// GameDto - This object contains game information.
+//
+// resource: "game", original name: "GameDto"
+type Game struct {
+	// Champion ID associated with game.
+	ChampionID int32 `json:"championId,omitempty"`
+	// Date that end game data was recorded, specified as epoch milliseconds.
+	CreateDate EpochMillis `json:"createDate,omitempty"`
+	// Other players associated with the game.
+	FellowPlayers []*Player `json:"fellowPlayers,omitempty"`
+	// Game ID.
+	GameID int64 `json:"gameId,omitempty"`
+	// Game mode. (Legal values: CLASSIC, ODIN, ARAM, TUTORIAL, ONEFORALL, ASCENSION, FIRSTBLOOD, KINGPORO)
+	GameMode string `json:"gameMode,omitempty"`
+	// Game type. (Legal values: CUSTOM_GAME, MATCHED_GAME, TUTORIAL_GAME)
+	GameType string `json:"gameType,omitempty"`
+	// Invalid flag.
+	Invalid bool `json:"invalid,omitempty"`
+	// IP Earned.
+	IPEarned int32 `json:"ipEarned,omitempty"`
+	// Level.
+	Level int32 `json:"level,omitempty"`
+	// Map ID.
+	MapID int32 `json:"mapId,omitempty"`
+	// ID of first summoner spell.
+	Spell1 int32 `json:"spell1,omitempty"`
+	// ID of second summoner spell.
+	Spell2 int32 `json:"spell2,omitempty"`
+	// Statistics associated with the game for this summoner.
+	Stats *RawStats `json:"stats,omitempty"`
+	// Game sub-type. (Legal values: NONE, NORMAL, BOT, RANKED_SOLO_5x5, RANKED_PREMADE_3x3, RANKED_PREMADE_5x5, ODIN_UNRANKED, RANKED_TEAM_3x3, RANKED_TEAM_5x5, NORMAL_3x3, BOT_3x3, CAP_5x5, ARAM_UNRANKED_5x5, ONEFORALL_5x5, FIRSTBLOOD_1x1, FIRSTBLOOD_2x2, SR_6x6, URF, URF_BOT, NIGHTMARE_BOT, ASCENSION, HEXAKILL, KING_PORO, COUNTER_PICK, BILGEWATER)
+	SubType string `json:"subType,omitempty"`
+	// Team ID associated with game. Team ID 100 is blue team. Team ID 200 is purple team.
+	TeamID int32 `json:"teamId,omitempty"`
+}
+
+// PlayerDto - This object contains player information.
+//
+// resource: "game", original name: "PlayerDto"
+type Player struct {
+	// Champion id associated with player.
+	ChampionID int32 `json:"championId,omitempty"`
+	// Summoner id associated with player.
+	SummonerID int64 `json:"summonerId,omitempty"`
+	// Team id associated with player.
+	TeamID int32 `json:"teamId,omitempty"`
+}
+
+// RawStatsDto - This object contains raw stat information.
+//
+// resource: "game", original name: "RawStatsDto"
+type RawStats struct {
+	Assists int32 `json:"assists,omitempty"`
+	// Number of enemy inhibitors killed.
+	BarracksKilled        int32 `json:"barracksKilled,omitempty"`
+	ChampionsKilled       int32 `json:"championsKilled,omitempty"`
+	CombatPlayerScore     int32 `json:"combatPlayerScore,omitempty"`
+	ConsumablesPurchased  int32 `json:"consumablesPurchased,omitempty"`
+	DamageDealtPlayer     int32 `json:"damageDealtPlayer,omitempty"`
+	DoubleKills           int32 `json:"doubleKills,omitempty"`
+	FirstBlood            int32 `json:"firstBlood,omitempty"`
+	Gold                  int32 `json:"gold,omitempty"`
+	GoldEarned            int32 `json:"goldEarned,omitempty"`
+	GoldSpent             int32 `json:"goldSpent,omitempty"`
+	Item0                 int32 `json:"item0,omitempty"`
+	Item1                 int32 `json:"item1,omitempty"`
+	Item2                 int32 `json:"item2,omitempty"`
+	Item3                 int32 `json:"item3,omitempty"`
+	Item4                 int32 `json:"item4,omitempty"`
+	Item5                 int32 `json:"item5,omitempty"`
+	Item6                 int32 `json:"item6,omitempty"`
+	ItemsPurchased        int32 `json:"itemsPurchased,omitempty"`
+	KillingSprees         int32 `json:"killingSprees,omitempty"`
+	LargestCriticalStrike int32 `json:"largestCriticalStrike,omitempty"`
+	LargestKillingSpree   int32 `json:"largestKillingSpree,omitempty"`
+	LargestMultiKill      int32 `json:"largestMultiKill,omitempty"`
+	// Number of tier 3 items built.
+	LegendaryItemsCreated           int32 `json:"legendaryItemsCreated,omitempty"`
+	Level                           int32 `json:"level,omitempty"`
+	MagicDamageDealtPlayer          int32 `json:"magicDamageDealtPlayer,omitempty"`
+	MagicDamageDealtToChampions     int32 `json:"magicDamageDealtToChampions,omitempty"`
+	MagicDamageTaken                int32 `json:"magicDamageTaken,omitempty"`
+	MinionsDenied                   int32 `json:"minionsDenied,omitempty"`
+	MinionsKilled                   int32 `json:"minionsKilled,omitempty"`
+	NeutralMinionsKilled            int32 `json:"neutralMinionsKilled,omitempty"`
+	NeutralMinionsKilledEnemyJungle int32 `json:"neutralMinionsKilledEnemyJungle,omitempty"`
+	NeutralMinionsKilledYourJungle  int32 `json:"neutralMinionsKilledYourJungle,omitempty"`
+	// Flag specifying if the summoner got the killing blow on the nexus.
+	NexusKilled                    bool  `json:"nexusKilled,omitempty"`
+	NodeCapture                    int32 `json:"nodeCapture,omitempty"`
+	NodeCaptureAssist              int32 `json:"nodeCaptureAssist,omitempty"`
+	NodeNeutralize                 int32 `json:"nodeNeutralize,omitempty"`
+	NodeNeutralizeAssist           int32 `json:"nodeNeutralizeAssist,omitempty"`
+	NumDeaths                      int32 `json:"numDeaths,omitempty"`
+	NumItemsBought                 int32 `json:"numItemsBought,omitempty"`
+	ObjectivePlayerScore           int32 `json:"objectivePlayerScore,omitempty"`
+	PentaKills                     int32 `json:"pentaKills,omitempty"`
+	PhysicalDamageDealtPlayer      int32 `json:"physicalDamageDealtPlayer,omitempty"`
+	PhysicalDamageDealtToChampions int32 `json:"physicalDamageDealtToChampions,omitempty"`
+	PhysicalDamageTaken            int32 `json:"physicalDamageTaken,omitempty"`
+	// Player position (Legal values: TOP(1), MIDDLE(2), JUNGLE(3), BOT(4))
+	PlayerPosition int32 `json:"playerPosition,omitempty"`
+	// Player role (Legal values: DUO(1), SUPPORT(2), CARRY(3), SOLO(4))
+	PlayerRole       int32 `json:"playerRole,omitempty"`
+	QuadraKills      int32 `json:"quadraKills,omitempty"`
+	SightWardsBought int32 `json:"sightWardsBought,omitempty"`
+	// Number of times first champion spell was cast.
+	Spell1Cast int32 `json:"spell1Cast,omitempty"`
+	// Number of times second champion spell was cast.
+	Spell2Cast int32 `json:"spell2Cast,omitempty"`
+	// Number of times third champion spell was cast.
+	Spell3Cast int32 `json:"spell3Cast,omitempty"`
+	// Number of times fourth champion spell was cast.
+	Spell4Cast                  int32   `json:"spell4Cast,omitempty"`
+	SummonSpell1Cast            int32   `json:"summonSpell1Cast,omitempty"`
+	SummonSpell2Cast            int32   `json:"summonSpell2Cast,omitempty"`
+	SuperMonsterKilled          int32   `json:"superMonsterKilled,omitempty"`
+	Team                        int32   `json:"team,omitempty"`
+	TeamObjective               int32   `json:"teamObjective,omitempty"`
+	TimePlayed                  Seconds `json:"timePlayed,omitempty"`
+	TotalDamageDealt            int32   `json:"totalDamageDealt,omitempty"`
+	TotalDamageDealtToChampions int32   `json:"totalDamageDealtToChampions,omitempty"`
+	TotalDamageTaken            int32   `json:"totalDamageTaken,omitempty"`
+	TotalHeal                   int32   `json:"totalHeal,omitempty"`
+	TotalPlayerScore            int32   `json:"totalPlayerScore,omitempty"`
+	TotalScoreRank              int32   `json:"totalScoreRank,omitempty"`
+	TotalTimeCrowdControlDealt  int32   `json:"totalTimeCrowdControlDealt,omitempty"`
+	TotalUnitsHealed            int32   `json:"totalUnitsHealed,omitempty"`
+	TripleKills                 int32   `json:"tripleKills,omitempty"`
+	TrueDamageDealtPlayer       int32   `json:"trueDamageDealtPlayer,omitempty"`
+	TrueDamageDealtToChampions  int32   `json:"trueDamageDealtToChampions,omitempty"`
+	TrueDamageTaken             int32   `json:"trueDamageTaken,omitempty"`
+	TurretsKilled               int32   `json:"turretsKilled,omitempty"`
+	UnrealKills                 int32   `json:"unrealKills,omitempty"`
+	VictoryPointTotal           int32   `json:"victoryPointTotal,omitempty"`
+	VisionWardsBought           int32   `json:"visionWardsBought,omitempty"`
+	WardKilled                  int32   `json:"wardKilled,omitempty"`
+	WardPlaced                  int32   `json:"wardPlaced,omitempty"`
+	// Flag specifying whether or not this game was won.
+	Win bool `json:"win,omitempty"`
+}
+
+// RecentGamesDto - This object contains recent games information.
+//
+// resource: "game", original name: "RecentGamesDto"
+type RecentGames struct {
+	// Collection of recent games played (max 10).
+	Games []*Game `json:"games,omitempty"`
+	// Summoner ID.
+	SummonerID int64 `json:"summonerId,omitempty"`
+}
+
+// RecentGamesCall is a builder for "RecentGames"
+type RecentGamesCall struct {
+	ctx        context.Context
+	client     *Client
+	query      url.Values
+	pathParams map[string]string
+	region     Region
+}
+
+var recentGamesInfo = &OperationInfo{
+	Name:     "RecentGames",
+	Resource: "game",
+	Version:  "v1.3",
+	Method:   "GET",
+	Path:     "/api/lol/{region}/v1.3/game/by-summoner/{summonerId}/recent",
+	DocURL:   "https://developer.riotgames.com/api/methods#!/1016/3445",
+	Regions:  []Region{BR, EUNE, EUW, KR, LAN, LAS, NA, OCE, RU, TR},
+	Errors: []OperationError{
+		{400, "Bad request"},
+		{401, "Unauthorized"},
+		{404, "Game data not found"},
+		{429, "Rate limit exceeded"},
+		{500, "Internal server error"},
+		{503, "Service unavailable"},
+	},
+}
+
+// Info returns information of the operation.
+func (c *RecentGamesCall) Info() *OperationInfo { return recentGamesInfo }
+
+// Get recent games by summoner ID.
+//
+//
+// GET: /api/lol/{region}/v1.3/game/by-summoner/{summonerId}/recent
+//
+// Reference: https://developer.riotgames.com/api/methods#!/1016/3445
+func (c *Client) RecentGames(ctx context.Context, region Region, summonerID int64) *RecentGamesCall {
+	path := make(map[string]string)
+	path["summonerId"] = convertToString(summonerID)
+	return &RecentGamesCall{ctx: ctx, client: c, query: make(url.Values), pathParams: path, region: region}
+}
+
+func (c *RecentGamesCall) doRequest() (*http.Response, error) {
+	var body io.Reader
+	switch c.region {
+	case BR, EUNE, EUW, KR, LAN, LAS, NA, OCE, RU, TR:
+	default:
+		return nil, ErrNotSupportedRegion
+	}
+	c.query.Set("api_key", c.client.apiKey)
+	c.pathParams["region"] = c.region.Name()
+
+	path, err := uritemplates.Expand("/api/lol/{region}/v1.3/game/by-summoner/{summonerId}/recent", c.pathParams)
+	if err != nil {
+		return nil, err
+	}
+	urls := c.region.baseURL() + path + "?" + c.query.Encode()
+
+	return c.client.doRequest(c.ctx, recentGamesInfo, urls, body)
+}
+
+// Do executes api request.
+//
+// API Errors:
+//  400 - Bad request
+//  401 - Unauthorized
+//  404 - Game data not found
+//  429 - Rate limit exceeded
+//  500 - Internal server error
+//  503 - Service unavailable
+func (c *RecentGamesCall) Do() (*RecentGames, error) {
+	res, err := c.doRequest()
+	if err != nil {
+		return nil, err
+	}
+	defer closeBody(res)
+	if err := verifyAPIResponse(res); err != nil {
+		return nil, err
+	}
+	ret := &RecentGames{}
+	if err := json.NewDecoder(res.Body).Decode(&ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
@@ -0,0 +1,22 @@
+// Generated by go-lol-generator. DO NOT EDIT.
+
+package lol
+
+import "testing"
+import "golang.org/x/net/context"
+
+func TestGameOperations(t *testing.T) {
+	runOperationTests(t, []operationTest{
+		{
+			name:        "RecentGames",
+			region:      NA,
+			unsupported: Global,
+			path:        "/api/lol/na/v1.3/game/by-summoner/1/recent",
+			apiKey:      true,
+			fixture:     "{\"games\":[{\"championId\":1,\"createDate\":1,\"fellowPlayers\":[{\"championId\":1,\"summonerId\":1,\"teamId\":1}],\"gameId\":1,\"gameMode\":\"example\",\"gameType\":\"example\",\"invalid\":true,\"ipEarned\":1,\"level\":1,\"mapId\":1,\"spell1\":1,\"spell2\":1,\"stats\":{\"assists\":1,\"barracksKilled\":1,\"championsKilled\":1,\"combatPlayerScore\":1,\"consumablesPurchased\":1,\"damageDealtPlayer\":1,\"doubleKills\":1,\"firstBlood\":1,\"gold\":1,\"goldEarned\":1,\"goldSpent\":1,\"item0\":1,\"item1\":1,\"item2\":1,\"item3\":1,\"item4\":1,\"item5\":1,\"item6\":1,\"itemsPurchased\":1,\"killingSprees\":1,\"largestCriticalStrike\":1,\"largestKillingSpree\":1,\"largestMultiKill\":1,\"legendaryItemsCreated\":1,\"level\":1,\"magicDamageDealtPlayer\":1,\"magicDamageDealtToChampions\":1,\"magicDamageTaken\":1,\"minionsDenied\":1,\"minionsKilled\":1,\"neutralMinionsKilled\":1,\"neutralMinionsKilledEnemyJungle\":1,\"neutralMinionsKilledYourJungle\":1,\"nexusKilled\":true,\"nodeCapture\":1,\"nodeCaptureAssist\":1,\"nodeNeutralize\":1,\"nodeNeutralizeAssist\":1,\"numDeaths\":1,\"numItemsBought\":1,\"objectivePlayerScore\":1,\"pentaKills\":1,\"physicalDamageDealtPlayer\":1,\"physicalDamageDealtToChampions\":1,\"physicalDamageTaken\":1,\"playerPosition\":1,\"playerRole\":1,\"quadraKills\":1,\"sightWardsBought\":1,\"spell1Cast\":1,\"spell2Cast\":1,\"spell3Cast\":1,\"spell4Cast\":1,\"summonSpell1Cast\":1,\"summonSpell2Cast\":1,\"superMonsterKilled\":1,\"team\":1,\"teamObjective\":1,\"timePlayed\":1,\"totalDamageDealt\":1,\"totalDamageDealtToChampions\":1,\"totalDamageTaken\":1,\"totalHeal\":1,\"totalPlayerScore\":1,\"totalScoreRank\":1,\"totalTimeCrowdControlDealt\":1,\"totalUnitsHealed\":1,\"tripleKills\":1,\"trueDamageDealtPlayer\":1,\"trueDamageDealtToChampions\":1,\"trueDamageTaken\":1,\"turretsKilled\":1,\"unrealKills\":1,\"victoryPointTotal\":1,\"visionWardsBought\":1,\"wardKilled\":1,\"wardPlaced\":1,\"win\":true},\"subType\":\"example\",\"teamId\":1}],\"summonerId\":1}",
+			do: func(c *Client, region Region) (interface{}, error) {
+				return c.RecentGames(context.TODO(), region, 1).Do()
+			},
+		},
+	})
+}
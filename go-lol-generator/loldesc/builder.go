@@ -1,7 +1,326 @@
 package loldesc
 
-//TODO: lolregi.Registry -> loldesc.Builder
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"sort"
+)
+
+// builtinTypes are named types which are not response classes.
+var builtinTypes = map[string]bool{
+	"bool": true, "int32": true, "int64": true, "string": true,
+	"float32": true, "float64": true,
+	// handwritten
+	"SpellRange": true, "EpochMillis": true, "Millis": true, "Seconds": true,
+}
 
 // Builder is a helper to generate customized document.
+//
+// Usage:
+//
+//	doc, err := loldesc.NewBuilder().
+//		AddResource(res).
+//		AddClass(cls).
+//		RenameClass("ChampionData", "StaticChampion").
+//		Document()
 type Builder struct {
+	regions   []*Region
+	resources []*Resource
+	classes   []*Class
+
+	err error
+}
+
+// NewBuilder creates an empty builder.
+func NewBuilder() *Builder {
+	return &Builder{}
+}
+
+// AddRegion adds a region.
+func (b *Builder) AddRegion(r *Region) *Builder {
+	b.regions = append(b.regions, r)
+	return b
+}
+
+// AddResource adds a resource with its operations.
+func (b *Builder) AddResource(r *Resource) *Builder {
+	b.resources = append(b.resources, r)
+	return b
+}
+
+// AddClass adds a response class.
+func (b *Builder) AddClass(c *Class) *Builder {
+	b.classes = append(b.classes, c)
+	return b
+}
+
+// AddDocument adds all regions, resources and classes of the document.
+func (b *Builder) AddDocument(doc *Document) *Builder {
+	b.regions = append(b.regions, doc.Regions...)
+	b.resources = append(b.resources, doc.Resources...)
+	b.classes = append(b.classes, doc.Classes...)
+	return b
+}
+
+// AddOldVersion adds an old version of a resource with its classes,
+// to generate it side by side with the current version.
+// The current version must be added first.
+//
+// Operations and classes are renamed with VersionSuffix, like "MatchV2_2".
+// Given objects are modified.
+func (b *Builder) AddOldVersion(r *Resource, classes []*Class) *Builder {
+	suffix := VersionSuffix(r.Version)
+
+	names := make(map[string]bool)
+	for _, c := range classes {
+		names[c.Name] = true
+	}
+	rename := func(name string) string {
+		if names[name] {
+			return name + suffix
+		}
+		return name
+	}
+
+	for _, c := range classes {
+		c.Name = rename(c.Name)
+		c.ResourceVersion = r.Version
+		for _, f := range c.Fields {
+			f.Type = b.rewriteType(f.Type, rename)
+		}
+		b.classes = append(b.classes, c)
+	}
+
+	for _, e := range r.Endpoints {
+		for _, op := range e.Operations {
+			op.Name += suffix
+			op.ReturnValue = b.rewriteType(op.ReturnValue, rename)
+			for _, p := range op.PathParams {
+				p.Type = b.rewriteType(p.Type, rename)
+			}
+			for _, p := range op.QueryParams {
+				p.Type = b.rewriteType(p.Type, rename)
+			}
+		}
+	}
+	b.resources = append(b.resources, r)
+	return b
+}
+
+// RemoveResource removes a resource and classes declared by it.
+func (b *Builder) RemoveResource(id string) *Builder {
+	resources := b.resources[:0]
+	for _, r := range b.resources {
+		if r.ID != id {
+			resources = append(resources, r)
+		}
+	}
+	b.resources = resources
+
+	classes := b.classes[:0]
+	for _, c := range b.classes {
+		if c.Resource != id {
+			classes = append(classes, c)
+		}
+	}
+	b.classes = classes
+	return b
+}
+
+// RenameClass renames a class and updates all types referring it.
+func (b *Builder) RenameClass(old, new string) *Builder {
+	rename := func(name string) string {
+		if name == old {
+			return new
+		}
+		return name
+	}
+
+	for _, c := range b.classes {
+		c.Name = rename(c.Name)
+		for _, f := range c.Fields {
+			f.Type = b.rewriteType(f.Type, rename)
+		}
+	}
+
+	for _, r := range b.resources {
+		for _, e := range r.Endpoints {
+			for _, op := range e.Operations {
+				op.ReturnValue = b.rewriteType(op.ReturnValue, rename)
+				for _, p := range op.PathParams {
+					p.Type = b.rewriteType(p.Type, rename)
+				}
+				for _, p := range op.QueryParams {
+					p.Type = b.rewriteType(p.Type, rename)
+				}
+			}
+		}
+	}
+	return b
+}
+
+// Document validates added objects and returns a document.
+//
+// Classes are sorted by name.
+func (b *Builder) Document() (*Document, error) {
+	if b.err != nil {
+		return nil, b.err
+	}
+
+	regions := make(map[string]bool)
+	for _, r := range b.regions {
+		if regions[r.Name] {
+			return nil, fmt.Errorf("duplicate region %s", r.Name)
+		}
+		regions[r.Name] = true
+	}
+
+	classes := make(map[string]bool)
+	for _, c := range b.classes {
+		if classes[c.Name] {
+			return nil, fmt.Errorf("duplicate class %s (resource: %s)", c.Name, c.Resource)
+		}
+		classes[c.Name] = true
+	}
+
+	checkType := func(where, expr string) error {
+		names, err := TypeNames(expr)
+		if err != nil {
+			return fmt.Errorf("%s: %v", where, err)
+		}
+		for _, name := range names {
+			if !builtinTypes[name] && !classes[name] {
+				return fmt.Errorf("%s: unknown type %s", where, name)
+			}
+		}
+		return nil
+	}
+
+	for _, c := range b.classes {
+		for _, f := range c.Fields {
+			if err := checkType(c.Name+"."+f.Name, f.Type); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	resources := make(map[string]bool)
+	for _, r := range b.resources {
+		if resources[r.ID+" "+r.Version] {
+			return nil, fmt.Errorf("duplicate resource %s %s", r.ID, r.Version)
+		}
+		resources[r.ID+" "+r.Version] = true
+
+		for _, name := range r.Regions {
+			if !regions[name] {
+				return nil, fmt.Errorf("%s: unknown region %s", r.ID, name)
+			}
+		}
+
+		for _, e := range r.Endpoints {
+			for _, op := range e.Operations {
+				where := r.ID + "." + op.Name
+				if err := checkType(where, op.ReturnValue); err != nil {
+					return nil, err
+				}
+				for _, p := range op.PathParams {
+					if err := checkType(where+"."+p.Raw, p.Type); err != nil {
+						return nil, err
+					}
+				}
+				for _, p := range op.QueryParams {
+					if err := checkType(where+"."+p.Raw, p.Type); err != nil {
+						return nil, err
+					}
+				}
+			}
+		}
+	}
+
+	doc := &Document{
+		Regions:   b.regions,
+		Resources: b.resources,
+		Classes:   append([]*Class(nil), b.classes...),
+	}
+	sort.Sort(classesByName(doc.Classes))
+	return doc, nil
 }
+
+func (b *Builder) rewriteType(expr string, rename func(string) string) string {
+	if b.err != nil {
+		return expr
+	}
+
+	e, err := parser.ParseExpr(expr)
+	if err != nil {
+		b.err = fmt.Errorf("invalid type %q: %v", expr, err)
+		return expr
+	}
+
+	ast.Inspect(e, func(n ast.Node) bool {
+		if id, ok := n.(*ast.Ident); ok {
+			id.Name = rename(id.Name)
+		}
+		return true
+	})
+
+	var buf bytes.Buffer
+	if err := format.Node(&buf, token.NewFileSet(), e); err != nil {
+		b.err = err
+		return expr
+	}
+	return buf.String()
+}
+
+// TypeNames returns names of named types used in the type expression.
+//
+// TypeNames("map[string]*ChampionData") returns ["string", "ChampionData"].
+func TypeNames(expr string) ([]string, error) {
+	e, err := parser.ParseExpr(expr)
+	if err != nil {
+		return nil, fmt.Errorf("invalid type %q: %v", expr, err)
+	}
+
+	var names []string
+	var walk func(e ast.Expr) error
+	walk = func(e ast.Expr) error {
+		switch e := e.(type) {
+		case *ast.Ident:
+			names = append(names, e.Name)
+		case *ast.StarExpr:
+			return walk(e.X)
+		case *ast.ArrayType:
+			if e.Len != nil {
+				return fmt.Errorf("invalid type %q: arrays are not supported", expr)
+			}
+			return walk(e.Elt)
+		case *ast.MapType:
+			if err := walk(e.Key); err != nil {
+				return err
+			}
+			return walk(e.Value)
+		case *ast.InterfaceType:
+			if e.Methods != nil && len(e.Methods.List) != 0 {
+				return fmt.Errorf("invalid type %q: only empty interface is supported", expr)
+			}
+		default:
+			return fmt.Errorf("invalid type %q", expr)
+		}
+		return nil
+	}
+
+	if err := walk(e); err != nil {
+		return nil, err
+	}
+	return names, nil
+}
+
+type classesByName []*Class
+
+func (cs classesByName) Len() int           { return len(cs) }
+func (cs classesByName) Swap(i, j int)      { cs[i], cs[j] = cs[j], cs[i] }
+func (cs classesByName) Less(i, j int) bool { return cs[i].Name < cs[j].Name }
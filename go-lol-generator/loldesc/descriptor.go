@@ -1,4 +1,207 @@
 package loldesc
 
+import (
+	"encoding/json"
+	"io"
+	"os"
+	"strings"
+	"time"
+)
+
+// Document is a serializable description of the riot api.
+//
+// Names and types are already resolved, so a document can be
+// hand-edited or checked in and used to generate code without
+// fetching the api reference.
+//
+// Types are golang type expressions relative to the lol package,
+// like "int64", "[]*Item" or "map[string]*ChampionData".
 type Document struct {
+	Regions   []*Region   `json:"regions"`
+	Resources []*Resource `json:"resources"`
+	Classes   []*Class    `json:"classes"`
+}
+
+// Region represents a league of legends service area.
+type Region struct {
+	Name       string     `json:"name"`
+	PlatformID string     `json:"platformId,omitempty"`
+	Host       string     `json:"host"`
+	Number     int32      `json:"number"`
+	LaunchedAt *time.Time `json:"launchedAt,omitempty"`
+}
+
+// Resource is an api resource like "summoner" or "match".
+//
+// Multiple versions of a resource can be declared during deprecation
+// windows. The first one is the current version. See Builder.AddOldVersion
+type Resource struct {
+	ID      string   `json:"id"`
+	Version string   `json:"version"`
+	Num     int      `json:"num"` // numeric id
+	Regions []string `json:"regions"`
+
+	Endpoints []*Endpoint `json:"endpoints"`
+}
+
+// Endpoint is a group of operations.
+type Endpoint struct {
+	Operations []*Operation `json:"operations"`
+}
+
+// Operation is an api method.
+type Operation struct {
+	Name   string `json:"name"`
+	Desc   string `json:"desc,omitempty"`
+	Method string `json:"method"`
+	Num    int    `json:"num"` // numeric id
+	Path   string `json:"path"`
+
+	PathParams  []*Parameter `json:"pathParams,omitempty"`
+	QueryParams []*Parameter `json:"queryParams,omitempty"`
+
+	ReturnValue string `json:"returnValue"`
+	// Basic type to use as a key of returned map, like "int64".
+	MapKey string `json:"mapKey,omitempty"`
+
+	ImplementationNotes string `json:"implementationNotes,omitempty"`
+	RateLimitNotes      string `json:"rateLimitNotes,omitempty"`
+
+	Errors []*ResponseError `json:"errors,omitempty"`
+}
+
+// Parameter is a path or query parameter of an operation.
+type Parameter struct {
+	Raw      string `json:"raw"`
+	Name     string `json:"name"`
+	Desc     string `json:"desc,omitempty"`
+	Type     string `json:"type"`
+	Required bool   `json:"required,omitempty"`
+}
+
+// ResponseError is a documented error of an operation.
+type ResponseError struct {
+	Code int    `json:"code"`
+	Desc string `json:"desc"`
+}
+
+// Class is a response class.
+type Class struct {
+	Resource string `json:"resource"`
+	// Set if the class belongs to an old version of the resource.
+	ResourceVersion string `json:"resourceVersion,omitempty"`
+
+	RawName string   `json:"rawName"`
+	Name    string   `json:"name"`
+	Desc    string   `json:"desc,omitempty"`
+	Fields  []*Field `json:"fields"`
+}
+
+// Field is a field of a response class.
+type Field struct {
+	RawName string `json:"rawName"`
+	Name    string `json:"name"`
+	Type    string `json:"type"`
+	Desc    string `json:"desc,omitempty"`
+}
+
+// Read decodes a json document.
+func Read(r io.Reader) (*Document, error) {
+	doc := &Document{}
+	if err := json.NewDecoder(r).Decode(doc); err != nil {
+		return nil, err
+	}
+	return doc, nil
+}
+
+// ReadFile decodes a json document from the file.
+func ReadFile(filename string) (*Document, error) {
+	f, err := os.Open(filename)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	return Read(f)
+}
+
+// Write encodes the document as indented json.
+func (d *Document) Write(w io.Writer) error {
+	data, err := json.MarshalIndent(d, "", "  ")
+	if err != nil {
+		return err
+	}
+	data = append(data, '\n')
+
+	_, err = w.Write(data)
+	return err
+}
+
+// WriteFile writes the document to the file.
+func (d *Document) WriteFile(filename string) error {
+	f, err := os.Create(filename)
+	if err != nil {
+		return err
+	}
+
+	if err := d.Write(f); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+// Resource returns the current version of a resource with the id, or nil.
+func (d *Document) Resource(id string) *Resource {
+	for _, r := range d.Resources {
+		if r.ID == id {
+			return r
+		}
+	}
+	return nil
+}
+
+// ResourceVersions returns all versions of a resource.
+// The first one is the current version.
+func (d *Document) ResourceVersions(id string) []*Resource {
+	var versions []*Resource
+	for _, r := range d.Resources {
+		if r.ID == id {
+			versions = append(versions, r)
+		}
+	}
+	return versions
+}
+
+// ClassesOf returns classes declared by the resource.
+func (d *Document) ClassesOf(r *Resource) []*Class {
+	current := d.Resource(r.ID) == r
+
+	var classes []*Class
+	for _, c := range d.Classes {
+		if c.Resource != r.ID {
+			continue
+		}
+		if c.ResourceVersion == r.Version || (c.ResourceVersion == "" && current) {
+			classes = append(classes, c)
+		}
+	}
+	return classes
+}
+
+// VersionSuffix returns a suffix for names of an old version.
+//
+//	VersionSuffix("v2.2") == "V2_2"
+func VersionSuffix(version string) string {
+	return strings.Replace(strings.ToUpper(version), ".", "_", -1)
+}
+
+// Class returns a class with the name, or nil.
+func (d *Document) Class(name string) *Class {
+	for _, c := range d.Classes {
+		if c.Name == name {
+			return c
+		}
+	}
+	return nil
 }
@@ -4,13 +4,17 @@ import (
 	"bytes"
 	"fmt"
 	"go/ast"
+	"go/parser"
+	"go/token"
 	"go/types"
 	"log"
+	"sort"
 	"strconv"
 	"strings"
 	"unicode"
 
-	"github.com/jerrodrurik/go-lol/go-lol-generator/lolregi"
+	"github.com/go-lol/lol/go-lol-generator/loldesc"
+	"github.com/go-lol/lol/go-lol-generator/lolregi"
 )
 
 type Generator struct {
@@ -24,41 +28,198 @@ func New(reg *lolregi.Registry) *Generator {
 	return &Generator{Buffer: &bytes.Buffer{}, reg: reg}
 }
 
+// NewFromDocument creates a generator from a document,
+// without fetching the api reference page.
+func NewFromDocument(doc *loldesc.Document) (*Generator, error) {
+	reg, err := lolregi.NewFromDocument(lolregi.Config{}, doc)
+	if err != nil {
+		return nil, err
+	}
+	return New(reg), nil
+}
+
 func (g *Generator) Generate() []byte {
-	g.generatePackage()
+	g.Write(g.file(g.reg.Pkg.Name(), func(g *Generator) {
+		g.generateRegions(g.reg.Regions)
+		g.generateOperationTable()
+
+		for _, c := range g.classes() {
+			g.GenerateResponseClass(c)
+		}
+
+		for _, v := range g.reg.Resources {
+			g.generateResource(v)
+		}
+	}))
+
+	src := g.Bytes()
+	return src
+}
 
-	g.generateRegions(g.reg.Regions)
+// TypesFile contains regions, shared classes,
+// and classes of resources which are not generated. See GenerateFiles.
+const TypesFile = "types.gen.go"
+
+// GenerateFiles generates one file per resource, named by ResourceFile,
+// and TypesFile.
+//
+// Classes are declared in the file of resource declaring them,
+// unless they are shared. A class is shared if it's used by multiple
+// resources, or listed in lolregi.Overrides.SharedClasses.
+func (g *Generator) GenerateFiles() map[string][]byte {
+	shared := g.sharedClasses()
+
+	generated := make(map[*lolregi.Resource]bool)
+	for _, res := range g.reg.Resources {
+		generated[res] = true
+	}
+
+	files := make(map[string][]byte)
+	files[TypesFile] = g.file(g.reg.Pkg.Name(), func(g *Generator) {
+		g.generateRegions(g.reg.Regions)
+		g.generateOperationTable()
+
+		for _, c := range g.classes() {
+			if shared[c] || !generated[c.Resource()] {
+				g.GenerateResponseClass(c)
+			}
+		}
+	})
+
+	for _, res := range g.reg.Resources {
+		res := res
+		classes := make([]*lolregi.ResponseClass, 0)
+		for _, c := range g.classes() {
+			if !shared[c] && c.Resource() == res {
+				classes = append(classes, c)
+			}
+		}
+		if len(classes) == 0 && len(res.Endpoints) == 0 {
+			continue
+		}
+
+		files[ResourceFile(res)] = g.file(g.reg.Pkg.Name(), func(g *Generator) {
+			for _, c := range classes {
+				g.GenerateResponseClass(c)
+			}
+
+			g.generateResource(res)
+		})
+	}
+	return files
+}
+
+func (g *Generator) sharedClasses() map[*lolregi.ResponseClass]bool {
+	shared := make(map[*lolregi.ResponseClass]bool)
+	usedBy := make(map[*lolregi.ResponseClass]*lolregi.Resource)
+	for _, res := range g.reg.Resources {
+		for c := range res.UsedClasses() {
+			if r, ok := usedBy[c]; ok && r != res {
+				shared[c] = true
+			}
+			usedBy[c] = res
+		}
+	}
 
 	for _, c := range g.reg.Classes {
-		g.GenerateResponseClass(c)
+		if g.reg.Config.Overrides.IsShared(c.Name()) {
+			shared[c] = true
+		}
 	}
+	return shared
+}
+
+// ResourceFile returns a file name for a resource.
+//
+//	"lol-static-data" -> "static_data.gen.go"
+//	"current-game" -> "current_game.gen.go"
+//	"match" v2.2 (old version) -> "match_v2_2.gen.go"
+func ResourceFile(res *lolregi.Resource) string {
+	name := strings.TrimPrefix(res.ID, "lol-")
+	if !res.IsCurrent() {
+		name += "_" + strings.ToLower(loldesc.VersionSuffix(res.Version))
+	}
+	return strings.Replace(name, "-", "_", -1) + ".gen.go"
+}
+
+// file returns a source file with declarations printed by fn.
+func (g *Generator) file(pkgName string, fn func(g *Generator)) []byte {
+	body := New(g.reg)
+	fn(body)
 
-	for _, v := range g.reg.Resources {
-		g.generateResource(v)
+	f := New(g.reg)
+	f.generatePackage(pkgName, body.Bytes())
+	f.Write(body.Bytes())
+	return f.Bytes()
+}
+
+// classes returns response classes sorted by name.
+func (g *Generator) classes() []*lolregi.ResponseClass {
+	names := make([]string, 0, len(g.reg.Classes))
+	for name := range g.reg.Classes {
+		names = append(names, name)
 	}
+	sort.Strings(names)
 
-	src := g.Bytes()
-	return src
+	classes := make([]*lolregi.ResponseClass, len(names))
+	for i, name := range names {
+		classes[i] = g.reg.Classes[name]
+	}
+	return classes
 }
 
-func (g *Generator) generatePackage() {
+// imports used by generated code.
+var imports = []struct{ name, path string }{
+	{"json", "encoding/json"},
+	{"fmt", "fmt"},
+	{"io", "io"},
+	{"log", "log"},
+	{"os", "os"},
+	{"strconv", "strconv"},
+	{"testing", "testing"},
+	{"http", "net/http"},
+	{"url", "net/url"},
+	{"context", "golang.org/x/net/context"},
+}
+
+// generatePackage prints package clause, and imports used in body.
+func (g *Generator) generatePackage(pkgName string, body []byte) {
 	g.P(`// Generated by go-lol-generator. DO NOT EDIT.`)
 	g.P()
-	g.P(`package `, g.reg.Pkg.Name(), `;`)
+	g.P(`package `, pkgName, `;`)
 
-	g.P(`import "encoding/json"`)
-	g.P(`import "io"`)
-	g.P(`import "strconv"`)
-	g.P(`import "net/http"`)
-	g.P(`import "net/url"`)
+	used := usedPackages(body)
+	for _, imp := range imports {
+		if used[imp.name] {
+			g.P(`import `, strconv.Quote(imp.path))
+		}
+	}
+	if used["uritemplates"] {
+		g.P(`import `, strconv.Quote(g.reg.Pkg.Path()+"/uritemplates"))
+	}
+	if pkgName != g.reg.Pkg.Name() && used[g.reg.Pkg.Name()] {
+		g.P(`import `, strconv.Quote(g.reg.Pkg.Path()))
+	}
 	g.P()
+}
 
-	g.P(`import "golang.org/x/net/context"`)
-	g.P(`import `, strconv.Quote(g.reg.Pkg.Path()+"/uritemplates"))
-	g.P()
+// usedPackages returns names of packages referred in body.
+func usedPackages(body []byte) map[string]bool {
+	f, err := parser.ParseFile(token.NewFileSet(), "", append([]byte("package p;"), body...), 0)
+	if err != nil {
+		log.Panicf("Generated invalid code: %v", err)
+	}
 
-	g.P(`var _ = json.Marshal`)
-	g.P(`var _ = io.EOF`)
+	used := make(map[string]bool)
+	ast.Inspect(f, func(n ast.Node) bool {
+		if sel, ok := n.(*ast.SelectorExpr); ok {
+			if id, ok := sel.X.(*ast.Ident); ok && id.Obj == nil {
+				used[id.Name] = true
+			}
+		}
+		return true
+	})
+	return used
 }
 
 func (g *Generator) generateResource(res *lolregi.Resource) {
@@ -74,14 +235,18 @@ func (g *Generator) generateEndpoint(res *lolregi.Resource, e *lolregi.Endpoint)
 }
 
 func (g *Generator) generateOperation(res *lolregi.Resource, e *lolregi.Endpoint, op *lolregi.Operation) {
-	info, ret := op.Info(), op.ReturnValue
-	if info.MapKey != 0 {
+	ret := op.ReturnValue
+	if op.MapKey != 0 {
 		if m, ok := ret.(*types.Map); ok {
-			ret = types.NewMap(types.Typ[info.MapKey], m.Elem())
+			ret = types.NewMap(types.Typ[op.MapKey], m.Elem())
 		}
 	}
 
 	g.generateOpType(op)
+	g.generateOpInfo(res, op)
+	g.P(`// Info returns information of the operation.`)
+	g.P(`func (c *`, op.GoType(), `) Info() *OperationInfo { return `, opInfoVar(op), ` }`)
+	g.P()
 	g.generateOpCreatorFunc(res, e, op)
 	g.generateOpDoRequestFunc(op)
 
@@ -101,9 +266,9 @@ func (g *Generator) generateOperation(res *lolregi.Resource, e *lolregi.Endpoint
 
 	g.DeclareVar(`ret`, op.ReturnValue)
 	g.P(`if err := json.NewDecoder(res.Body).Decode(&ret); err != nil { return nil, err }`)
-	if info.MapKey != 0 {
+	if op.MapKey != 0 {
 		if m, ok := ret.(*types.Map); ok {
-			ret = types.NewMap(types.Typ[info.MapKey], m.Elem())
+			ret = types.NewMap(types.Typ[op.MapKey], m.Elem())
 		}
 
 		//TODO
@@ -259,6 +424,52 @@ func (g *Generator) generateOpType(op *lolregi.Operation) {
 	g.P()
 }
 
+// opInfoVar returns a name of variable holding OperationInfo of the operation.
+func opInfoVar(op *lolregi.Operation) string {
+	return funcName(op.Name, false) + "Info"
+}
+
+func (g *Generator) generateOpInfo(res *lolregi.Resource, op *lolregi.Operation) {
+	g.P(`var `, opInfoVar(op), ` = &OperationInfo{`)
+	g.P(`Name: `, strconv.Quote(op.Name), `,`)
+	g.P(`Resource: `, strconv.Quote(res.ID), `,`)
+	g.P(`Version: `, strconv.Quote(res.Version), `,`)
+	g.P(`Method: `, strconv.Quote(op.Method), `,`)
+	g.P(`Path: `, strconv.Quote(op.Path.String()), `,`)
+	g.P(`DocURL: `, strconv.Quote(op.DocURL()), `,`)
+	if op.RateLimitNotes != "" {
+		g.P(`RateLimitNotes: `, strconv.Quote(op.RateLimitNotes), `,`)
+	}
+	if op.RateLimitExempt() {
+		g.P(`RateLimitExempt: true,`)
+	}
+	g.P(`Regions: []Region{`, res.Regions.Join(","), `},`)
+	if len(op.Errors) != 0 {
+		g.P(`Errors: []OperationError{`)
+		for _, e := range op.Errors {
+			g.P(`{`, e.Code, `, `, strconv.Quote(e.Desc), `},`)
+		}
+		g.P(`},`)
+	}
+	g.P(`}`)
+	g.P()
+}
+
+// generateOperationTable prints OperationInfo of all operations by name.
+func (g *Generator) generateOperationTable() {
+	g.P(`// Operations is a table of all operations by the name of Client method.`)
+	g.P(`var Operations = map[string]*OperationInfo{`)
+	for _, res := range g.reg.Resources {
+		for _, e := range res.Endpoints {
+			for _, op := range e.Operations {
+				g.P(strconv.Quote(op.Name), `: `, opInfoVar(op), `,`)
+			}
+		}
+	}
+	g.P(`}`)
+	g.P()
+}
+
 func (g *Generator) generateOpDoRequestFunc(op *lolregi.Operation) {
 	g.P()
 	g.P(`func (c *`, op.GoType(), `) doRequest() (*http.Response, error) {`)
@@ -279,8 +490,8 @@ func (g *Generator) generateOpDoRequestFunc(op *lolregi.Operation) {
 	if op.Path.Has("region") {
 		g.P(`c.pathParams["region"] = c.region.Name()`)
 	}
-	if op.Path.Has("platformId") {
-		g.P(`c.pathParams["platformID"] = c.region.PlatformID()`)
+	if op.Path.Has("platformID") {
+		g.P(`c.pathParams["platformId"] = c.region.PlatformID()`)
 	}
 	g.P()
 
@@ -297,7 +508,7 @@ func (g *Generator) generateOpDoRequestFunc(op *lolregi.Operation) {
 	g.P(`urls := `, urlsTpl+` + path + "?" + c.query.Encode()`)
 	g.P()
 
-	g.P(`return c.client.doRequest(c.ctx, `, strconv.Quote(op.Method), `, urls, body)`)
+	g.P(`return c.client.doRequest(c.ctx, `, opInfoVar(op), `, urls, body)`)
 	g.P(`}`)
 	g.P()
 }
@@ -317,11 +528,37 @@ func (g *Generator) GenerateResponseClass(c *lolregi.ResponseClass) {
 			}
 		}
 
-		g.P(f.Name(), ` `, f.Type(), "`", f.Tag, "`")
+		typ := f.Type()
+		if g.reg.IsOptional(c, f) {
+			typ = types.NewPointer(typ)
+		}
+		g.P(f.Name(), ` `, typ, "`", f.Tag, "`")
 
 	}
 	g.P(`}`)
 	g.P()
+
+	g.generateOptionalAccessors(c)
+}
+
+// generateOptionalAccessors generates Get<Field> methods of optional fields,
+// returning false if the field is absent.
+func (g *Generator) generateOptionalAccessors(c *lolregi.ResponseClass) {
+	recv := strings.ToLower(c.Name()[:1])
+	for _, f := range c.Fields() {
+		if !g.reg.IsOptional(c, f) {
+			continue
+		}
+
+		g.P(`// Get`, f.Name(), ` returns `, f.Name(), `, or false if it's absent.`)
+		g.P(`func (`, recv, ` *`, c.Name(), `) Get`, f.Name(), `() (value `, f.Type(), `, ok bool) {`)
+		g.P(`if `, recv, ` == nil || `, recv, `.`, f.Name(), ` == nil {`)
+		g.P(`return`)
+		g.P(`}`)
+		g.P(`return *`, recv, `.`, f.Name(), `, true`)
+		g.P(`}`)
+		g.P()
+	}
 }
 
 func (g *Generator) P(args ...interface{}) {
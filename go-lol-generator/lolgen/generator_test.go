@@ -0,0 +1,152 @@
+package lolgen
+
+import (
+	"go/format"
+	"strings"
+	"testing"
+
+	"github.com/go-lol/lol/go-lol-generator/loldesc"
+	"github.com/go-lol/lol/go-lol-generator/lolregi"
+)
+
+func TestGenerateFiles(t *testing.T) {
+	doc := newTestDocument()
+	doc.Classes = append(doc.Classes, &loldesc.Class{
+		Resource: "summoner", RawName: "ImageDto", Name: "Image",
+		Fields: []*loldesc.Field{{RawName: "full", Name: "Full", Type: "string"}},
+	})
+	doc.Classes[1].Fields = append(doc.Classes[1].Fields, &loldesc.Field{RawName: "image", Name: "Image", Type: "*Image"})
+
+	g, err := NewFromDocument(doc)
+	if err != nil {
+		t.Fatal(err)
+		return
+	}
+
+	files := g.GenerateFiles()
+	if len(files) != 2 || files[TypesFile] == nil || files["summoner.gen.go"] == nil {
+		t.Fatalf("Invalid files %v", files)
+		return
+	}
+
+	for name, src := range files {
+		if _, err := format.Source(src); err != nil {
+			t.Fatalf("%s: %v\n%s", name, err, src)
+			return
+		}
+	}
+
+	types, summoner := string(files[TypesFile]), string(files["summoner.gen.go"])
+	if !strings.Contains(types, "type Image struct") || strings.Contains(summoner, "type Image struct") {
+		t.Fatal("Expected Image to be declared in " + TypesFile)
+		return
+	}
+	if strings.Contains(types, "import") || !strings.Contains(summoner, `import "net/url"`) {
+		t.Fatal("Invalid imports")
+	}
+}
+
+func TestGenerateTestFiles(t *testing.T) {
+	g, err := NewFromDocument(newTestDocument())
+	if err != nil {
+		t.Fatal(err)
+		return
+	}
+
+	files := g.GenerateTestFiles()
+	if len(files) != 2 {
+		t.Fatalf("Invalid files %v", files)
+		return
+	}
+	for name, src := range files {
+		if files[name], err = format.Source(src); err != nil {
+			t.Fatalf("%s: %v\n%s", name, err, src)
+			return
+		}
+	}
+
+	test, example := string(files["summoner.gen_test.go"]), string(files["summoner_example.gen_test.go"])
+
+	if !strings.Contains(test, `path:        "/api/lol/na/v1.4/summoner/1"`) ||
+		!strings.Contains(test, `unsupported: Global`) {
+		t.Fatalf("Invalid test:\n%s", test)
+		return
+	}
+	if !strings.Contains(example, "func ExampleClient_Summoners()") ||
+		!strings.Contains(example, `import "github.com/go-lol/lol"`) {
+		t.Fatalf("Invalid example:\n%s", example)
+	}
+}
+
+func TestGenerateOldVersion(t *testing.T) {
+	old := newTestDocument()
+	res := old.Resources[0]
+
+	current := newTestDocument()
+	current.Resources[0].Version = "v1.5"
+	current.Resources[0].Endpoints[0].Operations[0].Path = "/api/lol/{region}/v1.5/summoner/{summonerIds}"
+
+	doc, err := loldesc.NewBuilder().AddDocument(current).AddOldVersion(res, old.ClassesOf(res)).Document()
+	if err != nil {
+		t.Fatal(err)
+		return
+	}
+
+	g, err := NewFromDocument(doc)
+	if err != nil {
+		t.Fatal(err)
+		return
+	}
+
+	files := g.GenerateFiles()
+	old14, cur := string(files["summoner_v1_4.gen.go"]), string(files["summoner.gen.go"])
+	if !strings.Contains(old14, "func (c *Client) SummonersV1_4(") ||
+		!strings.Contains(old14, "type SummonerV1_4 struct") ||
+		!strings.Contains(old14, `Version: "v1.4"`) {
+		t.Fatalf("Invalid old version:\n%s", old14)
+		return
+	}
+	if !strings.Contains(cur, "func (c *Client) Summoners(") || !strings.Contains(cur, `Version: "v1.5"`) {
+		t.Fatalf("Invalid current version:\n%s", cur)
+	}
+}
+
+func TestGenerateOptionalFields(t *testing.T) {
+	doc := newTestDocument()
+	doc.Classes[0].Fields = append(doc.Classes[0].Fields,
+		&loldesc.Field{RawName: "level", Name: "Level", Type: "int32", Desc: "Summoner level. Only present if relevant."})
+
+	reg, err := lolregi.NewFromDocument(lolregi.Config{OptionalFields: true}, doc)
+	if err != nil {
+		t.Fatal(err)
+		return
+	}
+
+	src, err := format.Source(New(reg).GenerateFiles()["summoner.gen.go"])
+	if err != nil {
+		t.Fatal(err)
+		return
+	}
+	summoner := string(src)
+	if !strings.Contains(summoner, "Level *int32 `json:\"level,omitempty\"`") ||
+		!strings.Contains(summoner, "func (s *Summoner) GetLevel() (value int32, ok bool) {") {
+		t.Fatalf("Invalid optional field:\n%s", summoner)
+		return
+	}
+	if strings.Contains(summoner, "ProfileIconID *int32") || strings.Contains(summoner, "GetProfileIconID") {
+		t.Fatalf("Expected ProfileIconID to be required:\n%s", summoner)
+	}
+
+	// Without OptionalFields, absent fields are zero, so there is no accessor to claim otherwise.
+	reg.Config.OptionalFields = false
+	src, err = format.Source(New(reg).GenerateFiles()["summoner.gen.go"])
+	if err != nil {
+		t.Fatal(err)
+		return
+	}
+	summoner = string(src)
+	if !strings.Contains(summoner, "Level int32 `json:\"level,omitempty\"`") ||
+		strings.Contains(summoner, "GetLevel") {
+		t.Fatalf("Invalid value field:\n%s", summoner)
+	}
+}
@@ -0,0 +1,317 @@
+package lolgen
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"go/types"
+	"strconv"
+	"strings"
+
+	"github.com/go-lol/lol/go-lol-generator/lolregi"
+)
+
+// GenerateOpenAPI returns an OpenAPI 3 json document describing the registry.
+//
+// Regions are declared as servers, and response classes are declared
+// as component schemas, with types used by generated go code.
+// Tags are "<resource>-<version>" as lolregi.Registry.LoadOpenAPI expects,
+// so the document can be loaded again.
+func (g *Generator) GenerateOpenAPI() ([]byte, error) {
+	doc := &oaDocument{
+		OpenAPI: "3.0.0",
+		Info:    oaInfo{Title: "League of Legends API", Version: "1.0"},
+		Paths:   make(map[string]map[string]*oaOperation),
+		Security: []map[string][]string{
+			{"api_key": {}},
+		},
+		Components: oaComponents{
+			Schemas: make(map[string]*oaSchema),
+			SecuritySchemes: map[string]*oaSecurityScheme{
+				"api_key": {Type: "apiKey", In: "query", Name: "api_key"},
+			},
+		},
+	}
+
+	for _, r := range g.reg.Regions {
+		if r.Name == "Global" {
+			continue
+		}
+		doc.Servers = append(doc.Servers, oaServer{URL: "https://" + r.Host(), Description: r.Name})
+	}
+
+	for _, res := range g.reg.Resources {
+		for _, e := range res.Endpoints {
+			for _, op := range e.Operations {
+				path := op.Path.String()
+				if doc.Paths[path] == nil {
+					doc.Paths[path] = make(map[string]*oaOperation)
+				}
+				doc.Paths[path][strings.ToLower(op.Method)] = g.openAPIOperation(res, op)
+			}
+		}
+	}
+
+	for _, c := range g.reg.Classes {
+		s := &oaSchema{Type: "object", Description: c.Desc}
+		for _, f := range c.Fields() {
+			fs := g.openAPISchema(f.Type(), f.Desc)
+			s.Properties = append(s.Properties, oaProperty{Name: f.RawName(), Schema: fs})
+		}
+		doc.Components.Schemas[c.Name()] = s
+	}
+
+	doc.Components.Schemas["SpellRange"] = &oaSchema{
+		Description: "Either a list of ranges, or 'self' for spells that target one's own champion.",
+		OneOf: []*oaSchema{
+			{Type: "string", Enum: []string{"self"}},
+			{Type: "array", Items: &oaSchema{Type: "integer", Format: "int32"}},
+			{Type: "object", Properties: []oaProperty{
+				{Name: "Ranges", Schema: &oaSchema{Type: "array", Items: &oaSchema{Type: "integer", Format: "int32"}}},
+			}},
+		},
+	}
+
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(doc); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func (g *Generator) openAPIOperation(res *lolregi.Resource, op *lolregi.Operation) *oaOperation {
+	o := &oaOperation{
+		OperationID:    op.Name,
+		Summary:        op.Desc,
+		Description:    strings.TrimSpace(op.ImplementationNotes),
+		Tags:           []string{res.ID + "-" + res.Version},
+		RateLimitNotes: op.RateLimitNotes,
+		ExternalDocs:   &oaExternalDocs{URL: op.DocURL()},
+		Responses:      make(map[string]*oaResponse),
+	}
+
+	for _, r := range res.Regions {
+		o.Regions = append(o.Regions, r.Name)
+	}
+	if base := res.APIBase(); base != "" {
+		o.Servers = []oaServer{{URL: base}}
+	} else {
+		for _, r := range res.Regions {
+			o.Servers = append(o.Servers, oaServer{URL: "https://" + r.Host(), Description: r.Name})
+		}
+	}
+
+	if !op.APIKeyRequired() {
+		o.Security = &[]map[string][]string{}
+	}
+
+	for _, p := range op.Path.Params {
+		o.Parameters = append(o.Parameters, g.openAPIParameter("path", p))
+	}
+	for _, p := range op.QueryParams {
+		o.Parameters = append(o.Parameters, g.openAPIParameter("query", p))
+	}
+
+	o.Responses["200"] = &oaResponse{
+		Description: "Success",
+		Content: map[string]oaMediaType{
+			"application/json": {Schema: g.openAPISchema(op.ReturnValue, "")},
+		},
+	}
+	for _, e := range op.Errors {
+		o.Responses[strconv.Itoa(e.Code)] = &oaResponse{Description: e.Desc}
+	}
+	return o
+}
+
+func (g *Generator) openAPIParameter(in string, p lolregi.Parameter) *oaParameter {
+	op := &oaParameter{
+		Name:        p.Raw,
+		In:          in,
+		Description: strings.TrimSpace(p.Desc),
+		Required:    p.IsRequired() || in == "path",
+		Schema:      g.openAPISchema(p.Type(), p.Desc),
+	}
+
+	// Lists are comma-separated.
+	if op.Schema.Type == "array" {
+		explode := false
+		op.Explode = &explode
+		if in == "path" {
+			op.Style = "simple"
+		} else {
+			op.Style = "form"
+		}
+	}
+	return op
+}
+
+// openAPISchema converts a type to a schema.
+// Legal values in desc are used as enum of strings.
+func (g *Generator) openAPISchema(t types.Type, desc string) *oaSchema {
+	s := &oaSchema{Description: strings.TrimSpace(desc)}
+
+	switch t := t.(type) {
+	case *types.Pointer:
+		return g.openAPISchema(t.Elem(), desc)
+
+	case *lolregi.ResponseClass:
+		return &oaSchema{Ref: "#/components/schemas/" + t.Name(), Description: s.Description}
+
+	case *types.Named:
+		if _, ok := t.Underlying().(*types.Basic); ok { // time types
+			return g.openAPISchema(t.Underlying(), desc)
+		}
+		return &oaSchema{Ref: "#/components/schemas/" + t.Obj().Name(), Description: s.Description}
+
+	case *types.Basic:
+		switch t.Kind() {
+		case types.Bool:
+			s.Type = "boolean"
+		case types.Int32:
+			s.Type, s.Format = "integer", "int32"
+		case types.Int64:
+			s.Type, s.Format = "integer", "int64"
+		case types.Float32:
+			s.Type, s.Format = "number", "float"
+		case types.Float64:
+			s.Type, s.Format = "number", "double"
+		case types.String:
+			s.Type = "string"
+			s.Enum = lolregi.ParseLegalValues(desc)
+		default:
+			panic(fmt.Sprintf("openapi: unsupported basic type %s", t))
+		}
+
+	case *types.Slice:
+		s.Type = "array"
+		s.Items = g.openAPISchema(t.Elem(), "")
+
+	case *types.Map:
+		s.Type = "object"
+		if _, ok := t.Elem().(*types.Interface); !ok {
+			s.AdditionalProperties = g.openAPISchema(t.Elem(), "")
+		}
+
+	case *types.Interface:
+		s.Type = "object"
+
+	default:
+		panic(fmt.Sprintf("openapi: unsupported type %T", t))
+	}
+
+	return s
+}
+
+// ----- OpenAPI 3 document
+
+type oaDocument struct {
+	OpenAPI    string                             `json:"openapi"`
+	Info       oaInfo                             `json:"info"`
+	Servers    []oaServer                         `json:"servers"`
+	Security   []map[string][]string              `json:"security,omitempty"`
+	Paths      map[string]map[string]*oaOperation `json:"paths"`
+	Components oaComponents                       `json:"components"`
+}
+
+type oaInfo struct {
+	Title   string `json:"title"`
+	Version string `json:"version"`
+}
+
+type oaServer struct {
+	URL         string `json:"url"`
+	Description string `json:"description,omitempty"`
+}
+
+type oaExternalDocs struct {
+	URL string `json:"url"`
+}
+
+type oaComponents struct {
+	Schemas         map[string]*oaSchema         `json:"schemas"`
+	SecuritySchemes map[string]*oaSecurityScheme `json:"securitySchemes"`
+}
+
+type oaSecurityScheme struct {
+	Type string `json:"type"`
+	In   string `json:"in"`
+	Name string `json:"name"`
+}
+
+type oaOperation struct {
+	OperationID  string                 `json:"operationId"`
+	Summary      string                 `json:"summary,omitempty"`
+	Description  string                 `json:"description,omitempty"`
+	Tags         []string               `json:"tags"`
+	ExternalDocs *oaExternalDocs        `json:"externalDocs,omitempty"`
+	Servers      []oaServer             `json:"servers,omitempty"`
+	Security     *[]map[string][]string `json:"security,omitempty"`
+	Parameters   []*oaParameter         `json:"parameters,omitempty"`
+	Responses    map[string]*oaResponse `json:"responses"`
+
+	Regions        []string `json:"x-regions,omitempty"`
+	RateLimitNotes string   `json:"x-rate-limit-notes,omitempty"`
+}
+
+type oaParameter struct {
+	Name        string    `json:"name"`
+	In          string    `json:"in"`
+	Description string    `json:"description,omitempty"`
+	Required    bool      `json:"required,omitempty"`
+	Style       string    `json:"style,omitempty"`
+	Explode     *bool     `json:"explode,omitempty"`
+	Schema      *oaSchema `json:"schema"`
+}
+
+type oaResponse struct {
+	Description string                 `json:"description"`
+	Content     map[string]oaMediaType `json:"content,omitempty"`
+}
+
+type oaMediaType struct {
+	Schema *oaSchema `json:"schema"`
+}
+
+type oaSchema struct {
+	Ref                  string       `json:"$ref,omitempty"`
+	Type                 string       `json:"type,omitempty"`
+	Format               string       `json:"format,omitempty"`
+	Description          string       `json:"description,omitempty"`
+	Enum                 []string     `json:"enum,omitempty"`
+	Items                *oaSchema    `json:"items,omitempty"`
+	Properties           oaProperties `json:"properties,omitempty"`
+	AdditionalProperties *oaSchema    `json:"additionalProperties,omitempty"`
+	OneOf                []*oaSchema  `json:"oneOf,omitempty"`
+}
+
+type oaProperty struct {
+	Name   string
+	Schema *oaSchema
+}
+
+// oaProperties is encoded as an object, keeping field order.
+type oaProperties []oaProperty
+
+func (ps oaProperties) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteByte('{')
+	for i, p := range ps {
+		if i != 0 {
+			buf.WriteByte(',')
+		}
+		buf.WriteString(strconv.Quote(p.Name))
+		buf.WriteByte(':')
+
+		enc := json.NewEncoder(&buf)
+		enc.SetEscapeHTML(false)
+		if err := enc.Encode(p.Schema); err != nil {
+			return nil, err
+		}
+	}
+	buf.WriteByte('}')
+	return buf.Bytes(), nil
+}
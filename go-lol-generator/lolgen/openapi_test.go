@@ -0,0 +1,98 @@
+package lolgen
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/go-lol/lol/go-lol-generator/loldesc"
+	"github.com/go-lol/lol/go-lol-generator/lolregi"
+)
+
+func newTestDocument() *loldesc.Document {
+	return &loldesc.Document{
+		Regions: []*loldesc.Region{
+			{Name: "Global", Host: "global.api.pvp.net", Number: 1},
+			{Name: "NA", PlatformID: "NA1", Host: "na.api.pvp.net", Number: 10},
+			{Name: "KR", PlatformID: "KR", Host: "kr.api.pvp.net", Number: 13},
+		},
+		Resources: []*loldesc.Resource{{
+			ID: "summoner", Version: "v1.4", Num: 1017, Regions: []string{"NA", "KR"},
+			Endpoints: []*loldesc.Endpoint{{Operations: []*loldesc.Operation{{
+				Name: "Summoners", Method: "GET", Num: 3452,
+				Path: "/api/lol/{region}/v1.4/summoner/{summonerIds}",
+				PathParams: []*loldesc.Parameter{
+					{Raw: "region", Name: "region", Type: "string", Required: true},
+					{Raw: "summonerIds", Name: "summonerIDs", Type: "[]int64", Required: true},
+				},
+				QueryParams: []*loldesc.Parameter{
+					{Raw: "season", Name: "season", Type: "string", Desc: "(Legal values: SEASON2014, SEASON2015)"},
+				},
+				ReturnValue: "map[string]*Summoner",
+				MapKey:      "int64",
+				Errors:      []*loldesc.ResponseError{{Code: 404, Desc: "No summoner data found for any specified inputs"}},
+			}}}},
+		}},
+		Classes: []*loldesc.Class{
+			{
+				Resource: "summoner", RawName: "SummonerDto", Name: "Summoner",
+				Fields: []*loldesc.Field{
+					{RawName: "id", Name: "ID", Type: "int64"},
+					{RawName: "profileIconId", Name: "ProfileIconID", Type: "int32"},
+					{RawName: "spells", Name: "Spells", Type: "[]*SummonerSpell"},
+				},
+			},
+			{
+				Resource: "summoner", RawName: "SummonerSpellDto", Name: "SummonerSpell",
+				Fields: []*loldesc.Field{
+					{RawName: "range", Name: "Range", Type: "*SpellRange"},
+					{RawName: "effect", Name: "Effect", Type: "[][]float64"},
+				},
+			},
+		},
+	}
+}
+
+func TestGenerateOpenAPI(t *testing.T) {
+	reg, err := lolregi.NewFromDocument(lolregi.Config{}, newTestDocument())
+	if err != nil {
+		t.Fatal(err)
+		return
+	}
+
+	src, err := New(reg).GenerateOpenAPI()
+	if err != nil {
+		t.Fatal(err)
+		return
+	}
+	t.Logf("Generated:\n%s", src)
+
+	var doc map[string]interface{}
+	if err := json.Unmarshal(src, &doc); err != nil {
+		t.Fatal(err)
+		return
+	}
+	if doc["openapi"] != "3.0.0" {
+		t.Fatalf("Invalid version %v", doc["openapi"])
+		return
+	}
+
+	// The object variant of SpellRange has the key read by SpellRange.UnmarshalJSON.
+	spellRange := doc["components"].(map[string]interface{})["schemas"].(map[string]interface{})["SpellRange"].(map[string]interface{})
+	object := spellRange["oneOf"].([]interface{})[2].(map[string]interface{})
+	if _, ok := object["properties"].(map[string]interface{})["Ranges"]; !ok {
+		t.Fatalf("Expected Ranges property of SpellRange, got %v", object)
+		return
+	}
+
+	// Load generated document again.
+	loaded := lolregi.New(lolregi.Config{})
+	if err := loaded.LoadOpenAPI(bytes.NewReader(src)); err != nil {
+		t.Fatal(err)
+		return
+	}
+
+	if changes := lolregi.Diff(reg, loaded); len(changes) != 0 {
+		t.Fatalf("Expected no changes, got:\n%s", changes)
+	}
+}
@@ -0,0 +1,231 @@
+package lolgen
+
+import (
+	"encoding/json"
+	"fmt"
+	"go/types"
+	"strconv"
+	"strings"
+
+	"github.com/go-lol/lol/go-lol-generator/loldesc"
+	"github.com/go-lol/lol/go-lol-generator/lolregi"
+	"github.com/go-lol/lol/uritemplates"
+)
+
+// GenerateTestFiles generates tests and godoc examples for each resource.
+//
+// Tests (package lol) run each operation against a local server with
+// a fixture generated from the return type, and require operationTest and
+// runOperationTests declared in a handwritten test file.
+// Examples are declared in package lol_test.
+func (g *Generator) GenerateTestFiles() map[string][]byte {
+	files := make(map[string][]byte)
+	for _, res := range g.reg.Resources {
+		res := res
+		if len(res.Endpoints) == 0 {
+			continue
+		}
+
+		base := strings.TrimSuffix(ResourceFile(res), ".gen.go")
+		files[base+".gen_test.go"] = g.file(g.reg.Pkg.Name(), func(g *Generator) {
+			g.generateResourceTest(res)
+		})
+		files[base+"_example.gen_test.go"] = g.file(g.reg.Pkg.Name()+"_test", func(g *Generator) {
+			for _, e := range res.Endpoints {
+				for _, op := range e.Operations {
+					g.generateExample(res, op)
+				}
+			}
+		})
+	}
+	return files
+}
+
+func (g *Generator) generateResourceTest(res *lolregi.Resource) {
+	region, unsupported := g.testRegions(res)
+
+	name := exportedName(res.ID)
+	if !res.IsCurrent() {
+		name += loldesc.VersionSuffix(res.Version)
+	}
+
+	g.P(`func Test`, name, `Operations(t *testing.T) {`)
+	g.P(`runOperationTests(t, []operationTest{`)
+	for _, e := range res.Endpoints {
+		for _, op := range e.Operations {
+			g.P(`{`)
+			g.P(`name: `, strconv.Quote(op.Name), `,`)
+			if op.HasRegionParameter() {
+				g.P(`region: `, region.Name, `,`)
+				if unsupported != nil {
+					g.P(`unsupported: `, unsupported.Name, `,`)
+				}
+			}
+			g.P(`path: `, strconv.Quote(g.expectedPath(op, region)), `,`)
+			g.P(`apiKey: `, op.APIKeyRequired(), `,`)
+			g.P(`fixture: `, strconv.Quote(fixture(op.ReturnValue)), `,`)
+			g.P(`do: func(c *Client, region Region) (interface{}, error) {`)
+			g.P(`return c.`, op.Name, `(context.TODO()`, g.sampleArgs(op, "region"), `).Do()`)
+			g.P(`},`)
+			g.P(`},`)
+		}
+	}
+	g.P(`})`)
+	g.P(`}`)
+	g.P()
+}
+
+func (g *Generator) generateExample(res *lolregi.Resource, op *lolregi.Operation) {
+	region, _ := g.testRegions(res)
+	pkg := g.reg.Pkg.Name()
+
+	g.P(`func ExampleClient_`, op.Name, `() {`)
+	g.P(`client, err := `, pkg, `.New(nil, os.Getenv("RIOT_API_KEY"))`)
+	g.P(`if err != nil { log.Fatal(err) }`)
+	g.P()
+	g.P(`ret, err := client.`, op.Name, `(context.TODO()`, g.sampleArgs(op, pkg+"."+region.Name), `).Do()`)
+	g.P(`if err != nil { log.Fatal(err) }`)
+	g.P(`fmt.Println(ret)`)
+	g.P(`}`)
+	g.P()
+}
+
+// testRegions returns a region supported by the resource,
+// and a region which is not supported. (nil if all regions are supported)
+func (g *Generator) testRegions(res *lolregi.Resource) (region, unsupported *lolregi.Region) {
+	region = res.Regions[0]
+	if na := res.Regions.Find("NA"); na != nil {
+		region = na
+	}
+
+	for _, r := range g.reg.Regions {
+		if res.Regions.Find(r.Name) == nil {
+			return region, r
+		}
+	}
+	return region, nil
+}
+
+// sampleArgs returns arguments after context for the creator function of op.
+func (g *Generator) sampleArgs(op *lolregi.Operation, region string) string {
+	var args string
+	if op.HasRegionParameter() {
+		args += `, ` + region
+	}
+	for _, p := range op.Path.Params {
+		if !p.IsRegion() && p.IsRequired() {
+			literal, _ := sampleValue(p.Type())
+			args += `, ` + literal
+		}
+	}
+	return args
+}
+
+func (g *Generator) expectedPath(op *lolregi.Operation, region *lolregi.Region) string {
+	values := make(map[string]string)
+	for _, p := range op.Path.Params {
+		if !p.IsRegion() && p.IsRequired() {
+			_, values[p.Raw] = sampleValue(p.Type())
+		}
+	}
+	// Same as generated doRequest.
+	if op.Path.Has("region") {
+		values["region"] = strings.ToLower(region.Name)
+	}
+	if op.Path.Has("platformID") {
+		values["platformId"] = strings.ToLower(region.PlatformID)
+	}
+
+	path, err := uritemplates.Expand(op.Path.String(), values)
+	if err != nil {
+		panic(fmt.Sprintf("%s: %v", op.Name, err))
+	}
+	return path
+}
+
+// sampleValue returns a go literal, and its string form used in path.
+func sampleValue(t types.Type) (literal, str string) {
+	switch t := t.(type) {
+	case *types.Basic:
+		switch t.Info() & (types.IsInteger | types.IsString) {
+		case types.IsInteger:
+			return "1", "1"
+		case types.IsString:
+			return `"example"`, "example"
+		}
+	case *types.Slice:
+		elem, s := sampleValue(t.Elem())
+		return "[]" + t.Elem().String() + "{" + elem + "}", s
+	}
+	panic(fmt.Sprintf("sampleValue: unsupported type %s", t))
+}
+
+// fixture returns a json response for a type, with a value for each field.
+func fixture(t types.Type) string {
+	data, err := json.Marshal(fixtureValue(t, make(map[*lolregi.ResponseClass]bool)))
+	if err != nil {
+		panic(err)
+	}
+	return string(data)
+}
+
+func fixtureValue(t types.Type, seen map[*lolregi.ResponseClass]bool) interface{} {
+	switch t := t.(type) {
+	case *types.Pointer:
+		return fixtureValue(t.Elem(), seen)
+
+	case *lolregi.ResponseClass:
+		if seen[t] { // recursive
+			return nil
+		}
+		seen[t] = true
+		defer delete(seen, t)
+
+		obj := make(map[string]interface{})
+		for _, f := range t.Fields() {
+			obj[f.RawName()] = fixtureValue(f.Type(), seen)
+		}
+		return obj
+
+	case *types.Named:
+		switch t.Obj().Name() {
+		case "SpellRange":
+			return "self"
+		}
+		if _, ok := t.Underlying().(*types.Basic); ok { // time types
+			return fixtureValue(t.Underlying(), seen)
+		}
+
+	case *types.Basic:
+		switch {
+		case t.Info()&types.IsBoolean != 0:
+			return true
+		case t.Info()&types.IsNumeric != 0:
+			return 1
+		case t.Info()&types.IsString != 0:
+			return "example"
+		}
+
+	case *types.Slice:
+		return []interface{}{fixtureValue(t.Elem(), seen)}
+
+	case *types.Map:
+		// "1" is a valid key for both of string and integer keys.
+		return map[string]interface{}{"1": fixtureValue(t.Elem(), seen)}
+
+	case *types.Interface:
+		return map[string]interface{}{}
+	}
+	panic(fmt.Sprintf("fixture: unsupported type %s", t))
+}
+
+// exportedName converts a resource id like "lol-static-data" to "LolStaticData".
+func exportedName(id string) string {
+	var name string
+	for _, s := range strings.Split(id, "-") {
+		if s != "" {
+			name += strings.ToUpper(s[:1]) + s[1:]
+		}
+	}
+	return name
+}
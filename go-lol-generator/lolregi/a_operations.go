@@ -13,7 +13,7 @@ type OpInfo struct {
 // map[resource name]map[path suffix]Operation
 //
 // Note: Operation ids may change without api change.
-var knownOperations = map[string]map[string]OpInfo{
+var defaultOperations = map[string]map[string]OperationOverride{
 	"lol-static-data": { // v1.2
 		"/champion":            {Name: "ChampionDatas"},
 		"/champion/{id}":       {Name: "ChampionData"},
@@ -80,14 +80,14 @@ var knownOperations = map[string]map[string]OpInfo{
 
 	"summoner": { // v1.4
 		"/summoner/by-name/{summonerNames}": {Name: "SummonersByName"},
-		"/summoner/{summonerIds}":           {Name: "Summoners", MapKey: types.Int64},
-		"/summoner/{summonerIds}/masteries": {Name: "SummonerMasteries", MapKey: types.Int64},
-		"/summoner/{summonerIds}/name":      {Name: "SummonerNames", MapKey: types.Int64},
-		"/summoner/{summonerIds}/runes":     {Name: "SummonerRunes", MapKey: types.Int64},
+		"/summoner/{summonerIds}":           {Name: "Summoners", MapKey: "int64"},
+		"/summoner/{summonerIds}/masteries": {Name: "SummonerMasteries", MapKey: "int64"},
+		"/summoner/{summonerIds}/name":      {Name: "SummonerNames", MapKey: "int64"},
+		"/summoner/{summonerIds}/runes":     {Name: "SummonerRunes", MapKey: "int64"},
 	},
 
 	"team": { // v2.4
-		"/team/by-summoner/{summonerIds}": {Name: "TeamsBySummonerID", MapKey: types.Int64},
+		"/team/by-summoner/{summonerIds}": {Name: "TeamsBySummonerID", MapKey: "int64"},
 		"/team/{teamIds}":                 {Name: "Teams"},
 	},
 
@@ -0,0 +1,96 @@
+package lolregi
+
+// DefaultOverrides returns overrides used when Config.Overrides is nil.
+//
+// Use Merge to add entries.
+func DefaultOverrides() *Overrides {
+	return (&Overrides{
+		// Avoid conflicts between resources.
+		Classes: map[string]map[string]string{
+			"lol-static-data": {
+				"ChampionDto":     "ChampionData",
+				"ChampionListDto": "ChampionDataList",
+			},
+			"team": {
+				"TeamDto":           "RankTeam",
+				"TeamMemberInfoDto": "RankTeamMemberInfo",
+				"TeamStatDetailDto": "RankTeamStatDetail",
+			},
+			"match": {
+				"Player":              "MatchPlayer",
+				"Participant":         "MatchParticipant",
+				"ParticipantIdentity": "MatchParticipantIdentity",
+				"Rune":                "UsedRune",
+				"Mastery":             "UsedMastery",
+			},
+			"current-game": {
+				"Rune":           "CurrentRune",
+				"Mastery":        "CurrentMastery",
+				"Observer":       "CurrentGameObserver",
+				"BannedChampion": "CurrentGameBannedChampion",
+			},
+			"featured-games": {
+				"Participant":    "FeaturedGameParticipant",
+				"Observer":       "FeaturedGameObserver",
+				"BannedChampion": "FeaturedGameBannedChampion",
+			},
+			"summoner": {
+				"MasteryDto": "SummonerMastery",
+			},
+		},
+
+		FieldTypes: map[string]map[string]string{
+			"SummonerSpellDto": {
+				"effect": "[][]float64",
+				"range":  "*SpellRange",
+			},
+			"ChampionSpellDto": {
+				"effect": "[][]float64",
+				"range":  "*SpellRange",
+			},
+		},
+
+		// int32: map id, summoner spell id, champion id, rune id, mastery id, item id
+		IDTypes: map[string]map[string]string{
+			AnyClass: {
+				"mapId":         "int32",
+				"championId":    "int32",
+				"profileIconId": "int32",
+				"runeId":        "int32",
+				"masteryId":     "int32",
+				"spell1Id":      "int32",
+				"spell2Id":      "int32",
+			},
+			"MatchReference": {
+				"champion": "int32",
+			},
+			"ChampionDto": {
+				"id": "int32",
+			},
+		},
+
+		// Undocumented units.
+		TimeTypes: map[string]map[string]string{
+			"MatchDetail": {
+				"matchCreation": "EpochMillis",
+			},
+			"MatchReference": {
+				"timestamp": "EpochMillis",
+			},
+			"RawStatsDto": {
+				"timePlayed": "Seconds",
+			},
+		},
+
+		Operations: defaultOperations,
+
+		SharedClasses: []string{"Image"},
+
+		// Absent if false or 0, which is not documented.
+		OptionalFields: map[string][]string{
+			"ChampionDto": {"freeToPlay"},
+			"GameDto":     {"invalid"},
+			"RawStatsDto": {"firstBlood"},
+		},
+	}).Merge(nil) // copy
+}
@@ -1,6 +1,7 @@
 package lolregi
 
 import (
+	"fmt"
 	"go/types"
 	"strings"
 	"unicode"
@@ -13,21 +14,37 @@ import (
 const Skip = "-"
 
 type Config struct {
-	// Default: "github.com/jerrodrurik/go-lol", "lol"
+	// Default: DefaultPackagePath, "lol"
 	Package *types.Package
 
-	// Dont fix inconsistent id type.
-	//
-	// int32: map id, summoner spell id, champion id, rune id, mastery id, item id
+	// Dont fix inconsistent id type. See Overrides.IDTypes
 	DontFixIDType bool
 
+	// Dont use EpochMillis, Millis and Seconds for time fields,
+	// keeping integers. See Overrides.TimeTypes and TimeType
+	DontUseTimeTypes bool
+
+	// Renames and type fixes.
+	// Default: DefaultOverrides()
+	Overrides *Overrides
+
+	// Keep operations which are not declared in Overrides.Operations,
+	// instead of panicking. Such operations have an empty name.
+	//
+	// Useful when comparing snapshots of the api reference.
+	AllowUnknownOperations bool
+
+	// Generate optional fields of basic types as pointers, with accessors
+	// returning (value, ok). See Registry.IsOptional
+	OptionalFields bool
+
 	// return "-" to exclude class.
 	// TODO: Add helper methods to replace types from other classes.
-	// return empty string to use default.
+	// return empty string to use Overrides.Classes.
 	GetClassName func(resID, rawName string) string
 
 	// return "-" to exclude field.
-	// return empty string to use default.
+	// return empty string to use Overrides.Fields.
 	GetFieldName func(resID, rawClassName, rawName string) string
 }
 
@@ -37,9 +54,21 @@ func (reg *Registry) parseField(resID, rawClassName string,
 
 	s = s.ChildrenFiltered("td")
 	rawName = strings.TrimSpace(s.Eq(0).Text())
+	name = reg.fieldName(resID, rawClassName, rawName)
+
+	rawType = s.Eq(1).Text()
+	desc = s.Eq(2).Text()
+	return
+}
+
+// fieldName returns Skip if the field should be excluded.
+func (reg *Registry) fieldName(resID, rawClassName, rawName string) (name string) {
 	if reg.Config.GetFieldName != nil {
 		name = reg.Config.GetFieldName(resID, rawClassName, rawName)
 	}
+	if name == "" {
+		name = reg.Config.Overrides.fieldName(rawClassName, rawName)
+	}
 
 	if name == "" {
 		name = inflect.Camelize(rawName)
@@ -50,10 +79,23 @@ func (reg *Registry) parseField(resID, rawClassName string,
 		name = inflect.Camelize(name)
 		name = lintName(string(name))
 	}
+	return name
+}
 
-	rawType = s.Eq(1).Text()
-	desc = s.Eq(2).Text()
-	return
+// IsOptional returns true if the field should be generated as a pointer,
+// which is nil if absent. Only fields of basic types are optional,
+// as others are already nil if absent.
+//
+// A field is optional if Config.OptionalFields is set, and the field is
+// documented as optional or declared in Overrides.OptionalFields.
+func (reg *Registry) IsOptional(c *ResponseClass, f *Field) bool {
+	if !reg.Config.OptionalFields {
+		return false
+	}
+	if _, ok := f.Type().(*types.Basic); !ok {
+		return false
+	}
+	return f.DocumentedOptional() || reg.Config.Overrides.isOptional(c.RawName(), f.RawName())
 }
 
 func (reg *Registry) className(resID, rawCls string) string {
@@ -67,67 +109,21 @@ func (reg *Registry) className(resID, rawCls string) string {
 		}
 	}
 
-	name = strings.TrimSuffix(rawCls, "Dto")
-	name = lintName(name)
-
-	switch resID {
-	case "lol-static-data":
-		switch name {
-		case "Champion":
-			return "ChampionData"
-		case "ChampionList":
-			return "ChampionDataList"
-		}
-	case "team":
-		switch name {
-		case "Team", "TeamMemberInfo", "TeamStatDetail":
-			return "Rank" + name
-		case "MatchHistorySummary", "Roster":
-			return name
-		}
-
-	case "match":
-		switch name {
-		case "Player", "Participant", "ParticipantIdentity":
-			return "Match" + name
-		case "Rune", "Mastery":
-			return "Used" + name
-		}
-
-	case "current-game":
-		switch name {
-		case "Rune", "Mastery":
-			return "Current" + name
-		case "Observer", "BannedChampion":
-			return "CurrentGame" + name
-		}
-
-	case "featured-games":
-		switch name {
-		case "Participant", "Observer", "BannedChampion":
-			return "FeaturedGame" + name
-		}
-
-	case "summoner":
-		switch name {
-		case "Mastery":
-			return "Summoner" + name
-		}
+	if name = reg.Config.Overrides.className(resID, rawCls); name != "" {
+		return lintName(name)
 	}
 
-	return name
+	name = strings.TrimSuffix(rawCls, "Dto")
+	return lintName(name)
 }
 
 func (reg *Registry) fieldType(resID, rawCls, rawField, rawType, fieldDesc string) types.Type {
-	switch rawCls {
-	case "SummonerSpellDto", "ChampionSpellDto":
-		switch rawField {
-		case "effect":
-			return types.NewSlice(types.NewSlice(types.Typ[types.Float64]))
-
-		case "range":
-			return types.NewPointer(reg.Pkg.Scope().Lookup("SpellRange").Type())
+	if expr := reg.Config.Overrides.fieldType(rawCls, rawField); expr != "" {
+		t, err := reg.lookupType(expr)
+		if err != nil {
+			panic(fmt.Sprintf("%s.%s: %v", rawCls, rawField, err))
 		}
+		return t
 	}
 
 	t, err := reg.parseType(resID, rawType)
@@ -137,27 +133,48 @@ func (reg *Registry) fieldType(resID, rawCls, rawField, rawType, fieldDesc strin
 
 	// Fix wrong id types.
 	if reg.Config.DontFixIDType == false && t == types.Typ[types.Int64] {
-		switch rawField {
-		case "mapId", "championId", "profileIconId", "runeId", "masteryId",
-			"spell1Id", "spell2Id":
-			return types.Typ[types.Int32]
-		}
-
-		switch rawCls {
-		case "MatchReference":
-			switch rawField {
-			case "champion":
-				return types.Typ[types.Int32]
+		if expr := reg.Config.Overrides.idType(rawCls, rawField); expr != "" {
+			if t, err = reg.lookupType(expr); err != nil {
+				panic(fmt.Sprintf("%s.%s: %v", rawCls, rawField, err))
 			}
 		}
+	}
 
-		if resID == "champion" && rawCls == "ChampionDto" {
-			switch rawField {
-			case "id":
-				return types.Typ[types.Int32]
+	if reg.Config.DontUseTimeTypes == false && isInteger(t) {
+		name := reg.Config.Overrides.timeType(rawCls, rawField)
+		if name == "" {
+			name = TimeType(fieldDesc)
+		}
+		if name != "" {
+			if t, err = reg.lookupType(name); err != nil {
+				panic(fmt.Sprintf("%s.%s: %v", rawCls, rawField, err))
 			}
 		}
 	}
 
 	return t
 }
+
+// TimeType returns the name of a handwritten time type for a field description,
+// or an empty string.
+//
+//	"... specified as epoch milliseconds." -> "EpochMillis"
+//	"... how many milliseconds into the game ..." -> "Millis"
+//	"The amount of time in seconds ...", "Match duration" -> "Seconds"
+func TimeType(desc string) string {
+	desc = strings.ToLower(desc)
+	switch {
+	case strings.Contains(desc, "epoch milliseconds"):
+		return "EpochMillis"
+	case strings.Contains(desc, "milliseconds"):
+		return "Millis"
+	case strings.Contains(desc, "seconds"), strings.Contains(desc, "duration"):
+		return "Seconds"
+	}
+	return ""
+}
+
+func isInteger(t types.Type) bool {
+	b, ok := t.(*types.Basic)
+	return ok && b.Info()&types.IsInteger != 0
+}
@@ -56,6 +56,8 @@ type (
 		Path        Path
 		QueryParams []Parameter
 		ReturnValue types.Type
+		// Override map key in return value. See OpInfo.MapKey
+		MapKey types.BasicKind
 
 		ImplementationNotes string
 		RateLimitNotes      string
@@ -125,6 +127,16 @@ func (regions Regions) Join(sep string) string {
 	return buf.String()
 }
 
+// Find returns a region with the name, or nil.
+func (regions Regions) Find(name string) *Region {
+	for _, r := range regions {
+		if r.Name == name {
+			return r
+		}
+	}
+	return nil
+}
+
 func regionByName(name string) *Region {
 	for _, r := range allRegions {
 		if r.Name == name {
@@ -160,6 +172,20 @@ func (res *Resource) APIBase() string {
 	}
 }
 
+// IsCurrent returns false if the resource is an old version,
+// declared after the current version of the resource.
+func (res *Resource) IsCurrent() bool {
+	if res.reg == nil {
+		return true
+	}
+	for _, r := range res.reg.Resources {
+		if r.ID == res.ID {
+			return r == res
+		}
+	}
+	return true
+}
+
 func (res *Resource) APIKeyRequired() bool {
 	switch res.ID {
 	case "lol-status":
@@ -179,6 +205,14 @@ func (op *Operation) APIKeyRequired() bool {
 	return op.Endpoint.Resource.APIKeyRequired()
 }
 
+// RateLimitExempt returns true if requests are not counted in the rate limit,
+// according to RateLimitNotes.
+// e.g. "Requests to this API will not be counted in your Rate Limit."
+func (op *Operation) RateLimitExempt() bool {
+	notes := strings.ToLower(op.RateLimitNotes)
+	return strings.Contains(notes, "not be counted") || strings.Contains(notes, "not count")
+}
+
 // HasRegionParameter returns true if this operation has a path parameter named 'region' or 'platformId'.
 func (op *Operation) HasRegionParameter() bool {
 	if op.Endpoint.Resource.APIBase() == "" { // region is required to determine hostname.
@@ -194,15 +228,16 @@ func (op *Operation) HasRegionParameter() bool {
 }
 
 func (op *Operation) Info() OpInfo {
-	knownOps := knownOperations[op.Endpoint.Resource.ID]
-
-	for suffix, o := range knownOps {
-		if strings.HasSuffix(op.Path.String(), suffix) {
-			return o
-		}
+	info, ok := op.lookupInfo()
+	if !ok {
+		panic(`Unknown operation: ` + op.DocURL())
 	}
+	return info
+}
 
-	panic(`Unknown operation: ` + op.DocURL())
+func (op *Operation) lookupInfo() (OpInfo, bool) {
+	res := op.Endpoint.Resource
+	return res.reg.Config.Overrides.operation(res.ID, op.Path.String())
 }
 
 // GoType returns a name for operation builder struct.
@@ -305,6 +340,11 @@ func (c *ResponseClass) ResID() string {
 	return c.res.ID
 }
 
+// Resource returns the resource declaring the class.
+func (c *ResponseClass) Resource() *Resource {
+	return c.res
+}
+
 func (c *ResponseClass) Field(name string) *Field {
 	for _, f := range c.Fields() {
 		if name == f.Name() {
@@ -347,6 +387,22 @@ func (f *Field) RawName() string {
 	return f.rawName
 }
 
+// optionalPhrases are phrases of descriptions of fields which may be absent.
+var optionalPhrases = []string{"only present if", "only present when", "not present if", "not present when"}
+
+// DocumentedOptional returns true if the description says the field may be absent.
+// e.g. "The item ID of the event. Only present if relevant."
+// Other fields which may be absent are declared in Overrides.OptionalFields.
+func (f *Field) DocumentedOptional() bool {
+	desc := strings.ToLower(f.Desc)
+	for _, phrase := range optionalPhrases {
+		if strings.Contains(desc, phrase) {
+			return true
+		}
+	}
+	return false
+}
+
 func (f *Field) SetType(typ types.Type) {
 	f.Var = types.NewField(f.Pos(), f.Pkg(), f.Name(), typ, f.Anonymous())
 }
@@ -0,0 +1,344 @@
+package lolregi
+
+import (
+	"bytes"
+	"fmt"
+	"go/types"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// ChangeKind describes how something changed between two registries.
+type ChangeKind string
+
+const (
+	Added    ChangeKind = "added"
+	Removed  ChangeKind = "removed"
+	Modified ChangeKind = "modified"
+)
+
+// Change is a single difference between two registries.
+type Change struct {
+	Kind ChangeKind `json:"kind"`
+	// What has been changed.
+	// One of "resource", "version", "region", "operation", "return value", "error", "parameter", "parameter type", "required",
+	// "class", "field", "field type" and "legal values".
+	Subject  string `json:"subject"`
+	Resource string `json:"resource"`
+	// Dot-separated name of changed object, like "Match.matchId" or "MatchDetail.mapId".
+	Name string `json:"name,omitempty"`
+	Old  string `json:"old,omitempty"`
+	New  string `json:"new,omitempty"`
+}
+
+// String returns a human readable representation of the change.
+func (c Change) String() string {
+	var buf bytes.Buffer
+
+	switch c.Kind {
+	case Added:
+		buf.WriteString("+ ")
+	case Removed:
+		buf.WriteString("- ")
+	default:
+		buf.WriteString("~ ")
+	}
+
+	buf.WriteString(c.Resource)
+	buf.WriteString(": ")
+	buf.WriteString(c.Subject)
+	if c.Name != "" {
+		buf.WriteString(" ")
+		buf.WriteString(c.Name)
+	}
+
+	switch {
+	case c.Old != "" && c.New != "":
+		fmt.Fprintf(&buf, ": %s -> %s", c.Old, c.New)
+	case c.Old != "":
+		fmt.Fprintf(&buf, ": %s", c.Old)
+	case c.New != "":
+		fmt.Fprintf(&buf, ": %s", c.New)
+	}
+	return buf.String()
+}
+
+// Changes is a list of changes. Changes of resources come first, in the order of resources,
+// followed by changes of classes ordered by class name.
+type Changes []Change
+
+// String returns one change per line.
+func (cs Changes) String() string {
+	var buf bytes.Buffer
+	for _, c := range cs {
+		buf.WriteString(c.String())
+		buf.WriteByte('\n')
+	}
+	return buf.String()
+}
+
+// Diff compares two registries built from different snapshots of the api reference.
+//
+// Operations are matched by resource and path with the version stripped,
+// so version bumps are reported once per resource instead of as
+// removed and added operations.
+func Diff(old, new *Registry) Changes {
+	d := &differ{old: old, new: new}
+
+	oldRes, newRes := resourcesByID(old), resourcesByID(new)
+	for _, r := range old.Resources {
+		if newRes[r.ID] == nil {
+			d.add(Change{Kind: Removed, Subject: "resource", Resource: r.ID, Old: r.Version})
+		}
+	}
+	for _, r := range new.Resources {
+		o := oldRes[r.ID]
+		if o == nil {
+			d.add(Change{Kind: Added, Subject: "resource", Resource: r.ID, New: r.Version})
+			continue
+		}
+		d.diffResource(o, r)
+	}
+
+	d.diffClasses()
+	return d.changes
+}
+
+type differ struct {
+	old, new *Registry
+	changes  Changes
+}
+
+func (d *differ) add(c Change) {
+	d.changes = append(d.changes, c)
+}
+
+func (d *differ) diffResource(o, n *Resource) {
+	if o.Version != n.Version {
+		d.add(Change{Kind: Modified, Subject: "version", Resource: n.ID, Old: o.Version, New: n.Version})
+	}
+
+	oldRegions, newRegions := regionNames(o.Regions), regionNames(n.Regions)
+	d.diffNames("region", n.ID, "", oldRegions, newRegions)
+
+	oldOps, newOps := operationsByKey(o), operationsByKey(n)
+	for _, k := range sortedOperationKeys(oldOps) {
+		if newOps[k] == nil {
+			d.add(Change{Kind: Removed, Subject: "operation", Resource: n.ID, Name: oldOps[k].displayName()})
+		}
+	}
+	for _, k := range sortedOperationKeys(newOps) {
+		if oldOps[k] == nil {
+			d.add(Change{Kind: Added, Subject: "operation", Resource: n.ID, Name: newOps[k].displayName()})
+			continue
+		}
+		d.diffOperation(n.ID, oldOps[k], newOps[k])
+	}
+}
+
+func (d *differ) diffOperation(resID string, o, n *Operation) {
+	name := n.displayName()
+
+	oldRet, newRet := d.typeString(d.old, o.ReturnValue), d.typeString(d.new, n.ReturnValue)
+	if oldRet != newRet {
+		d.add(Change{Kind: Modified, Subject: "return value", Resource: resID, Name: name, Old: oldRet, New: newRet})
+	}
+
+	oldErrs, newErrs := make(map[string]bool), make(map[string]bool)
+	for _, e := range o.Errors {
+		oldErrs[strconv.Itoa(e.Code)] = true
+	}
+	for _, e := range n.Errors {
+		newErrs[strconv.Itoa(e.Code)] = true
+	}
+	d.diffNames("error", resID, name, oldErrs, newErrs)
+
+	d.diffParams(resID, name, o.Path.Params, n.Path.Params)
+	d.diffParams(resID, name, o.QueryParams, n.QueryParams)
+}
+
+func (d *differ) diffParams(resID, opName string, o, n []Parameter) {
+	oldParams, newParams := make(map[string]Parameter), make(map[string]Parameter)
+	for _, p := range o {
+		oldParams[p.Raw] = p
+	}
+	for _, p := range n {
+		newParams[p.Raw] = p
+	}
+
+	for _, p := range o {
+		if _, ok := newParams[p.Raw]; !ok {
+			d.add(Change{Kind: Removed, Subject: "parameter", Resource: resID, Name: opName + "." + p.Raw})
+		}
+	}
+
+	for _, p := range n {
+		name := opName + "." + p.Raw
+
+		op, ok := oldParams[p.Raw]
+		if !ok {
+			d.add(Change{Kind: Added, Subject: "parameter", Resource: resID, Name: name, New: d.typeString(d.new, p.Type())})
+			continue
+		}
+
+		oldType, newType := d.typeString(d.old, op.Type()), d.typeString(d.new, p.Type())
+		if oldType != newType {
+			d.add(Change{Kind: Modified, Subject: "parameter type", Resource: resID, Name: name, Old: oldType, New: newType})
+		}
+		if op.IsRequired() != p.IsRequired() {
+			d.add(Change{
+				Kind: Modified, Subject: "required", Resource: resID, Name: name,
+				Old: strconv.FormatBool(op.IsRequired()), New: strconv.FormatBool(p.IsRequired()),
+			})
+		}
+		d.diffLegalValues(resID, name, op.Desc, p.Desc)
+	}
+}
+
+func (d *differ) diffClasses() {
+	for _, name := range sortedClassNames(d.old) {
+		if d.new.Classes[name] == nil {
+			cls := d.old.Classes[name]
+			d.add(Change{Kind: Removed, Subject: "class", Resource: cls.ResID(), Name: name, Old: cls.RawName()})
+		}
+	}
+
+	for _, name := range sortedClassNames(d.new) {
+		n := d.new.Classes[name]
+		o := d.old.Classes[name]
+		if o == nil {
+			d.add(Change{Kind: Added, Subject: "class", Resource: n.ResID(), Name: name, New: n.RawName()})
+			continue
+		}
+
+		for _, f := range o.Fields() {
+			if n.FieldByRawName(f.RawName()) == nil {
+				d.add(Change{Kind: Removed, Subject: "field", Resource: n.ResID(), Name: name + "." + f.RawName()})
+			}
+		}
+
+		for _, f := range n.Fields() {
+			fieldName := name + "." + f.RawName()
+			newType := d.typeString(d.new, f.Type())
+
+			of := o.FieldByRawName(f.RawName())
+			if of == nil {
+				d.add(Change{Kind: Added, Subject: "field", Resource: n.ResID(), Name: fieldName, New: newType})
+				continue
+			}
+
+			if oldType := d.typeString(d.old, of.Type()); oldType != newType {
+				d.add(Change{Kind: Modified, Subject: "field type", Resource: n.ResID(), Name: fieldName, Old: oldType, New: newType})
+			}
+			d.diffLegalValues(n.ResID(), fieldName, of.Desc, f.Desc)
+		}
+	}
+}
+
+func (d *differ) diffLegalValues(resID, name, oldDesc, newDesc string) {
+	o, n := ParseLegalValues(oldDesc), ParseLegalValues(newDesc)
+	if strings.Join(o, ",") == strings.Join(n, ",") {
+		return
+	}
+
+	d.add(Change{
+		Kind: Modified, Subject: "legal values", Resource: resID, Name: name,
+		Old: strings.Join(o, ", "), New: strings.Join(n, ", "),
+	})
+}
+
+// diffNames reports added and removed entries of a set.
+func (d *differ) diffNames(subject, resID, prefix string, o, n map[string]bool) {
+	for _, k := range sortedKeys(o) {
+		if !n[k] {
+			d.add(Change{Kind: Removed, Subject: subject, Resource: resID, Name: prefix, Old: k})
+		}
+	}
+	for _, k := range sortedKeys(n) {
+		if !o[k] {
+			d.add(Change{Kind: Added, Subject: subject, Resource: resID, Name: prefix, New: k})
+		}
+	}
+}
+
+func (d *differ) typeString(reg *Registry, t types.Type) string {
+	if t == nil {
+		return ""
+	}
+	return types.TypeString(t, types.RelativeTo(reg.Pkg))
+}
+
+func resourcesByID(reg *Registry) map[string]*Resource {
+	m := make(map[string]*Resource, len(reg.Resources))
+	for _, r := range reg.Resources {
+		if m[r.ID] == nil { // current version
+			m[r.ID] = r
+		}
+	}
+	return m
+}
+
+func regionNames(regions Regions) map[string]bool {
+	m := make(map[string]bool, len(regions))
+	for _, r := range regions {
+		m[r.Name] = true
+	}
+	return m
+}
+
+// operationsByKey returns operations of the resource keyed by method and path without version.
+// A changed method or path is reported as a removed and an added operation.
+func operationsByKey(res *Resource) map[string]*Operation {
+	m := make(map[string]*Operation)
+	for _, e := range res.Endpoints {
+		for _, op := range e.Operations {
+			m[op.Method+" "+stripVersion(op.Path.String())] = op
+		}
+	}
+	return m
+}
+
+func sortedClassNames(reg *Registry) []string {
+	names := make([]string, 0, len(reg.Classes))
+	for name := range reg.Classes {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func sortedKeys(m map[string]bool) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func sortedOperationKeys(m map[string]*Operation) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+var versionSegment = regexp.MustCompile(`/v\d+(\.\d+)*/`)
+
+// stripVersion replaces a version segment like '/v2.2/' with '/{version}/'.
+func stripVersion(path string) string {
+	return versionSegment.ReplaceAllString(path, "/{version}/")
+}
+
+// displayName returns the name of the operation,
+// or its path if it's not a known operation.
+func (op *Operation) displayName() string {
+	if op.Name != "" {
+		return op.Name
+	}
+	return stripVersion(op.Path.String())
+}
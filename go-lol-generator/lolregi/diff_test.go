@@ -0,0 +1,88 @@
+package lolregi
+
+import (
+	"go/types"
+	"testing"
+
+	"github.com/go-lol/lol/uritemplates"
+)
+
+// newTestRegistry creates a registry with a single 'match' resource.
+func newTestRegistry(version, mapIDType, queueDesc string) *Registry {
+	reg := New(Config{})
+
+	res := &Resource{ID: "match", Version: version, Regions: Regions{regionByName("NA"), regionByName("KR")}}
+	e := &Endpoint{Resource: res}
+	res.Endpoints = Endpoints{e}
+	reg.Resources = append(reg.Resources, res)
+
+	cls := &ResponseClass{res: res, rawName: "MatchDetail", name: "MatchDetail"}
+	typ := types.Typ[types.Int32]
+	if mapIDType == "long" {
+		typ = types.Typ[types.Int64]
+	}
+	cls.AddField(NewField(reg.Pkg, "mapId", "MapID", typ, "Match map ID"))
+	cls.AddField(NewField(reg.Pkg, "queueType", "QueueType", types.Typ[types.String], queueDesc))
+	reg.Classes[cls.Name()] = cls
+
+	tpl, err := uritemplates.Parse("/api/lol/{region}/" + version + "/match/{matchId}")
+	if err != nil {
+		panic(err)
+	}
+	e.Operations = Operations{{
+		Endpoint: e, Name: "Match", Method: "GET",
+		Path: Path{tpl: tpl, Params: []Parameter{
+			{Raw: "matchId", Name: "matchID", typ: types.Typ[types.Int64], required: true},
+		}},
+		ReturnValue: types.NewPointer(cls),
+	}}
+
+	return reg
+}
+
+func TestDiff(t *testing.T) {
+	old := newTestRegistry("v2.2", "int", "Match queue type (Legal values: CUSTOM, NORMAL_5x5_BLIND)")
+	new := newTestRegistry("v2.3", "long", "Match queue type (Legal values: CUSTOM, NORMAL_5x5_BLIND, TEAM_BUILDER_DRAFT_RANKED_5x5)")
+	new.Resources[0].Regions = Regions{regionByName("NA")}
+
+	expected := Changes{
+		{Kind: Modified, Subject: "version", Resource: "match", Old: "v2.2", New: "v2.3"},
+		{Kind: Removed, Subject: "region", Resource: "match", Old: "KR"},
+		{Kind: Modified, Subject: "field type", Resource: "match", Name: "MatchDetail.mapId", Old: "int32", New: "int64"},
+		{
+			Kind: Modified, Subject: "legal values", Resource: "match", Name: "MatchDetail.queueType",
+			Old: "CUSTOM, NORMAL_5x5_BLIND", New: "CUSTOM, NORMAL_5x5_BLIND, TEAM_BUILDER_DRAFT_RANKED_5x5",
+		},
+	}
+
+	changes := Diff(old, new)
+	t.Logf("Changes:\n%s", changes)
+
+	if len(changes) != len(expected) {
+		t.Fatalf("Expected %d changes, got %d", len(expected), len(changes))
+		return
+	}
+	for i, c := range changes {
+		if c != expected[i] {
+			t.Fatalf("Expected: %v, got: %v", expected[i], c)
+			return
+		}
+	}
+
+	if changes := Diff(old, old); len(changes) != 0 {
+		t.Fatalf("Expected no changes, got:\n%s", changes)
+	}
+}
+
+func TestDiffMethod(t *testing.T) {
+	old := newTestRegistry("v2.2", "int", "")
+	new := newTestRegistry("v2.2", "int", "")
+	new.Resources[0].Endpoints[0].Operations[0].Method = "POST"
+
+	changes := Diff(old, new)
+	if len(changes) != 2 ||
+		changes[0].Kind != Removed || changes[0].Subject != "operation" ||
+		changes[1].Kind != Added || changes[1].Subject != "operation" {
+		t.Fatalf("Expected a removed and an added operation, got:\n%s", changes)
+	}
+}
@@ -0,0 +1,291 @@
+package lolregi
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/types"
+	"sort"
+
+	"github.com/go-lol/lol/go-lol-generator/loldesc"
+	"github.com/go-lol/lol/uritemplates"
+)
+
+// Document exports the registry as a serializable document.
+func (reg *Registry) Document() (*loldesc.Document, error) {
+	b := loldesc.NewBuilder()
+
+	for _, r := range reg.Regions {
+		dr := &loldesc.Region{
+			Name: r.Name, PlatformID: r.PlatformID, Host: r.Host(), Number: r.Number,
+		}
+		if !r.LaunchedAt.IsZero() {
+			t := r.LaunchedAt
+			dr.LaunchedAt = &t
+		}
+		b.AddRegion(dr)
+	}
+
+	for _, res := range reg.Resources {
+		dres := &loldesc.Resource{ID: res.ID, Version: res.Version, Num: res.Num}
+		for _, r := range res.Regions {
+			dres.Regions = append(dres.Regions, r.Name)
+		}
+
+		for _, e := range res.Endpoints {
+			de := &loldesc.Endpoint{}
+			for _, op := range e.Operations {
+				de.Operations = append(de.Operations, reg.operationDesc(op))
+			}
+			dres.Endpoints = append(dres.Endpoints, de)
+		}
+		b.AddResource(dres)
+	}
+
+	names := make([]string, 0, len(reg.Classes))
+	for name := range reg.Classes {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		cls := reg.Classes[name]
+		dc := &loldesc.Class{
+			Resource: cls.ResID(), RawName: cls.RawName(), Name: cls.Name(), Desc: cls.Desc,
+		}
+		if res := cls.Resource(); !res.IsCurrent() {
+			dc.ResourceVersion = res.Version
+		}
+		for _, f := range cls.Fields() {
+			dc.Fields = append(dc.Fields, &loldesc.Field{
+				RawName: f.RawName(), Name: f.Name(), Type: reg.typeString(f.Type()), Desc: f.Desc,
+			})
+		}
+		b.AddClass(dc)
+	}
+
+	return b.Document()
+}
+
+func (reg *Registry) operationDesc(op *Operation) *loldesc.Operation {
+	dop := &loldesc.Operation{
+		Name: op.Name, Desc: op.Desc, Method: op.Method, Num: op.Num,
+		Path:                op.Path.String(),
+		ReturnValue:         reg.typeString(op.ReturnValue),
+		ImplementationNotes: op.ImplementationNotes,
+		RateLimitNotes:      op.RateLimitNotes,
+	}
+	if op.MapKey != 0 {
+		dop.MapKey = types.Typ[op.MapKey].Name()
+	}
+
+	for _, p := range op.Path.Params {
+		dop.PathParams = append(dop.PathParams, reg.parameterDesc(p))
+	}
+	for _, p := range op.QueryParams {
+		dop.QueryParams = append(dop.QueryParams, reg.parameterDesc(p))
+	}
+	for _, e := range op.Errors {
+		dop.Errors = append(dop.Errors, &loldesc.ResponseError{Code: e.Code, Desc: e.Desc})
+	}
+	return dop
+}
+
+func (reg *Registry) parameterDesc(p Parameter) *loldesc.Parameter {
+	return &loldesc.Parameter{
+		Raw: p.Raw, Name: p.Name, Desc: p.Desc,
+		Type:     reg.typeString(p.Type()),
+		Required: p.IsRequired(),
+	}
+}
+
+func (reg *Registry) typeString(t types.Type) string {
+	return types.TypeString(t, types.RelativeTo(reg.Pkg))
+}
+
+// NewFromDocument creates a new registry from a document.
+//
+// Names in the document are used as is,
+// so Config.GetClassName and Config.GetFieldName are not called.
+func NewFromDocument(conf Config, doc *loldesc.Document) (*Registry, error) {
+	regions := make(Regions, 0, len(doc.Regions))
+	for _, r := range doc.Regions {
+		region := &Region{Name: r.Name, PlatformID: r.PlatformID, host: r.Host, Number: r.Number}
+		if r.LaunchedAt != nil {
+			region.LaunchedAt = *r.LaunchedAt
+		}
+		regions = append(regions, region)
+	}
+	reg := newRegistry(conf, regions)
+
+	resources := make(map[string]*Resource)
+	for _, dres := range doc.Resources {
+		res := &Resource{reg: reg, ID: dres.ID, Version: dres.Version, Num: dres.Num}
+		for _, name := range dres.Regions {
+			r := reg.Regions.Find(name)
+			if r == nil {
+				return nil, fmt.Errorf("%s: unknown region %s", dres.ID, name)
+			}
+			res.Regions = append(res.Regions, r)
+		}
+		// Classes without version belong to the current (first) version.
+		resources[res.ID+" "+res.Version] = res
+		if resources[res.ID] == nil {
+			resources[res.ID] = res
+		}
+		reg.Resources = append(reg.Resources, res)
+	}
+
+	// Declare all classes first, as fields refer other classes.
+	for _, dc := range doc.Classes {
+		key := dc.Resource
+		if dc.ResourceVersion != "" {
+			key += " " + dc.ResourceVersion
+		}
+		res := resources[key]
+		if res == nil {
+			return nil, fmt.Errorf("class %s: unknown resource %s", dc.Name, key)
+		}
+
+		cls := &ResponseClass{res: res, rawName: dc.RawName, name: dc.Name, Desc: dc.Desc}
+		reg.Classes[cls.Name()] = cls
+		reg.Insert(types.NewTypeName(0, reg.Pkg, cls.Name(), cls))
+	}
+
+	for _, dc := range doc.Classes {
+		cls := reg.Classes[dc.Name]
+		for _, df := range dc.Fields {
+			typ, err := reg.lookupType(df.Type)
+			if err != nil {
+				return nil, fmt.Errorf("%s.%s: %v", dc.Name, df.Name, err)
+			}
+			cls.AddField(NewField(reg.Pkg, df.RawName, df.Name, typ, df.Desc))
+		}
+	}
+
+	for _, dres := range doc.Resources {
+		res := resources[dres.ID+" "+dres.Version]
+		for _, de := range dres.Endpoints {
+			e := &Endpoint{Resource: res}
+			for _, dop := range de.Operations {
+				op, err := reg.operationFromDesc(e, dop)
+				if err != nil {
+					return nil, fmt.Errorf("%s.%s: %v", res.ID, dop.Name, err)
+				}
+				e.Operations = append(e.Operations, op)
+			}
+			res.Endpoints = append(res.Endpoints, e)
+		}
+	}
+
+	return reg, nil
+}
+
+func (reg *Registry) operationFromDesc(e *Endpoint, dop *loldesc.Operation) (*Operation, error) {
+	op := &Operation{
+		Endpoint: e,
+		Name:     dop.Name, Desc: dop.Desc, Method: dop.Method, Num: dop.Num,
+		ImplementationNotes: dop.ImplementationNotes,
+		RateLimitNotes:      dop.RateLimitNotes,
+	}
+
+	tpl, err := uritemplates.Parse(dop.Path)
+	if err != nil {
+		return nil, err
+	}
+	op.Path.tpl = tpl
+
+	if op.ReturnValue, err = reg.lookupType(dop.ReturnValue); err != nil {
+		return nil, err
+	}
+
+	if op.MapKey, err = (OperationOverride{MapKey: dop.MapKey}).mapKey(); err != nil {
+		return nil, err
+	}
+
+	for _, dp := range dop.PathParams {
+		p, err := reg.parameterFromDesc(dp)
+		if err != nil {
+			return nil, err
+		}
+		op.Path.Params = append(op.Path.Params, p)
+	}
+	for _, dp := range dop.QueryParams {
+		p, err := reg.parameterFromDesc(dp)
+		if err != nil {
+			return nil, err
+		}
+		op.QueryParams = append(op.QueryParams, p)
+	}
+
+	for _, de := range dop.Errors {
+		op.Errors = append(op.Errors, ResponseError{Code: de.Code, Desc: de.Desc})
+	}
+	return op, nil
+}
+
+func (reg *Registry) parameterFromDesc(dp *loldesc.Parameter) (Parameter, error) {
+	typ, err := reg.lookupType(dp.Type)
+	if err != nil {
+		return Parameter{}, fmt.Errorf("parameter %s: %v", dp.Raw, err)
+	}
+	return Parameter{Raw: dp.Raw, Name: dp.Name, Desc: dp.Desc, typ: typ, required: dp.Required}, nil
+}
+
+// lookupType converts a golang type expression like "map[string]*Item" to a type.
+func (reg *Registry) lookupType(expr string) (types.Type, error) {
+	e, err := parser.ParseExpr(expr)
+	if err != nil {
+		return nil, fmt.Errorf("invalid type %q: %v", expr, err)
+	}
+	return reg.typeOf(e)
+}
+
+func (reg *Registry) typeOf(e ast.Expr) (types.Type, error) {
+	switch e := e.(type) {
+	case *ast.Ident:
+		if cls := reg.Classes[e.Name]; cls != nil {
+			return cls, nil
+		}
+		if obj, ok := reg.Pkg.Scope().Lookup(e.Name).(*types.TypeName); ok {
+			return obj.Type(), nil
+		}
+		if obj, ok := types.Universe.Lookup(e.Name).(*types.TypeName); ok {
+			return obj.Type(), nil
+		}
+		return nil, fmt.Errorf("unknown type %s", e.Name)
+
+	case *ast.StarExpr:
+		elem, err := reg.typeOf(e.X)
+		if err != nil {
+			return nil, err
+		}
+		return types.NewPointer(elem), nil
+
+	case *ast.ArrayType:
+		if e.Len != nil {
+			return nil, fmt.Errorf("arrays are not supported")
+		}
+		elem, err := reg.typeOf(e.Elt)
+		if err != nil {
+			return nil, err
+		}
+		return types.NewSlice(elem), nil
+
+	case *ast.MapType:
+		k, err := reg.typeOf(e.Key)
+		if err != nil {
+			return nil, err
+		}
+		v, err := reg.typeOf(e.Value)
+		if err != nil {
+			return nil, err
+		}
+		return types.NewMap(k, v), nil
+
+	case *ast.InterfaceType:
+		return types.NewInterface(nil, nil), nil
+	}
+
+	return nil, fmt.Errorf("unsupported type expression %T", e)
+}
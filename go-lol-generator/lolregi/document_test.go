@@ -0,0 +1,79 @@
+package lolregi
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/go-lol/lol/go-lol-generator/loldesc"
+)
+
+func TestDocumentRoundTrip(t *testing.T) {
+	reg := newTestRegistry("v2.2", "long", "Match queue type (Legal values: CUSTOM, NORMAL_5x5_BLIND)")
+
+	doc, err := reg.Document()
+	if err != nil {
+		t.Fatal(err)
+		return
+	}
+
+	var buf bytes.Buffer
+	if err := doc.Write(&buf); err != nil {
+		t.Fatal(err)
+		return
+	}
+	t.Logf("Document:\n%s", buf.String())
+
+	decoded, err := loldesc.Read(bytes.NewReader(buf.Bytes()))
+	if err != nil {
+		t.Fatal(err)
+		return
+	}
+
+	loaded, err := NewFromDocument(Config{}, decoded)
+	if err != nil {
+		t.Fatal(err)
+		return
+	}
+
+	if changes := Diff(reg, loaded); len(changes) != 0 {
+		t.Fatalf("Expected no changes, got:\n%s", changes)
+		return
+	}
+
+	op := loaded.Resources[0].Endpoints[0].Operations[0]
+	if op.Name != "Match" || op.ReturnValue.String() != "*MatchDetail" {
+		t.Fatalf("Invalid operation %s returning %s", op.Name, op.ReturnValue)
+		return
+	}
+	if r := loaded.Regions.Find("KR"); r == nil || r.Host() != "kr.api.pvp.net" {
+		t.Fatalf("Invalid region %v", r)
+	}
+}
+
+func TestDocumentUnknownType(t *testing.T) {
+	doc, err := newTestRegistry("v2.2", "long", "").Document()
+	if err != nil {
+		t.Fatal(err)
+		return
+	}
+
+	doc.Classes[0].Fields[0].Type = "[]*Unknown"
+	if _, err := NewFromDocument(Config{}, doc); err == nil {
+		t.Fatal("Expected error for unknown type")
+	}
+}
+
+func TestDocumentInvalidMapKey(t *testing.T) {
+	for _, key := range []string{"error", "MatchDetail", "unknown"} {
+		doc, err := newTestRegistry("v2.2", "long", "").Document()
+		if err != nil {
+			t.Fatal(err)
+			return
+		}
+
+		doc.Resources[0].Endpoints[0].Operations[0].MapKey = key
+		if _, err := NewFromDocument(Config{}, doc); err == nil {
+			t.Errorf("Expected error for map key %s", key)
+		}
+	}
+}
@@ -0,0 +1,95 @@
+package lolregi
+
+import "go/types"
+
+// FilterResources removes resources which are not in include (if not empty),
+// or are in exclude.
+//
+// Classes declared by removed resources are removed too,
+// unless they are used by remaining resources.
+func (reg *Registry) FilterResources(include, exclude []string) {
+	keep := func(id string) bool {
+		for _, ex := range exclude {
+			if ex == id {
+				return false
+			}
+		}
+		if len(include) == 0 {
+			return true
+		}
+		for _, in := range include {
+			if in == id {
+				return true
+			}
+		}
+		return false
+	}
+
+	resources := reg.Resources[:0]
+	kept := make(map[string]bool)
+	for _, res := range reg.Resources {
+		if keep(res.ID) {
+			resources = append(resources, res)
+			kept[res.ID] = true
+		}
+	}
+	reg.Resources = resources
+
+	used := make(map[*ResponseClass]bool)
+	for _, res := range reg.Resources {
+		res.markUsed(used)
+	}
+	for _, cls := range reg.Classes {
+		if kept[cls.ResID()] {
+			markUsed(used, cls)
+		}
+	}
+
+	for name, cls := range reg.Classes {
+		if !used[cls] {
+			delete(reg.Classes, name)
+		}
+	}
+}
+
+// UsedClasses returns classes referred by operations of the resource.
+func (res *Resource) UsedClasses() map[*ResponseClass]bool {
+	used := make(map[*ResponseClass]bool)
+	res.markUsed(used)
+	return used
+}
+
+func (res *Resource) markUsed(used map[*ResponseClass]bool) {
+	for _, e := range res.Endpoints {
+		for _, op := range e.Operations {
+			markUsed(used, op.ReturnValue)
+			for _, p := range op.Path.Params {
+				markUsed(used, p.Type())
+			}
+			for _, p := range op.QueryParams {
+				markUsed(used, p.Type())
+			}
+		}
+	}
+}
+
+// markUsed marks classes referred by t.
+func markUsed(used map[*ResponseClass]bool, t types.Type) {
+	switch t := t.(type) {
+	case *ResponseClass:
+		if used[t] {
+			return
+		}
+		used[t] = true
+		for _, f := range t.Fields() {
+			markUsed(used, f.Type())
+		}
+	case *types.Pointer:
+		markUsed(used, t.Elem())
+	case *types.Slice:
+		markUsed(used, t.Elem())
+	case *types.Map:
+		markUsed(used, t.Key())
+		markUsed(used, t.Elem())
+	}
+}
@@ -0,0 +1,39 @@
+package lolregi
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestFilterResources(t *testing.T) {
+	load := func() *Registry {
+		reg := New(Config{})
+		for _, doc := range []string{testSwagger, testOpenAPI3} {
+			if err := reg.LoadOpenAPI(strings.NewReader(doc)); err != nil {
+				t.Fatal(err)
+			}
+		}
+		return reg
+	}
+
+	reg := load()
+	reg.FilterResources(nil, []string{"lol-static-data"})
+	if len(reg.Resources) != 1 || reg.Resources[0].ID != "champion" {
+		t.Fatalf("Invalid resources %v", reg.Resources)
+		return
+	}
+	if reg.Classes["ChampionData"] != nil || reg.Classes["Champion"] == nil {
+		t.Fatalf("Invalid classes %v", reg.Classes)
+		return
+	}
+
+	reg = load()
+	reg.FilterResources([]string{"lol-static-data"}, nil)
+	if len(reg.Resources) != 1 || reg.Resources[0].ID != "lol-static-data" {
+		t.Fatalf("Invalid resources %v", reg.Resources)
+		return
+	}
+	if len(reg.Classes) != 2 || reg.Classes["ChampionDataList"] == nil {
+		t.Fatalf("Invalid classes %v", reg.Classes)
+	}
+}
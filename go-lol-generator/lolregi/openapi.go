@@ -0,0 +1,684 @@
+package lolregi
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"go/types"
+	"io"
+	"os"
+	"sort"
+	"strings"
+
+	log "github.com/Sirupsen/logrus"
+	"github.com/go-lol/lol/uritemplates"
+)
+
+// LoadOpenAPIFile is like LoadOpenAPI, but reads a file.
+func (reg *Registry) LoadOpenAPIFile(filename string) error {
+	f, err := os.Open(filename)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	if err := reg.LoadOpenAPI(f); err != nil {
+		return fmt.Errorf("%s: %v", filename, err)
+	}
+	return nil
+}
+
+// LoadOpenAPI merges resources described by an OpenAPI 2 (swagger) or 3 json document.
+//
+// Resource id and version are taken from the first tag of each operation (like "summoner-v1.4"),
+// and schemas are converted to response classes of the first resource referring them.
+// Class names are resolved like classes of the api reference page, so
+// "ChampionDto" of "lol-static-data" becomes "ChampionData".
+//
+// Operations already in the registry are skipped, so multiple documents can be merged.
+//
+// Supported extensions:
+//
+//	x-type:             raw type of a schema, like "Map[string, ChampionDto]"
+//	x-regions:          regions of an operation, like ["NA", "KR"]
+//	x-rate-limit-notes: rate limit notes of an operation
+func (reg *Registry) LoadOpenAPI(r io.Reader) error {
+	doc := &openAPIDocument{}
+	if err := json.NewDecoder(r).Decode(doc); err != nil {
+		return err
+	}
+	if doc.Swagger == "" && doc.OpenAPI == "" {
+		return fmt.Errorf("neither 'swagger' nor 'openapi' version is declared")
+	}
+
+	l := &openAPILoader{reg: reg, doc: doc, classRes: make(map[string]*Resource)}
+	return l.load()
+}
+
+type openAPILoader struct {
+	reg *Registry
+	doc *openAPIDocument
+
+	// Key: schema name
+	classRes map[string]*Resource
+}
+
+func (l *openAPILoader) load() error {
+	type pendingOp struct {
+		res        *Resource
+		method     string
+		path       string
+		op         *openAPIOperation
+		pathParams []*openAPIParameter
+	}
+	var pending []pendingOp
+
+	paths := make([]string, 0, len(l.doc.Paths))
+	for p := range l.doc.Paths {
+		paths = append(paths, p)
+	}
+	sort.Strings(paths)
+
+	for _, p := range paths {
+		item := l.doc.Paths[p]
+		path := strings.TrimSuffix(l.doc.BasePath, "/") + p
+
+		methods := make([]string, 0, len(item.Operations))
+		for m := range item.Operations {
+			methods = append(methods, m)
+		}
+		sort.Strings(methods)
+
+		for _, m := range methods {
+			op := item.Operations[m]
+			res, err := l.resource(path, op)
+			if err != nil {
+				return fmt.Errorf("%s %s: %v", strings.ToUpper(m), path, err)
+			}
+			pending = append(pending, pendingOp{res, strings.ToUpper(m), path, op, item.Parameters})
+
+			for _, s := range op.schemas() {
+				l.assignSchema(res, s)
+			}
+		}
+	}
+
+	if err := l.registerClasses(); err != nil {
+		return err
+	}
+
+	for _, p := range pending {
+		if err := l.addOperation(p.res, p.method, p.path, p.op, p.pathParams); err != nil {
+			return fmt.Errorf("%s %s: %v", p.method, p.path, err)
+		}
+	}
+	return nil
+}
+
+// resource finds or creates a resource of the operation.
+func (l *openAPILoader) resource(path string, op *openAPIOperation) (*Resource, error) {
+	if len(op.Tags) == 0 {
+		return nil, fmt.Errorf("resource tag (like 'summoner-v1.4') required")
+	}
+
+	id, ver := op.Tags[0], ""
+	if i := strings.LastIndex(id, "-v"); i != -1 {
+		id, ver = id[:i], id[i+1:]
+	} else if m := versionSegment.FindString(path); m != "" {
+		ver = strings.Trim(m, "/")
+	}
+
+	for _, res := range l.reg.Resources {
+		if res.ID == id {
+			if ver != "" && res.Version != ver {
+				return nil, fmt.Errorf("resource %s: version %s conflicts with %s", id, ver, res.Version)
+			}
+			return res, nil
+		}
+	}
+
+	res := &Resource{reg: l.reg, ID: id, Version: ver, Endpoints: Endpoints{}}
+	if len(op.Regions) != 0 {
+		for _, name := range op.Regions {
+			r := l.reg.Regions.Find(name)
+			if r == nil {
+				return nil, fmt.Errorf("unknown region %s", name)
+			}
+			res.Regions = append(res.Regions, r)
+		}
+	} else {
+		res.Regions = l.serverRegions()
+	}
+
+	l.reg.Resources = append(l.reg.Resources, res)
+	return res, nil
+}
+
+// serverRegions returns regions matching hosts of the document,
+// or all valid regions if no host matches.
+func (l *openAPILoader) serverRegions() Regions {
+	hosts := []string{l.doc.Host}
+	for _, s := range l.doc.Servers {
+		u := strings.TrimPrefix(strings.TrimPrefix(s.URL, "https://"), "http://")
+		hosts = append(hosts, strings.SplitN(u, "/", 2)[0])
+	}
+
+	var regions Regions
+	for _, r := range l.reg.Regions {
+		for _, h := range hosts {
+			if h != "" && h == r.Host() {
+				regions = append(regions, r)
+				break
+			}
+		}
+	}
+
+	if len(regions) == 0 {
+		return AllValidRegions()
+	}
+	return regions
+}
+
+// assignSchema assigns the schema and all schemas referred by it to the resource.
+func (l *openAPILoader) assignSchema(res *Resource, s *openAPISchema) {
+	if s == nil {
+		return
+	}
+
+	if name := s.refName(); name != "" {
+		if _, ok := l.classRes[name]; ok || name == "SpellRange" {
+			return
+		}
+		def := l.doc.schema(name)
+		if def == nil {
+			return
+		}
+		l.classRes[name] = res
+		s = def
+	}
+
+	l.assignSchema(res, s.Items)
+	l.assignSchema(res, s.additionalProperties())
+	for _, p := range s.Properties {
+		l.assignSchema(res, p.Schema)
+	}
+}
+
+func (l *openAPILoader) registerClasses() error {
+	names := make([]string, 0, len(l.classRes))
+	for name := range l.classRes {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var classes []*ResponseClass
+	schemaNames := make(map[*ResponseClass]string)
+	for _, name := range names {
+		res := l.classRes[name]
+		rawName := rawSchemaName(name)
+
+		cls := &ResponseClass{
+			res:     res,
+			rawName: rawName,
+			name:    l.reg.className(res.ID, rawName),
+			Desc:    strings.TrimSpace(l.doc.schema(name).Description),
+			fields:  make([]*Field, 0),
+			methods: make([]*types.Func, 0),
+		}
+
+		if conflict, ok := l.reg.Classes[cls.Name()]; ok {
+			if conflict.ResID() == res.ID && conflict.RawName() == rawName {
+				log.Debugf(`%s is already declared. Raw: %s`, cls.Name(), rawName)
+				continue
+			}
+			return fmt.Errorf("conflict class %s:%s", conflict.DebugString(), cls.DebugString())
+		}
+
+		l.reg.Classes[cls.Name()] = cls
+		l.reg.Insert(types.NewTypeName(0, l.reg.Pkg, cls.Name(), cls))
+		classes = append(classes, cls)
+		schemaNames[cls] = name
+	}
+
+	// Fields are added after all classes are declared, as they refer each other.
+	for _, cls := range classes {
+		for _, p := range l.doc.schema(schemaNames[cls]).Properties {
+			desc := p.Schema.description()
+
+			name := l.reg.fieldName(cls.ResID(), cls.RawName(), p.Name)
+			if name == Skip {
+				continue
+			}
+
+			rawType, err := p.Schema.rawType()
+			if err != nil {
+				return fmt.Errorf("%s.%s: %v", cls.RawName(), p.Name, err)
+			}
+
+			typ, err := l.fieldType(cls, p.Name, rawType, desc)
+			if err != nil {
+				return fmt.Errorf("%s.%s: %v", cls.RawName(), p.Name, err)
+			}
+			cls.AddField(NewField(l.reg.Pkg, p.Name, name, typ, desc))
+		}
+	}
+	return nil
+}
+
+// fieldType is Registry.fieldType, but returns an error instead of panicking,
+// as types in documents and overrides may not be found.
+func (l *openAPILoader) fieldType(cls *ResponseClass, rawField, rawType, desc string) (typ types.Type, err error) {
+	if _, err := l.reg.parseType(cls.ResID(), rawType); err != nil {
+		return nil, err
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			typ, err = nil, fmt.Errorf("%v", r)
+		}
+	}()
+	return l.reg.fieldType(cls.ResID(), cls.RawName(), rawField, rawType, desc), nil
+}
+
+func (l *openAPILoader) addOperation(res *Resource, method, path string,
+	o *openAPIOperation, pathParams []*openAPIParameter) error {
+	for _, e := range res.Endpoints {
+		for _, op := range e.Operations {
+			if op.Method == method && op.Path.String() == path {
+				log.Debugf(`%s %s is already declared`, method, path)
+				return nil
+			}
+		}
+	}
+
+	e := &Endpoint{Resource: res, Operations: make(Operations, 0)}
+	op := &Operation{
+		Endpoint:            e,
+		Method:              method,
+		Desc:                strings.TrimSpace(o.Summary),
+		ImplementationNotes: strings.TrimSpace(o.Description),
+		RateLimitNotes:      strings.TrimSpace(o.RateLimitNotes),
+	}
+
+	tpl, err := uritemplates.Parse(path)
+	if err != nil {
+		return err
+	}
+	op.Path.tpl = tpl
+
+	info, ok := op.lookupInfo()
+	if !ok {
+		if !l.reg.Config.AllowUnknownOperations {
+			return fmt.Errorf("unknown operation")
+		}
+		if i := strings.LastIndex(o.OperationID, "."); i != -1 {
+			info.Name = exportedName(o.OperationID[i+1:])
+		} else if o.OperationID != "" {
+			info.Name = exportedName(o.OperationID)
+		}
+	}
+	op.Name, op.MapKey = info.Name, info.MapKey
+
+	for _, p := range append(append([]*openAPIParameter(nil), pathParams...), o.Parameters...) {
+		p = l.doc.parameter(p)
+		if p == nil {
+			continue
+		}
+
+		rawType, err := p.rawType()
+		if err != nil {
+			return fmt.Errorf("parameter %s: %v", p.Name, err)
+		}
+		typ, err := l.reg.parseType(res.ID, rawType)
+		if err != nil {
+			return fmt.Errorf("parameter %s: %v", p.Name, err)
+		}
+
+		param := Parameter{
+			Raw: p.Name, Name: parameterName(p.Name),
+			Desc: p.description(), typ: typ, required: p.Required || p.In == "path",
+		}
+		switch p.In {
+		case "path":
+			op.Path.Params = append(op.Path.Params, param)
+		case "query":
+			op.QueryParams = append(op.QueryParams, param)
+		}
+	}
+
+	codes := make([]string, 0, len(o.Responses))
+	for code := range o.Responses {
+		codes = append(codes, code)
+	}
+	sort.Strings(codes)
+
+	for _, code := range codes {
+		resp := o.Responses[code]
+		if strings.HasPrefix(code, "2") {
+			rawType, err := resp.schema().rawType()
+			if err != nil {
+				return fmt.Errorf("response %s: %v", code, err)
+			}
+			if op.ReturnValue, err = l.reg.parseType(res.ID, rawType); err != nil {
+				return fmt.Errorf("response %s: %v", code, err)
+			}
+			continue
+		}
+
+		var c int
+		if _, err := fmt.Sscanf(code, "%d", &c); err != nil {
+			continue // "default"
+		}
+		op.Errors = append(op.Errors, ResponseError{Code: c, Desc: strings.TrimSpace(resp.Description)})
+	}
+
+	if op.ReturnValue == nil {
+		return fmt.Errorf("response schema required")
+	}
+
+	e.Operations = append(e.Operations, op)
+	res.Endpoints = append(res.Endpoints, e)
+	return nil
+}
+
+func exportedName(name string) string {
+	if name == "" {
+		return name
+	}
+	return lintName(strings.ToUpper(name[:1]) + name[1:])
+}
+
+// rawSchemaName strips prefix from names like 'summoner-v1.4.SummonerDto'.
+func rawSchemaName(name string) string {
+	return name[strings.LastIndex(name, ".")+1:]
+}
+
+// ----- OpenAPI document
+
+type openAPIDocument struct {
+	Swagger string `json:"swagger"`
+	OpenAPI string `json:"openapi"`
+
+	Host     string `json:"host"`
+	BasePath string `json:"basePath"`
+	Servers  []struct {
+		URL string `json:"url"`
+	} `json:"servers"`
+
+	Paths map[string]*openAPIPathItem `json:"paths"`
+
+	// OpenAPI 2
+	Definitions map[string]*openAPISchema    `json:"definitions"`
+	Parameters  map[string]*openAPIParameter `json:"parameters"`
+
+	// OpenAPI 3
+	Components struct {
+		Schemas    map[string]*openAPISchema    `json:"schemas"`
+		Parameters map[string]*openAPIParameter `json:"parameters"`
+	} `json:"components"`
+}
+
+func (d *openAPIDocument) schema(name string) *openAPISchema {
+	if s, ok := d.Definitions[name]; ok {
+		return s
+	}
+	return d.Components.Schemas[name]
+}
+
+// parameter resolves $ref of the parameter.
+func (d *openAPIDocument) parameter(p *openAPIParameter) *openAPIParameter {
+	if p.Ref == "" {
+		return p
+	}
+
+	name := p.Ref[strings.LastIndex(p.Ref, "/")+1:]
+	if r, ok := d.Parameters[name]; ok {
+		return r
+	}
+	return d.Components.Parameters[name]
+}
+
+type openAPIPathItem struct {
+	Parameters []*openAPIParameter
+	// Key: lowercase http method
+	Operations map[string]*openAPIOperation
+}
+
+func (item *openAPIPathItem) UnmarshalJSON(data []byte) error {
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	item.Operations = make(map[string]*openAPIOperation)
+	for k, v := range raw {
+		switch k {
+		case "parameters":
+			if err := json.Unmarshal(v, &item.Parameters); err != nil {
+				return err
+			}
+		case "get", "put", "post", "delete", "options", "head", "patch":
+			op := &openAPIOperation{}
+			if err := json.Unmarshal(v, op); err != nil {
+				return err
+			}
+			item.Operations[k] = op
+		}
+	}
+	return nil
+}
+
+type openAPIOperation struct {
+	OperationID string   `json:"operationId"`
+	Summary     string   `json:"summary"`
+	Description string   `json:"description"`
+	Tags        []string `json:"tags"`
+
+	Parameters []*openAPIParameter         `json:"parameters"`
+	Responses  map[string]*openAPIResponse `json:"responses"`
+
+	Regions        []string `json:"x-regions"`
+	RateLimitNotes string   `json:"x-rate-limit-notes"`
+}
+
+// schemas returns all schemas used by the operation.
+func (op *openAPIOperation) schemas() []*openAPISchema {
+	var schemas []*openAPISchema
+	for _, p := range op.Parameters {
+		schemas = append(schemas, p.Schema, p.Items)
+	}
+	for _, r := range op.Responses {
+		schemas = append(schemas, r.schema())
+	}
+	return schemas
+}
+
+type openAPIParameter struct {
+	Ref string `json:"$ref"`
+
+	Name        string `json:"name"`
+	In          string `json:"in"`
+	Description string `json:"description"`
+	Required    bool   `json:"required"`
+
+	// OpenAPI 2
+	Type   string         `json:"type"`
+	Format string         `json:"format"`
+	Items  *openAPISchema `json:"items"`
+	Enum   []interface{}  `json:"enum"`
+
+	// OpenAPI 3
+	Schema *openAPISchema `json:"schema"`
+}
+
+func (p *openAPIParameter) rawType() (string, error) {
+	if p.Schema != nil {
+		return p.Schema.rawType()
+	}
+	return (&openAPISchema{Type: p.Type, Format: p.Format, Items: p.Items}).rawType()
+}
+
+func (p *openAPIParameter) description() string {
+	enum := p.Enum
+	if p.Schema != nil {
+		enum = p.Schema.Enum
+	}
+	return withLegalValues(p.Description, enum)
+}
+
+type openAPIResponse struct {
+	Description string `json:"description"`
+
+	// OpenAPI 2
+	Schema *openAPISchema `json:"schema"`
+
+	// OpenAPI 3
+	Content map[string]struct {
+		Schema *openAPISchema `json:"schema"`
+	} `json:"content"`
+}
+
+func (r *openAPIResponse) schema() *openAPISchema {
+	if r.Schema != nil {
+		return r.Schema
+	}
+	if c, ok := r.Content["application/json"]; ok {
+		return c.Schema
+	}
+	return nil
+}
+
+type openAPISchema struct {
+	Ref         string `json:"$ref"`
+	RawType     string `json:"x-type"`
+	Type        string `json:"type"`
+	Format      string `json:"format"`
+	Description string `json:"description"`
+
+	Items                *openAPISchema    `json:"items"`
+	UniqueItems          bool              `json:"uniqueItems"`
+	Properties           openAPIProperties `json:"properties"`
+	AdditionalProperties json.RawMessage   `json:"additionalProperties"`
+	Enum                 []interface{}     `json:"enum"`
+}
+
+func (s *openAPISchema) refName() string {
+	if s == nil || s.Ref == "" {
+		return ""
+	}
+	return s.Ref[strings.LastIndex(s.Ref, "/")+1:]
+}
+
+// additionalProperties returns nil if it's not a schema.
+func (s *openAPISchema) additionalProperties() *openAPISchema {
+	if len(s.AdditionalProperties) == 0 || s.AdditionalProperties[0] != '{' {
+		return nil
+	}
+
+	ap := &openAPISchema{}
+	if err := json.Unmarshal(s.AdditionalProperties, ap); err != nil {
+		return nil
+	}
+	return ap
+}
+
+func (s *openAPISchema) description() string {
+	if s == nil {
+		return ""
+	}
+	return withLegalValues(s.Description, s.Enum)
+}
+
+// rawType returns a type like types in the api reference page, like "List[ChampionDto]" or "long".
+func (s *openAPISchema) rawType() (string, error) {
+	if s == nil {
+		return "", fmt.Errorf("schema required")
+	}
+	if s.RawType != "" {
+		return s.RawType, nil
+	}
+	if name := s.refName(); name != "" {
+		return rawSchemaName(name), nil
+	}
+
+	switch s.Type {
+	case "boolean":
+		return "boolean", nil
+	case "string":
+		return "string", nil
+	case "integer":
+		if s.Format == "int64" {
+			return "long", nil
+		}
+		return "int", nil
+	case "number":
+		if s.Format == "float" {
+			return "float", nil
+		}
+		return "double", nil
+	case "array":
+		elem, err := s.Items.rawType()
+		if err != nil {
+			return "", err
+		}
+		if s.UniqueItems {
+			return "Set[" + elem + "]", nil
+		}
+		return "List[" + elem + "]", nil
+	case "object", "":
+		if ap := s.additionalProperties(); ap != nil {
+			elem, err := ap.rawType()
+			if err != nil {
+				return "", err
+			}
+			return "Map[string," + elem + "]", nil
+		}
+		return "object", nil
+	}
+
+	return "", fmt.Errorf("unknown type %s", s.Type)
+}
+
+// withLegalValues appends enum values to the description like the api reference page does.
+func withLegalValues(desc string, enum []interface{}) string {
+	desc = strings.TrimSpace(desc)
+	if len(enum) == 0 || ParseLegalValues(desc) != nil {
+		return desc
+	}
+
+	vals := make([]string, len(enum))
+	for i, v := range enum {
+		vals[i] = fmt.Sprint(v)
+	}
+	return strings.TrimSpace(desc + " (Legal values: " + strings.Join(vals, ", ") + ")")
+}
+
+type openAPIProperty struct {
+	Name   string
+	Schema *openAPISchema
+}
+
+// openAPIProperties keeps declaration order of properties,
+// as it's used as field order.
+type openAPIProperties []openAPIProperty
+
+func (ps *openAPIProperties) UnmarshalJSON(data []byte) error {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	if t, err := dec.Token(); err != nil {
+		return err
+	} else if t != json.Delim('{') {
+		return fmt.Errorf("properties must be an object")
+	}
+
+	for dec.More() {
+		t, err := dec.Token()
+		if err != nil {
+			return err
+		}
+		s := &openAPISchema{}
+		if err := dec.Decode(s); err != nil {
+			return err
+		}
+		*ps = append(*ps, openAPIProperty{Name: t.(string), Schema: s})
+	}
+	return nil
+}
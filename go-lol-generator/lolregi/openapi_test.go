@@ -0,0 +1,149 @@
+package lolregi
+
+import (
+	"strings"
+	"testing"
+)
+
+const testSwagger = `{
+  "swagger": "2.0",
+  "host": "na.api.pvp.net",
+  "paths": {
+    "/api/lol/{region}/v1.2/champion": {
+      "get": {
+        "tags": ["champion-v1.2"],
+        "summary": "Retrieve all champions.",
+        "parameters": [
+          {"name": "region", "in": "path", "required": true, "type": "string"},
+          {"name": "freeToPlay", "in": "query", "type": "boolean"}
+        ],
+        "responses": {
+          "200": {"description": "ok", "schema": {"$ref": "#/definitions/ChampionListDto"}},
+          "429": {"description": "Rate limit exceeded"}
+        }
+      }
+    }
+  },
+  "definitions": {
+    "ChampionListDto": {
+      "description": "This object contains a collection of champion information.",
+      "properties": {
+        "champions": {"type": "array", "items": {"$ref": "#/definitions/ChampionDto"}}
+      }
+    },
+    "ChampionDto": {
+      "properties": {
+        "id": {"type": "integer", "format": "int64", "description": "Champion ID."},
+        "freeToPlay": {"type": "boolean"},
+        "active": {"type": "boolean"}
+      }
+    }
+  }
+}`
+
+const testOpenAPI3 = `{
+  "openapi": "3.0.0",
+  "servers": [{"url": "https://global.api.pvp.net"}],
+  "paths": {
+    "/api/lol/static-data/{region}/v1.2/champion": {
+      "parameters": [
+        {"name": "region", "in": "path", "required": true, "schema": {"type": "string"}}
+      ],
+      "get": {
+        "tags": ["lol-static-data-v1.2"],
+        "x-regions": ["NA", "KR"],
+        "parameters": [
+          {"name": "champData", "in": "query", "schema": {"type": "string", "enum": ["all", "image"]}}
+        ],
+        "responses": {
+          "200": {"content": {"application/json": {"schema": {"$ref": "#/components/schemas/lol-static-data-v1.2.ChampionListDto"}}}}
+        }
+      }
+    }
+  },
+  "components": {
+    "schemas": {
+      "lol-static-data-v1.2.ChampionListDto": {
+        "properties": {
+          "data": {"type": "object", "additionalProperties": {"$ref": "#/components/schemas/lol-static-data-v1.2.ChampionDto"}},
+          "version": {"type": "string"}
+        }
+      },
+      "lol-static-data-v1.2.ChampionDto": {
+        "properties": {
+          "name": {"type": "string"},
+          "key": {"type": "string"}
+        }
+      }
+    }
+  }
+}`
+
+func TestLoadOpenAPI(t *testing.T) {
+	reg := New(Config{})
+
+	if err := reg.LoadOpenAPI(strings.NewReader(testSwagger)); err != nil {
+		t.Fatal(err)
+		return
+	}
+	if err := reg.LoadOpenAPI(strings.NewReader(testOpenAPI3)); err != nil {
+		t.Fatal(err)
+		return
+	}
+
+	if len(reg.Resources) != 2 {
+		t.Fatalf("Expected 2 resources, got %d", len(reg.Resources))
+		return
+	}
+
+	champ := reg.Resources[0]
+	if champ.ID != "champion" || champ.Version != "v1.2" || champ.Regions.Join(",") != "NA" {
+		t.Fatalf("Invalid resource %s %s %s", champ.ID, champ.Version, champ.Regions)
+		return
+	}
+	op := champ.Endpoints[0].Operations[0]
+	if op.Name != "Champions" || op.ReturnValue.String() != "*ChampionList" || len(op.Errors) != 1 {
+		t.Fatalf("Invalid operation %s returning %s", op.Name, op.ReturnValue)
+		return
+	}
+
+	// Fixed id type.
+	if typ := reg.Classes["Champion"].FieldByRawName("id").Type().String(); typ != "int32" {
+		t.Fatalf("Expected int32 for Champion.id, got %s", typ)
+		return
+	}
+
+	static := reg.Resources[1]
+	if static.ID != "lol-static-data" || static.Regions.Join(",") != "NA,KR" {
+		t.Fatalf("Invalid resource %s %s", static.ID, static.Regions)
+		return
+	}
+
+	// Renamed by className rules.
+	list := reg.Classes["ChampionDataList"]
+	if list == nil {
+		t.Fatal("ChampionDataList is not registered")
+		return
+	}
+	if f := list.Field("Data"); f == nil || f.Type().String() != "map[string]*ChampionData" {
+		t.Fatalf("Invalid field ChampionDataList.data: %v", f)
+		return
+	}
+
+	op = static.Endpoints[0].Operations[0]
+	if len(op.Path.Params) != 1 || len(op.QueryParams) != 1 {
+		t.Fatalf("Invalid parameters %v %v", op.Path.Params, op.QueryParams)
+		return
+	}
+	if vals := ParseLegalValues(op.QueryParams[0].Desc); strings.Join(vals, ",") != "all,image" {
+		t.Fatalf("Invalid legal values %v", vals)
+	}
+}
+
+func TestLoadOpenAPIUnknownOverrideType(t *testing.T) {
+	o := &Overrides{FieldTypes: map[string]map[string]string{"ChampionDto": {"id": "*Unknown"}}}
+	reg := New(Config{Overrides: DefaultOverrides().Merge(o)})
+	if err := reg.LoadOpenAPI(strings.NewReader(testSwagger)); err == nil {
+		t.Fatal("Expected error for unknown override type")
+	}
+}
@@ -0,0 +1,287 @@
+package lolregi
+
+import (
+	"encoding/json"
+	"fmt"
+	"go/types"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+
+	"github.com/go-lol/lol/go-lol-generator/loldesc"
+	"gopkg.in/yaml.v2"
+)
+
+// AnyClass is a class key of Overrides.Fields, Overrides.FieldTypes,
+// Overrides.IDTypes, Overrides.TimeTypes and Overrides.OptionalFields,
+// which matches all classes.
+const AnyClass = "*"
+
+// Overrides declares how classes, fields and operations in the api reference
+// are converted. Classes and fields are keyed by raw names (e.g. "SummonerDto").
+//
+// Example (yaml):
+//
+//	classes:
+//	  lol-static-data:
+//	    ChampionDto: ChampionData
+//	fields:
+//	  SummonerDto:
+//	    revisionDate: "-"
+//	fieldTypes:
+//	  SummonerSpellDto:
+//	    effect: "[][]float64"
+//	idTypes:
+//	  "*":
+//	    mapId: int32
+//	timeTypes:
+//	  MatchReference:
+//	    timestamp: EpochMillis
+//	operations:
+//	  summoner:
+//	    /summoner/{summonerIds}: {name: Summoners, mapKey: int64}
+//	sharedClasses: [Image]
+//	optionalFields:
+//	  GameDto: [invalid]
+type Overrides struct {
+	// map[resource id]map[raw class name]name
+	Classes map[string]map[string]string `json:"classes,omitempty" yaml:"classes,omitempty"`
+
+	// map[raw class name]map[raw field name]name
+	//
+	// Use Skip ("-") to exclude a field.
+	Fields map[string]map[string]string `json:"fields,omitempty" yaml:"fields,omitempty"`
+
+	// map[raw class name]map[raw field name]type
+	//
+	// Types are golang type expressions like "[]*SpellRange".
+	FieldTypes map[string]map[string]string `json:"fieldTypes,omitempty" yaml:"fieldTypes,omitempty"`
+
+	// Same as FieldTypes, but only replaces int64 fields.
+	// Ignored if Config.DontFixIDType is set.
+	IDTypes map[string]map[string]string `json:"idTypes,omitempty" yaml:"idTypes,omitempty"`
+
+	// Same as FieldTypes, but only replaces integer fields, for time fields
+	// which are not recognized by description. See TimeType
+	// Ignored if Config.DontUseTimeTypes is set.
+	TimeTypes map[string]map[string]string `json:"timeTypes,omitempty" yaml:"timeTypes,omitempty"`
+
+	// map[resource id]map[path suffix]operation
+	Operations map[string]map[string]OperationOverride `json:"operations,omitempty" yaml:"operations,omitempty"`
+
+	// Names of classes to generate in a shared file, instead of the file
+	// of resource declaring them. See lolgen.Generator.GenerateFiles
+	SharedClasses []string `json:"sharedClasses,omitempty" yaml:"sharedClasses,omitempty"`
+
+	// map[raw class name][]raw field name
+	//
+	// Fields which may be absent, in addition to fields documented so.
+	// See Config.OptionalFields
+	OptionalFields map[string][]string `json:"optionalFields,omitempty" yaml:"optionalFields,omitempty"`
+}
+
+// OperationOverride names an operation.
+type OperationOverride struct {
+	Name string `json:"name" yaml:"name"`
+	// Override map key in return value. e.g. "int64"
+	MapKey string `json:"mapKey,omitempty" yaml:"mapKey,omitempty"`
+}
+
+// LoadOverridesFile reads overrides from a yaml or json (*.json) file.
+func LoadOverridesFile(filename string) (*Overrides, error) {
+	data, err := ioutil.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+
+	o := &Overrides{}
+	if strings.EqualFold(filepath.Ext(filename), ".json") {
+		err = json.Unmarshal(data, o)
+	} else {
+		err = yaml.UnmarshalStrict(data, o)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%s: %v", filename, err)
+	}
+
+	if err := o.Validate(); err != nil {
+		return nil, fmt.Errorf("%s: %v", filename, err)
+	}
+	return o, nil
+}
+
+// Validate checks type expressions and map keys.
+func (o *Overrides) Validate() error {
+	for _, m := range []map[string]map[string]string{o.FieldTypes, o.IDTypes, o.TimeTypes} {
+		for cls, fields := range m {
+			for field, typ := range fields {
+				if _, err := loldesc.TypeNames(typ); err != nil {
+					return fmt.Errorf("%s.%s: %v", cls, field, err)
+				}
+			}
+		}
+	}
+
+	for resID, ops := range o.Operations {
+		for suffix, op := range ops {
+			if op.Name == "" {
+				return fmt.Errorf("%s %s: operation name is required", resID, suffix)
+			}
+			if _, err := op.mapKey(); err != nil {
+				return fmt.Errorf("%s %s: %v", resID, suffix, err)
+			}
+		}
+	}
+	return nil
+}
+
+// Merge returns a new Overrides with entries of other added to o.
+// Entries of other take precedence.
+func (o *Overrides) Merge(other *Overrides) *Overrides {
+	merged := &Overrides{}
+	for _, src := range []*Overrides{o, other} {
+		if src == nil {
+			continue
+		}
+		merged.Classes = mergeNames(merged.Classes, src.Classes)
+		merged.Fields = mergeNames(merged.Fields, src.Fields)
+		merged.FieldTypes = mergeNames(merged.FieldTypes, src.FieldTypes)
+		merged.IDTypes = mergeNames(merged.IDTypes, src.IDTypes)
+		merged.TimeTypes = mergeNames(merged.TimeTypes, src.TimeTypes)
+
+		for _, name := range src.SharedClasses {
+			if !merged.IsShared(name) {
+				merged.SharedClasses = append(merged.SharedClasses, name)
+			}
+		}
+
+		for cls, fields := range src.OptionalFields {
+			if merged.OptionalFields == nil {
+				merged.OptionalFields = make(map[string][]string)
+			}
+			for _, f := range fields {
+				if !contains(merged.OptionalFields[cls], f) {
+					merged.OptionalFields[cls] = append(merged.OptionalFields[cls], f)
+				}
+			}
+		}
+
+		for resID, ops := range src.Operations {
+			if merged.Operations == nil {
+				merged.Operations = make(map[string]map[string]OperationOverride)
+			}
+			if merged.Operations[resID] == nil {
+				merged.Operations[resID] = make(map[string]OperationOverride)
+			}
+			for suffix, op := range ops {
+				merged.Operations[resID][suffix] = op
+			}
+		}
+	}
+	return merged
+}
+
+func mergeNames(dst, src map[string]map[string]string) map[string]map[string]string {
+	for k, names := range src {
+		if dst == nil {
+			dst = make(map[string]map[string]string)
+		}
+		if dst[k] == nil {
+			dst[k] = make(map[string]string)
+		}
+		for raw, name := range names {
+			dst[k][raw] = name
+		}
+	}
+	return dst
+}
+
+// IsShared returns true if the class is declared in SharedClasses.
+func (o *Overrides) IsShared(name string) bool {
+	for _, shared := range o.SharedClasses {
+		if shared == name {
+			return true
+		}
+	}
+	return false
+}
+
+// className returns an empty string if class is not renamed.
+func (o *Overrides) className(resID, rawCls string) string {
+	return o.Classes[resID][rawCls]
+}
+
+// fieldName returns an empty string if field is not renamed.
+func (o *Overrides) fieldName(rawCls, rawField string) string {
+	return lookupClassKey(o.Fields, rawCls, rawField)
+}
+
+func (o *Overrides) fieldType(rawCls, rawField string) string {
+	return lookupClassKey(o.FieldTypes, rawCls, rawField)
+}
+
+func (o *Overrides) idType(rawCls, rawField string) string {
+	return lookupClassKey(o.IDTypes, rawCls, rawField)
+}
+
+func (o *Overrides) timeType(rawCls, rawField string) string {
+	return lookupClassKey(o.TimeTypes, rawCls, rawField)
+}
+
+// isOptional returns true if the field is declared in OptionalFields.
+func (o *Overrides) isOptional(rawCls, rawField string) bool {
+	return contains(o.OptionalFields[rawCls], rawField) || contains(o.OptionalFields[AnyClass], rawField)
+}
+
+func lookupClassKey(m map[string]map[string]string, rawCls, rawField string) string {
+	if v, ok := m[rawCls][rawField]; ok {
+		return v
+	}
+	return m[AnyClass][rawField]
+}
+
+// operation finds an operation by the longest matching path suffix.
+func (o *Overrides) operation(resID, path string) (OpInfo, bool) {
+	var (
+		found   OperationOverride
+		longest = -1
+	)
+	for suffix, op := range o.Operations[resID] {
+		if strings.HasSuffix(path, suffix) && len(suffix) > longest {
+			found, longest = op, len(suffix)
+		}
+	}
+	if longest < 0 {
+		return OpInfo{}, false
+	}
+
+	mapKey, err := found.mapKey()
+	if err != nil {
+		panic(err)
+	}
+	return OpInfo{Name: found.Name, MapKey: mapKey}, true
+}
+
+func (op OperationOverride) mapKey() (types.BasicKind, error) {
+	if op.MapKey == "" {
+		return 0, nil
+	}
+	t, ok := types.Universe.Lookup(op.MapKey).(*types.TypeName)
+	if !ok {
+		return 0, fmt.Errorf("invalid map key %s", op.MapKey)
+	}
+	b, ok := t.Type().(*types.Basic)
+	if !ok {
+		return 0, fmt.Errorf("invalid map key %s", op.MapKey)
+	}
+	return b.Kind(), nil
+}
+
+func contains(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
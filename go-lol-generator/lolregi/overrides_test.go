@@ -0,0 +1,123 @@
+package lolregi
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+const testOverrides = `
+classes:
+  champion:
+    ChampionListDto: ChampionInfoList
+fields:
+  ChampionDto:
+    active: "-"
+    freeToPlay: free
+idTypes:
+  ChampionDto:
+    id: int64 # keep
+operations:
+  champion:
+    /champion: {name: AllChampions}
+`
+
+func writeTestFile(t *testing.T, name, data string) string {
+	dir, err := ioutil.TempDir("", "lolregi")
+	if err != nil {
+		t.Fatal(err)
+	}
+	filename := filepath.Join(dir, name)
+	if err := ioutil.WriteFile(filename, []byte(data), 0644); err != nil {
+		t.Fatal(err)
+	}
+	return filename
+}
+
+func TestOverrides(t *testing.T) {
+	filename := writeTestFile(t, "overrides.yaml", testOverrides)
+	defer os.RemoveAll(filepath.Dir(filename))
+
+	o, err := LoadOverridesFile(filename)
+	if err != nil {
+		t.Fatal(err)
+		return
+	}
+
+	reg := New(Config{Overrides: DefaultOverrides().Merge(o)})
+	if err := reg.LoadOpenAPI(strings.NewReader(testSwagger)); err != nil {
+		t.Fatal(err)
+		return
+	}
+
+	op := reg.Resources[0].Endpoints[0].Operations[0]
+	if op.Name != "AllChampions" || op.ReturnValue.String() != "*ChampionInfoList" {
+		t.Fatalf("Invalid operation %s returning %s", op.Name, op.ReturnValue)
+		return
+	}
+
+	cls := reg.Classes["Champion"]
+	if f := cls.FieldByRawName("active"); f != nil {
+		t.Fatalf("Expected active to be skipped, got %v", f)
+		return
+	}
+	if f := cls.FieldByRawName("freeToPlay"); f == nil || f.Name() != "Free" {
+		t.Fatalf("Invalid field freeToPlay: %v", f)
+		return
+	}
+	if typ := cls.FieldByRawName("id").Type().String(); typ != "int64" {
+		t.Fatalf("Expected int64 for Champion.id, got %s", typ)
+	}
+}
+
+func TestOverridesInvalidMapKey(t *testing.T) {
+	filename := writeTestFile(t, "overrides.json",
+		`{"operations": {"summoner": {"/summoner/{summonerIds}": {"name": "Summoners", "mapKey": "Summoner"}}}}`)
+	defer os.RemoveAll(filepath.Dir(filename))
+
+	if _, err := LoadOverridesFile(filename); err == nil {
+		t.Fatal("Expected error for invalid map key")
+	}
+}
+
+func TestOptionalFields(t *testing.T) {
+	o := &Overrides{OptionalFields: map[string][]string{AnyClass: {"active"}}}
+	reg := New(Config{Overrides: DefaultOverrides().Merge(o), OptionalFields: true})
+	if err := reg.LoadOpenAPI(strings.NewReader(testSwagger)); err != nil {
+		t.Fatal(err)
+		return
+	}
+
+	cls := reg.Classes["Champion"]
+	for _, name := range []string{"freeToPlay", "active"} {
+		if !reg.IsOptional(cls, cls.FieldByRawName(name)) {
+			t.Errorf("Expected %s to be optional", name)
+		}
+	}
+	if reg.IsOptional(cls, cls.FieldByRawName("id")) {
+		t.Error("Expected id to be required")
+	}
+	if list := reg.Classes["ChampionList"]; reg.IsOptional(list, list.FieldByRawName("champions")) {
+		t.Error("Expected slices not to be optional")
+	}
+
+	reg.Config.OptionalFields = false
+	if reg.IsOptional(cls, cls.FieldByRawName("freeToPlay")) {
+		t.Error("Expected no optional fields without Config.OptionalFields")
+	}
+
+	typ := cls.FieldByRawName("id").Type()
+	for desc, expected := range map[string]bool{
+		"The item ID of the event. Only present if relevant.":                                      true,
+		"Only present when full league is requested so that participant's entry can be identified": true,
+		"Not present when individual entry is requested":                                           true,
+		"A number of optional parameters are provided for filtering":                               false,
+		"Non-optional summoner level":                                                              false,
+	} {
+		if f := NewField(reg.Pkg, "itemId", "ItemID", typ, desc); f.DocumentedOptional() != expected {
+			t.Errorf("Expected %v for %q", expected, desc)
+		}
+	}
+}
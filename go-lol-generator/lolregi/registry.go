@@ -11,13 +11,11 @@ import (
 
 	"github.com/PuerkitoBio/goquery"
 	log "github.com/Sirupsen/logrus"
-	"github.com/jerrodrurik/go-lol/uritemplates"
+	"github.com/go-lol/lol/uritemplates"
 )
 
-// go-lol pakcage path.
-const lolPackagePath = "github.com/jerrodrurik/go-lol"
-
-var lolPkg = types.NewPackage(lolPackagePath, "lol")
+// DefaultPackagePath is the import path of go-lol package.
+const DefaultPackagePath = "github.com/go-lol/lol"
 
 //
 //
@@ -35,8 +33,16 @@ type Registry struct {
 
 // New creates a new registry.
 func New(conf Config) *Registry {
+	return newRegistry(conf, allRegions)
+}
+
+func newRegistry(conf Config, regions Regions) *Registry {
+	if conf.Overrides == nil {
+		conf.Overrides = DefaultOverrides()
+	}
 	if conf.Package == nil {
-		conf.Package = lolPkg
+		// Each registry needs its own scope, as classes are inserted into it.
+		conf.Package = types.NewPackage(DefaultPackagePath, "lol")
 	}
 
 	reg := &Registry{
@@ -44,17 +50,24 @@ func New(conf Config) *Registry {
 		Config: conf,
 
 		importer:  importer.Default(),
-		Regions:   allRegions,
+		Regions:   regions,
 		Resources: make([]*Resource, 0),
 		Classes:   make(map[string]*ResponseClass, 0),
 	}
 	reg.initRegions()
 
 	{
-		it := types.NewInterface(nil, nil)
-		srn := types.NewTypeName(token.NoPos, lolPkg, "SpellRange", it)
+		// SpellRange is handwritten. See util.go
+		srn := types.NewTypeName(token.NoPos, reg.Pkg, "SpellRange", nil)
+		types.NewNamed(srn, types.NewStruct(nil, nil), nil)
 		reg.Pkg.Scope().Insert(srn)
 	}
+	// Time types are handwritten. See util.go
+	for _, name := range []string{"EpochMillis", "Millis", "Seconds"} {
+		tn := types.NewTypeName(token.NoPos, reg.Pkg, name, nil)
+		types.NewNamed(tn, types.Typ[types.Int64], nil)
+		reg.Pkg.Scope().Insert(tn)
+	}
 	return reg
 }
 
@@ -65,8 +78,23 @@ func NewDefault() *Registry {
 }
 
 func (reg *Registry) InitDocument() {
-	doc := NewDocument()
+	reg.LoadDocument(NewDocument())
+}
+
+// NewFromFile creates a new registry from a saved api reference page.
+func NewFromFile(conf Config, filename string) (*Registry, error) {
+	doc, err := NewDocumentFromFile(filename)
+	if err != nil {
+		return nil, err
+	}
+
+	reg := New(conf)
+	reg.LoadDocument(doc)
+	return reg, nil
+}
 
+// LoadDocument registers all resources in the document.
+func (reg *Registry) LoadDocument(doc *goquery.Document) {
 	sels := doc.Find(".resource")
 	ids := reg.sortResourceIDs(sels)
 	log.Infoln("Resources:", ids)
@@ -178,8 +206,11 @@ func (reg *Registry) newOperation(endpoint *Endpoint, i int, s *goquery.Selectio
 	op.Desc = strings.TrimSpace(s.Find(`.heading > .options > li`).Text())
 	op.Desc = strings.TrimSpace(strings.TrimSuffix(op.Desc, `(REST)`))
 
-	info := op.Info()
-	op.Name = op.Info().Name
+	info, ok := op.lookupInfo()
+	if !ok && !reg.Config.AllowUnknownOperations {
+		panic(`Unknown operation: ` + op.DocURL())
+	}
+	op.Name, op.MapKey = info.Name, info.MapKey
 
 	s.ChildrenFiltered(`.heading`).Remove() // useless
 
@@ -456,6 +487,10 @@ func (reg *Registry) parseType(resID, s string) (types.Type, error) {
 		return types.NewMap(k, v), nil
 	}
 
+	if s == "SpellRange" { // handwritten
+		return types.NewPointer(reg.Pkg.Scope().Lookup(s).Type()), nil
+	}
+
 	clsName := reg.className(resID, s)
 	if typ := reg.Classes[clsName]; typ != nil {
 		return types.NewPointer(typ), nil
@@ -60,7 +60,7 @@ func TestParseResponseField(t *testing.T) {
 
 		t.Logf("Expected name=%s, rawType=%s, desc=%s", d.Name, d.RawType, d.Desc)
 
-		name, rawType, desc := parseField(doc.Find("tr"))
+		name, _, rawType, desc := New(Config{}).parseField("match", "MatchDetail", doc.Find("tr"))
 		if name != d.Name || rawType != d.RawType || desc != d.Desc {
 			t.Fatalf("Invalid name=%s, rawType=%s, desc=%s", name, rawType, desc)
 		}
@@ -140,3 +140,47 @@ func TestParseResponseErrors(t *testing.T) {
 		}
 	}
 }
+
+func TestRateLimitExempt(t *testing.T) {
+	for notes, exempt := range map[string]bool{
+		"Requests to this API will not be counted in your Rate Limit.": true,
+		"Requests to this API do not count against your rate limit.":   true,
+		"": false,
+		"Requests to this API are limited to 10 per second.": false,
+	} {
+		op := &Operation{RateLimitNotes: notes}
+		if op.RateLimitExempt() != exempt {
+			t.Errorf("Expected %v for %q", exempt, notes)
+		}
+	}
+}
+
+func TestTimeTypes(t *testing.T) {
+	reg := New(Config{})
+	types := map[string]string{
+		"startTime": reg.fieldType("match", "MatchDetail", "matchCreation", "long", "Match creation time.").String(),
+		"duration":  reg.fieldType("match", "MatchDetail", "matchDuration", "long", "Match duration").String(),
+		"timestamp": reg.fieldType("match", "Frame", "timestamp", "long", "Represents how many milliseconds into the game the frame occurred.").String(),
+		"date":      reg.fieldType("game", "GameDto", "createDate", "long", "Date that end game data was recorded, specified as epoch milliseconds.").String(),
+		"played":    reg.fieldType("game", "RawStatsDto", "timePlayed", "int", "").String(),
+		"name":      reg.fieldType("summoner", "SummonerDto", "name", "string", "Summoner name in seconds.").String(),
+	}
+	expected := map[string]string{
+		"startTime": "github.com/go-lol/lol.EpochMillis",
+		"duration":  "github.com/go-lol/lol.Seconds",
+		"timestamp": "github.com/go-lol/lol.Millis",
+		"date":      "github.com/go-lol/lol.EpochMillis",
+		"played":    "github.com/go-lol/lol.Seconds",
+		"name":      "string",
+	}
+	for k, typ := range types {
+		if typ != expected[k] {
+			t.Errorf("Expected %s for %s, got %s", expected[k], k, typ)
+		}
+	}
+
+	reg = New(Config{DontUseTimeTypes: true})
+	if typ := reg.fieldType("match", "MatchDetail", "matchDuration", "long", "Match duration"); typ.String() != "int64" {
+		t.Errorf("Expected int64 without time types, got %s", typ)
+	}
+}
@@ -73,7 +73,36 @@ func NewDocument() *goquery.Document {
 		panic(err)
 	}
 
-	doc := goquery.NewDocumentFromNode(pageDoc.Find(`#resources`).Nodes[0])
+	doc, err := cleanDocument(pageDoc)
+	if err != nil {
+		panic(err)
+	}
+	return doc
+}
+
+// NewDocumentFromFile is like NewDocument, but it reads a saved copy of
+// the api reference page instead of fetching it.
+func NewDocumentFromFile(filename string) (*goquery.Document, error) {
+	f, err := os.Open(filename)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	pageDoc, err := goquery.NewDocumentFromReader(f)
+	if err != nil {
+		return nil, err
+	}
+	return cleanDocument(pageDoc)
+}
+
+func cleanDocument(pageDoc *goquery.Document) (*goquery.Document, error) {
+	resources := pageDoc.Find(`#resources`)
+	if len(resources.Nodes) == 0 {
+		return nil, fmt.Errorf("element #resources not found")
+	}
+
+	doc := goquery.NewDocumentFromNode(resources.Nodes[0])
 	doc.Find(`table`).RemoveClass(`table`)
 
 	doc.Find(`.response, .sandbox_header`).Remove()
@@ -87,7 +116,7 @@ func NewDocument() *goquery.Document {
 		}
 	}
 
-	return doc
+	return doc, nil
 }
 
 func isUselessNode(n *html.Node, s *goquery.Selection) bool {
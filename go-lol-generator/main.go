@@ -1,10 +1,20 @@
 package main
 
 import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
 	"go/format"
+	"go/types"
 	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
 
 	log "github.com/Sirupsen/logrus"
+	"github.com/go-lol/lol/go-lol-generator/loldesc"
 	"github.com/go-lol/lol/go-lol-generator/lolgen"
 	"github.com/go-lol/lol/go-lol-generator/lolregi"
 )
@@ -14,30 +24,392 @@ func init() {
 	log.SetLevel(log.InfoLevel)
 }
 
-const targetFile = "api.gen.go"
+const (
+	targetFile  = "api.gen.go"
+	openAPIFile = "openapi.json"
+
+	generatedHeader = "// Generated by go-lol-generator. DO NOT EDIT."
+)
+
+var (
+	descFile     = flag.String("desc", "", "generate from a document (see loldesc) instead of the api reference page")
+	openAPIFiles = flag.String("openapi", "", "comma-separated OpenAPI 2/3 json files to merge into the registry")
+	outFormat    = flag.String("format", "go", `output format. "go" writes `+targetFile+`, "openapi" writes `+openAPIFile)
+	overrides    = flag.String("overrides", "", "yaml/json file with class, field and operation overrides (see lolregi.Overrides)")
+	optional     = flag.Bool("optional", false, `generate fields documented as optional (e.g. "Only present if relevant") or declared in overrides as pointers, with Get<Field>() (value, ok) accessors`)
+
+	out       = flag.String("out", "", "output directory, or output file with -split=false (default ., "+targetFile+" or "+openAPIFile+")")
+	pkgPath   = flag.String("pkg", lolregi.DefaultPackagePath, "import path of the generated package")
+	resources = flag.String("resources", "", `comma-separated resources to generate, e.g. "match,summoner". Prefix "-" to exclude one, e.g. "-tournament-provider"`)
+	split     = flag.Bool("split", true, "write one file per resource and "+lolgen.TypesFile+". If false, write "+targetFile)
+	tests     = flag.Bool("tests", true, "write tests and examples (*.gen_test.go) for each resource")
+	verbose   = flag.Bool("v", false, "print resources and operations")
+	check     = flag.Bool("check", false, "exit with status 1 if generated files are out of date, instead of writing them")
+)
 
 func main() {
-	reg := lolregi.NewDefault()
-	reg.PrintDebugInfo()
+	if len(os.Args) > 1 {
+		switch os.Args[1] {
+		case "diff":
+			runDiff(os.Args[2:])
+			return
+		case "export":
+			runExport(os.Args[2:])
+			return
+		}
+	}
+	flag.Parse()
 
-	g := lolgen.New(reg)
-	src, err := formatFile(targetFile, g.Generate())
+	conf := newConfig(*overrides)
+	conf.Package = types.NewPackage(*pkgPath, "lol")
+	conf.OptionalFields = *optional
+
+	var reg *lolregi.Registry
+	switch {
+	case *descFile != "":
+		doc, err := loldesc.ReadFile(*descFile)
+		if err != nil {
+			log.Fatalf("Failed to read %s. %v", *descFile, err)
+			return
+		}
+		if reg, err = lolregi.NewFromDocument(conf, doc); err != nil {
+			log.Fatalf("Invalid document %s. %v", *descFile, err)
+			return
+		}
+	case *openAPIFiles != "":
+		reg = lolregi.New(conf)
+	default:
+		reg = lolregi.New(conf)
+		reg.InitDocument()
+	}
+
+	if *openAPIFiles != "" {
+		for _, filename := range strings.Split(*openAPIFiles, ",") {
+			if err := reg.LoadOpenAPIFile(strings.TrimSpace(filename)); err != nil {
+				log.Fatalf("Failed to load OpenAPI document. %v", err)
+				return
+			}
+		}
+	}
+
+	if *resources != "" {
+		var include, exclude []string
+		for _, id := range strings.Split(*resources, ",") {
+			id = strings.TrimSpace(id)
+			if strings.HasPrefix(id, "-") {
+				exclude = append(exclude, id[1:])
+			} else {
+				include = append(include, id)
+			}
+		}
+		reg.FilterResources(include, exclude)
+	}
+
+	if *verbose {
+		reg.PrintDebugInfo()
+	}
+
+	files, err := generate(lolgen.New(reg))
 	if err != nil {
-		log.Fatalf("Failed to format generated file. %v", err)
+		log.Fatalf("Failed to generate. %v", err)
 		return
 	}
 
-	if err := ioutil.WriteFile(targetFile, src, 0644); err != nil {
-		log.Fatalf("Failed to write to %s\nError: %v", targetFile, err)
+	removed := removedFiles(files)
+
+	if *check {
+		stale := staleFiles(files)
+		for _, filename := range stale {
+			fmt.Fprintf(os.Stderr, "%s is out of date\n", filename)
+		}
+		for _, filename := range removed {
+			fmt.Fprintf(os.Stderr, "%s should be removed\n", filename)
+		}
+		if len(stale) != 0 || len(removed) != 0 {
+			os.Exit(1)
+		}
 		return
 	}
+
+	for filename, src := range files {
+		if err := ioutil.WriteFile(filename, src, 0644); err != nil {
+			log.Fatalf("Failed to write to %s\nError: %v", filename, err)
+			return
+		}
+	}
+	for _, filename := range removed {
+		if err := os.Remove(filename); err != nil {
+			log.Fatalf("Failed to remove %s\nError: %v", filename, err)
+			return
+		}
+	}
+}
+
+// generate returns generated files by path.
+func generate(g *lolgen.Generator) (map[string][]byte, error) {
+	switch *outFormat {
+	case "go":
+	case "openapi":
+		src, err := g.GenerateOpenAPI()
+		if err != nil {
+			return nil, err
+		}
+		return map[string][]byte{outPath(openAPIFile): src}, nil
+	default:
+		return nil, fmt.Errorf("unknown format %s", *outFormat)
+	}
+
+	files := make(map[string][]byte)
+	if *split {
+		for name, src := range g.GenerateFiles() {
+			var err error
+			if files[filepath.Join(*out, name)], err = formatFile(name, src); err != nil {
+				return nil, err
+			}
+		}
+	} else {
+		src, err := formatFile(targetFile, g.Generate())
+		if err != nil {
+			return nil, err
+		}
+		files[outPath(targetFile)] = src
+	}
+
+	if *tests {
+		dir := *out
+		if !*split {
+			dir = filepath.Dir(outPath(targetFile))
+		}
+		for name, src := range g.GenerateTestFiles() {
+			var err error
+			if files[filepath.Join(dir, name)], err = formatFile(name, src); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return files, nil
+}
+
+func outPath(defaultName string) string {
+	if *out == "" {
+		return defaultName
+	}
+	return *out
+}
+
+// removedFiles returns previously generated go files in the output directory,
+// which are not generated anymore. (e.g. api.gen.go after splitting)
+// Nothing is removed with -resources, as files of other resources are not generated.
+func removedFiles(files map[string][]byte) []string {
+	if *outFormat != "go" || !*split || *resources != "" {
+		return nil
+	}
+
+	dir := *out
+	if dir == "" {
+		dir = "."
+	}
+	var matches []string
+	for _, pattern := range []string{"*.gen.go", "*.gen_test.go"} {
+		m, err := filepath.Glob(filepath.Join(dir, pattern))
+		if err != nil {
+			log.Fatalf("Failed to list generated files. %v", err)
+		}
+		matches = append(matches, m...)
+	}
+
+	var removed []string
+	for _, filename := range matches {
+		if _, ok := files[filename]; ok {
+			continue
+		}
+		data, err := ioutil.ReadFile(filename)
+		if err == nil && bytes.HasPrefix(data, []byte(generatedHeader)) {
+			removed = append(removed, filename)
+		}
+	}
+	return removed
+}
+
+// staleFiles returns files which differ from files on disk.
+func staleFiles(files map[string][]byte) []string {
+	var stale []string
+	for filename, src := range files {
+		data, err := ioutil.ReadFile(filename)
+		if err != nil || !bytes.Equal(data, src) {
+			stale = append(stale, filename)
+		}
+	}
+	sort.Strings(stale)
+	return stale
+}
+
+// newConfig returns a config with default overrides,
+// merged with overrides in the file if filename is not empty.
+func newConfig(filename string) lolregi.Config {
+	if filename == "" {
+		return lolregi.Config{}
+	}
+
+	o, err := lolregi.LoadOverridesFile(filename)
+	if err != nil {
+		log.Fatalf("Failed to load overrides. %v", err)
+	}
+	return lolregi.Config{Overrides: lolregi.DefaultOverrides().Merge(o)}
 }
 
 func formatFile(filename string, src []byte) ([]byte, error) {
 	data, err := format.Source(src)
 	if err != nil {
-		return nil, err
+		return nil, fmt.Errorf("%s: %v", filename, err)
 	}
 
 	return data, nil
 }
+
+const diffUsage = `Usage: go-lol-generator diff [-json] [-overrides file] old.html new.html
+
+Compares two saved copies of https://developer.riotgames.com/api/methods
+and reports added/removed resources, version bumps, and changed operations,
+parameters, response classes, field types and legal values.
+`
+
+func runDiff(args []string) {
+	fs := flag.NewFlagSet("diff", flag.ExitOnError)
+	asJSON := fs.Bool("json", false, "print changes as json")
+	overrides := fs.String("overrides", "", "yaml/json overrides file")
+	fs.Usage = func() {
+		fmt.Fprint(os.Stderr, diffUsage)
+		fs.PrintDefaults()
+	}
+	fs.Parse(args)
+
+	if fs.NArg() != 2 {
+		fs.Usage()
+		os.Exit(2)
+	}
+
+	conf := newConfig(*overrides)
+	conf.AllowUnknownOperations = true
+
+	old, err := lolregi.NewFromFile(conf, fs.Arg(0))
+	if err != nil {
+		log.Fatalf("Failed to load %s. %v", fs.Arg(0), err)
+		return
+	}
+	new, err := lolregi.NewFromFile(conf, fs.Arg(1))
+	if err != nil {
+		log.Fatalf("Failed to load %s. %v", fs.Arg(1), err)
+		return
+	}
+
+	changes := lolregi.Diff(old, new)
+
+	if *asJSON {
+		if changes == nil {
+			changes = lolregi.Changes{}
+		}
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		if err := enc.Encode(changes); err != nil {
+			log.Fatalf("Failed to encode changes. %v", err)
+		}
+		return
+	}
+
+	fmt.Print(changes)
+}
+
+// keepVersions adds versions of resources in old document,
+// which are not the current version in doc.
+func keepVersions(doc, old *loldesc.Document, ids []string) (*loldesc.Document, error) {
+	b := loldesc.NewBuilder().AddDocument(doc)
+	for _, id := range ids {
+		id = strings.TrimSpace(id)
+		current := doc.Resource(id)
+		if current == nil {
+			return nil, fmt.Errorf("unknown resource %s", id)
+		}
+
+		for i, r := range old.ResourceVersions(id) {
+			if r.Version == current.Version {
+				continue
+			}
+			log.Infof("Keeping %s %s", id, r.Version)
+
+			classes := old.ClassesOf(r)
+			if i == 0 { // Previous current version.
+				b.AddOldVersion(r, classes)
+				continue
+			}
+			b.AddResource(r)
+			for _, c := range classes {
+				b.AddClass(c)
+			}
+		}
+	}
+	return b.Document()
+}
+
+const exportUsage = `Usage: go-lol-generator export [-o lol.json] [-overrides file] [-keep ids] [reference.html]
+
+Writes a document (see loldesc) describing the api.
+If a saved copy of https://developer.riotgames.com/api/methods is not given,
+the page is fetched.
+
+With -keep, previous versions of the resources are kept in the document,
+and generated side by side with the current version, like "MatchV2_2".
+`
+
+func runExport(args []string) {
+	fs := flag.NewFlagSet("export", flag.ExitOnError)
+	out := fs.String("o", "lol.json", "output file")
+	keep := fs.String("keep", "", "comma-separated resources whose versions in the existing output file are kept side by side, when the version changed")
+	overrides := fs.String("overrides", "", "yaml/json overrides file")
+	fs.Usage = func() {
+		fmt.Fprint(os.Stderr, exportUsage)
+		fs.PrintDefaults()
+	}
+	fs.Parse(args)
+
+	conf := newConfig(*overrides)
+
+	var reg *lolregi.Registry
+	switch fs.NArg() {
+	case 0:
+		reg = lolregi.New(conf)
+		reg.InitDocument()
+	case 1:
+		var err error
+		if reg, err = lolregi.NewFromFile(conf, fs.Arg(0)); err != nil {
+			log.Fatalf("Failed to load %s. %v", fs.Arg(0), err)
+			return
+		}
+	default:
+		fs.Usage()
+		os.Exit(2)
+	}
+
+	doc, err := reg.Document()
+	if err != nil {
+		log.Fatalf("Failed to export registry. %v", err)
+		return
+	}
+
+	if *keep != "" {
+		old, err := loldesc.ReadFile(*out)
+		if err != nil {
+			log.Fatalf("Failed to read %s. %v", *out, err)
+			return
+		}
+		if doc, err = keepVersions(doc, old, strings.Split(*keep, ",")); err != nil {
+			log.Fatalf("Failed to keep old versions. %v", err)
+			return
+		}
+	}
+
+	if err := doc.WriteFile(*out); err != nil {
+		log.Fatalf("Failed to write to %s\nError: %v", *out, err)
+		return
+	}
+}
@@ -0,0 +1,101 @@
+package heatmap
+
+import (
+	"fmt"
+	"image"
+	"image/color"
+	"image/png"
+	"io"
+
+	"github.com/go-lol/lol"
+)
+
+// Grid is counts of positions in cells.
+// Row 0 is the top of the map. (the largest Y)
+type Grid struct {
+	Bounds     Bounds
+	Cols, Rows int
+	// Counts[row*Cols+col]
+	Counts []int
+	Max    int
+}
+
+// NewGrid returns an empty grid.
+// It returns an error if cols or rows is not positive, or if b is empty in either axis.
+func NewGrid(b Bounds, cols, rows int) (*Grid, error) {
+	if cols <= 0 || rows <= 0 {
+		return nil, fmt.Errorf("heatmap: invalid grid size %dx%d", cols, rows)
+	}
+	if b.MaxX <= b.MinX || b.MaxY <= b.MinY {
+		return nil, fmt.Errorf("heatmap: empty bounds %+v", b)
+	}
+	return &Grid{Bounds: b, Cols: cols, Rows: rows, Counts: make([]int, cols*rows)}, nil
+}
+
+// Add counts positions. Positions out of bounds are ignored.
+func (g *Grid) Add(positions ...lol.Position) {
+	b := g.Bounds
+	w, h := int(b.MaxX-b.MinX), int(b.MaxY-b.MinY)
+	for _, p := range positions {
+		if p.X < b.MinX || p.X > b.MaxX || p.Y < b.MinY || p.Y > b.MaxY {
+			continue
+		}
+		col := int(p.X-b.MinX) * g.Cols / (w + 1)
+		row := g.Rows - 1 - int(p.Y-b.MinY)*g.Rows/(h+1)
+
+		i := row*g.Cols + col
+		g.Counts[i]++
+		if g.Counts[i] > g.Max {
+			g.Max = g.Counts[i]
+		}
+	}
+}
+
+// At returns count of a cell.
+func (g *Grid) At(col, row int) int { return g.Counts[row*g.Cols+col] }
+
+// Image renders the grid as a heatmap of width x height,
+// to be drawn over a map image of the same size.
+// Empty cells are transparent, and cells are colored blue to red by count.
+func (g *Grid) Image(width, height int) *image.NRGBA {
+	img := image.NewNRGBA(image.Rect(0, 0, width, height))
+	if g.Max == 0 {
+		return img
+	}
+	for y := 0; y < height; y++ {
+		row := y * g.Rows / height
+		for x := 0; x < width; x++ {
+			col := x * g.Cols / width
+			if n := g.At(col, row); n != 0 {
+				img.SetNRGBA(x, y, heat(float64(n)/float64(g.Max)))
+			}
+		}
+	}
+	return img
+}
+
+// EncodePNG writes the heatmap image as PNG. See Image
+func (g *Grid) EncodePNG(w io.Writer, width, height int) error {
+	return png.Encode(w, g.Image(width, height))
+}
+
+// heat returns a color of v in [0, 1], from translucent blue to opaque red.
+func heat(v float64) color.NRGBA {
+	// blue -> cyan -> green -> yellow -> red
+	stops := [][3]float64{{0, 0, 255}, {0, 255, 255}, {0, 255, 0}, {255, 255, 0}, {255, 0, 0}}
+
+	pos := v * float64(len(stops)-1)
+	i := int(pos)
+	if i >= len(stops)-1 {
+		i = len(stops) - 2
+	}
+	t := pos - float64(i)
+	a, b := stops[i], stops[i+1]
+	lerp := func(k int) uint8 { return uint8(a[k] + (b[k]-a[k])*t) }
+	return color.NRGBA{
+		R: lerp(0),
+		G: lerp(1),
+		B: lerp(2),
+		A: uint8(96 + 159*v),
+	}
+}
@@ -0,0 +1,125 @@
+// Package heatmap aggregates positions of match events into a grid,
+// and renders it as an image.
+package heatmap
+
+import (
+	"errors"
+
+	"github.com/go-lol/lol"
+	"github.com/go-lol/lol/timeline"
+)
+
+// Kind is a kind of positions.
+type Kind int
+
+const (
+	// Kill is a position of a champion kill, by the killer.
+	Kill Kind = iota
+	// Death is a position of a champion kill, by the victim.
+	Death
+	// Ward is a position of a placed ward.
+	// As WARD_PLACED events have no position, the position of the creator
+	// at the start of the frame is used.
+	Ward
+	// Objective is a position of a building or an elite monster killed.
+	Objective
+)
+
+// ErrUnknownMap is returned by Collector.Grid if the map is not in MapBounds.
+var ErrUnknownMap = errors.New("heatmap: unknown map")
+
+// Bounds is a range of map coordinates.
+type Bounds struct {
+	MinX, MinY, MaxX, MaxY int32
+}
+
+// MapBounds are bounds of maps by map id.
+var MapBounds = map[int32]Bounds{
+	1:  {-650, -83, 14076, 14522},  // Summoner's Rift (Original)
+	10: {0, 0, 15398, 15192},       // Twisted Treeline
+	11: {-120, -120, 14870, 14980}, // Summoner's Rift
+	12: {-28, -19, 12849, 12858},   // Howling Abyss
+}
+
+// Collector collects positions of events in many matches, by map id.
+type Collector struct {
+	// Filter selects participants whose events are collected.
+	// (killer of Kill and Objective, victim of Death, creator of Ward)
+	// All events are collected if nil.
+	Filter func(m *lol.MatchDetail, participantID int32) bool
+
+	positions map[int32]map[Kind][]lol.Position
+}
+
+// NewCollector returns an empty collector.
+func NewCollector() *Collector {
+	return &Collector{positions: make(map[int32]map[Kind][]lol.Position)}
+}
+
+// Add collects positions of the match.
+// It returns timeline.ErrNoTimeline if the match has no timeline.
+func (c *Collector) Add(m *lol.MatchDetail) error {
+	if m.Timeline == nil {
+		return timeline.ErrNoTimeline
+	}
+
+	add := func(kind Kind, participantID int32, pos *lol.Position) {
+		if pos == nil || (c.Filter != nil && !c.Filter(m, participantID)) {
+			return
+		}
+		byKind := c.positions[m.MapID]
+		if byKind == nil {
+			byKind = make(map[Kind][]lol.Position)
+			c.positions[m.MapID] = byKind
+		}
+		byKind[kind] = append(byKind[kind], *pos)
+	}
+
+	for _, f := range m.Timeline.Frames {
+		for _, raw := range f.Events {
+			switch e := timeline.Parse(raw).(type) {
+			case *timeline.ChampionKill:
+				add(Kill, e.KillerID, e.Position)
+				add(Death, e.VictimID, e.Position)
+			case *timeline.BuildingKill:
+				add(Objective, e.KillerID, e.Position)
+			case *timeline.EliteMonsterKill:
+				add(Objective, e.KillerID, e.Position)
+			case *timeline.WardPlaced:
+				pos := raw.Position
+				for _, pf := range f.ParticipantFrames {
+					if pos == nil && pf.ParticipantID == e.CreatorID {
+						pos = pf.Position
+					}
+				}
+				add(Ward, e.CreatorID, pos)
+			}
+		}
+	}
+	return nil
+}
+
+// Positions returns collected positions of kinds in a map.
+func (c *Collector) Positions(mapID int32, kinds ...Kind) []lol.Position {
+	var positions []lol.Position
+	for _, k := range kinds {
+		positions = append(positions, c.positions[mapID][k]...)
+	}
+	return positions
+}
+
+// Grid returns positions of kinds in a map binned into a grid.
+// Bounds of the map are taken from MapBounds, and it returns ErrUnknownMap for unknown maps.
+// See NewGrid for errors of the grid size.
+func (c *Collector) Grid(mapID int32, cols, rows int, kinds ...Kind) (*Grid, error) {
+	b, ok := MapBounds[mapID]
+	if !ok {
+		return nil, ErrUnknownMap
+	}
+	g, err := NewGrid(b, cols, rows)
+	if err != nil {
+		return nil, err
+	}
+	g.Add(c.Positions(mapID, kinds...)...)
+	return g, nil
+}
@@ -0,0 +1,94 @@
+package heatmap
+
+import (
+	"bytes"
+	"image/png"
+	"testing"
+
+	"github.com/go-lol/lol"
+)
+
+func newTestMatch() *lol.MatchDetail {
+	return &lol.MatchDetail{
+		MapID: 11,
+		Timeline: &lol.Timeline{Frames: []*lol.Frame{{
+			ParticipantFrames: map[string]*lol.ParticipantFrame{
+				"1": {ParticipantID: 1, Position: &lol.Position{X: 14000, Y: 14000}},
+			},
+			Events: []*lol.Event{
+				{EventType: "CHAMPION_KILL", KillerID: 1, VictimID: 2, Position: &lol.Position{X: 100, Y: 100}},
+				{EventType: "CHAMPION_KILL", KillerID: 2, VictimID: 1, Position: &lol.Position{X: 200, Y: 200}},
+				{EventType: "ELITE_MONSTER_KILL", KillerID: 1, MonsterType: "DRAGON", Position: &lol.Position{X: 9866, Y: 4414}},
+				{EventType: "WARD_PLACED", CreatorID: 1, WardType: "YELLOW_TRINKET"},
+			},
+		}}},
+	}
+}
+
+func TestCollector(t *testing.T) {
+	c := NewCollector()
+	c.Filter = func(m *lol.MatchDetail, participantID int32) bool { return participantID == 1 }
+	if err := c.Add(newTestMatch()); err != nil {
+		t.Fatal(err)
+		return
+	}
+
+	if kills := c.Positions(11, Kill); len(kills) != 1 || kills[0].X != 100 {
+		t.Errorf("Invalid kills %v", kills)
+	}
+	if deaths := c.Positions(11, Death); len(deaths) != 1 || deaths[0].X != 200 {
+		t.Errorf("Invalid deaths %v", deaths)
+	}
+	if wards := c.Positions(11, Ward); len(wards) != 1 || wards[0].X != 14000 {
+		t.Errorf("Invalid wards %v", wards)
+	}
+
+	g, err := c.Grid(11, 10, 10, Kill, Death, Objective, Ward)
+	if err != nil {
+		t.Fatal(err)
+		return
+	}
+	// Bottom left, bottom right and top right.
+	if g.At(0, 9) != 2 || g.At(6, 6) != 1 || g.At(9, 0) != 1 || g.Max != 2 {
+		t.Errorf("Invalid grid %v", g.Counts)
+	}
+	if _, err := c.Grid(99, 10, 10, Kill); err != ErrUnknownMap {
+		t.Errorf("Expected ErrUnknownMap, got %v", err)
+	}
+	if _, err := c.Grid(11, 0, 10, Kill); err == nil {
+		t.Error("Expected error for invalid grid size")
+	}
+
+	var buf bytes.Buffer
+	if err := g.EncodePNG(&buf, 512, 512); err != nil {
+		t.Fatal(err)
+		return
+	}
+	img, err := png.Decode(&buf)
+	if err != nil {
+		t.Fatal(err)
+		return
+	}
+	if _, _, _, a := img.At(10, 500).RGBA(); a != 0xffff {
+		t.Errorf("Expected opaque bottom left, got alpha %d", a)
+	}
+	if _, _, _, a := img.At(250, 10).RGBA(); a != 0 {
+		t.Errorf("Expected transparent top, got alpha %d", a)
+	}
+}
+
+func TestNewGridErrors(t *testing.T) {
+	for _, tc := range []struct {
+		b          Bounds
+		cols, rows int
+	}{
+		{MapBounds[11], 0, 0},
+		{MapBounds[11], 10, -1},
+		{Bounds{0, 0, 0, 100}, 10, 10},
+		{Bounds{0, 100, 100, 0}, 10, 10},
+	} {
+		if _, err := NewGrid(tc.b, tc.cols, tc.rows); err == nil {
+			t.Errorf("Expected error for %+v %dx%d", tc.b, tc.cols, tc.rows)
+		}
+	}
+}
@@ -0,0 +1,95 @@
+package ladder
+
+import (
+	"testing"
+	"time"
+
+	"github.com/go-lol/lol"
+	"golang.org/x/net/context"
+)
+
+func newTestLeague(tier string, entries ...*lol.LeagueEntry) *lol.League {
+	return &lol.League{Name: "Test", Queue: "RANKED_SOLO_5x5", Tier: tier, Entries: entries}
+}
+
+func TestDiff(t *testing.T) {
+	a, err := NewSnapshot(time.Time{},
+		newTestLeague("GOLD",
+			&lol.LeagueEntry{PlayerOrTeamID: "1", Division: "II", LeaguePoints: 80},
+			&lol.LeagueEntry{PlayerOrTeamID: "2", Division: "I", LeaguePoints: 10},
+			&lol.LeagueEntry{PlayerOrTeamID: "3", Division: "III", LeaguePoints: 50},
+			&lol.LeagueEntry{PlayerOrTeamID: "4", Division: "V", LeaguePoints: 0},
+			&lol.LeagueEntry{PlayerOrTeamID: "6", Division: "IV", LeaguePoints: 100, MiniSeries: &lol.MiniSeries{Wins: 1, Target: 2, Progress: "WNN"}},
+		))
+	if err != nil {
+		t.Fatal(err)
+		return
+	}
+	b, _ := NewSnapshot(time.Time{},
+		newTestLeague("GOLD",
+			&lol.LeagueEntry{PlayerOrTeamID: "1", Division: "I", LeaguePoints: 0},
+			&lol.LeagueEntry{PlayerOrTeamID: "3", Division: "III", LeaguePoints: 70, IsHotStreak: true},
+			&lol.LeagueEntry{PlayerOrTeamID: "4", Division: "V", LeaguePoints: 0},
+			&lol.LeagueEntry{PlayerOrTeamID: "5", Division: "IV", LeaguePoints: 0, IsFreshBlood: true},
+			&lol.LeagueEntry{PlayerOrTeamID: "6", Division: "IV", LeaguePoints: 100, MiniSeries: &lol.MiniSeries{Wins: 1, Losses: 1, Target: 2, Progress: "WLN"}},
+		),
+		newTestLeague("SILVER", &lol.LeagueEntry{PlayerOrTeamID: "2", Division: "I", LeaguePoints: 75}),
+	)
+
+	expected := []struct {
+		id      string
+		t       ChangeType
+		lpDelta int64
+	}{
+		{"1", Promoted, 0},
+		{"2", Demoted, 0},
+		{"3", LPChanged, 20},
+		{"3", HotStreakChanged, 20},
+		{"5", Added, 0},
+		{"6", SeriesChanged, 0},
+	}
+	changes := Diff(a, b)
+	if len(changes) != len(expected) {
+		t.Fatalf("Expected %d changes, got %+v", len(expected), changes)
+		return
+	}
+	for i, c := range changes {
+		e := expected[i]
+		if c.Key.PlayerOrTeamID != e.id || c.Type != e.t || c.LPDelta != e.lpDelta {
+			t.Errorf("Expected %+v, got %v %v %v", e, c.Key.PlayerOrTeamID, c.Type, c.LPDelta)
+		}
+	}
+
+	// Sorted by key, 5 is before 6.
+	if changes := Diff(b, a); changes[len(changes)-2].Type != Removed || changes[len(changes)-2].Old == nil {
+		t.Errorf("Expected removed entry, got %+v", changes[len(changes)-2])
+	}
+	if ladder := b.Ladder("RANKED_SOLO_5x5"); len(ladder) != 6 || ladder[0].PlayerOrTeamID != "1" || ladder[5].PlayerOrTeamID != "2" {
+		t.Errorf("Invalid ladder %v", ladder)
+	}
+}
+
+func TestPoller(t *testing.T) {
+	lp := int32(0)
+	p := NewPoller(func(ctx context.Context) ([]*lol.League, error) {
+		lp += 10
+		return []*lol.League{newTestLeague("CHALLENGER", &lol.LeagueEntry{PlayerOrTeamID: "1", LeaguePoints: lp})}, nil
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	ch := make(chan Change)
+	go p.Run(ctx, time.Millisecond, ch)
+
+	for i := 0; i < 2; i++ {
+		select {
+		case c := <-ch:
+			if c.Type != LPChanged || c.LPDelta != 10 {
+				t.Errorf("Invalid change %+v", c)
+			}
+		case <-time.After(time.Second):
+			t.Fatal("Timed out")
+			return
+		}
+	}
+}
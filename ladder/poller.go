@@ -0,0 +1,127 @@
+package ladder
+
+import (
+	"time"
+
+	log "github.com/Sirupsen/logrus"
+	"github.com/go-lol/lol"
+	"golang.org/x/net/context"
+)
+
+// Source fetches leagues to snapshot.
+type Source func(ctx context.Context) ([]*lol.League, error)
+
+// Challenger returns a source of the challenger league of a queue.
+// e.g. "RANKED_SOLO_5x5"
+func Challenger(c *lol.Client, region lol.Region, queue string) Source {
+	return func(ctx context.Context) ([]*lol.League, error) {
+		l, err := c.Challenger(ctx, region).Type(queue).Do()
+		if err != nil {
+			return nil, err
+		}
+		return []*lol.League{l}, nil
+	}
+}
+
+// Master returns a source of the master league of a queue.
+func Master(c *lol.Client, region lol.Region, queue string) Source {
+	return func(ctx context.Context) ([]*lol.League, error) {
+		l, err := c.Master(ctx, region).Type(queue).Do()
+		if err != nil {
+			return nil, err
+		}
+		return []*lol.League{l}, nil
+	}
+}
+
+// BySummonerIDs returns a source of leagues of summoners.
+// The same league is included once even if summoners share it.
+func BySummonerIDs(c *lol.Client, region lol.Region, summonerIDs []int64) Source {
+	return func(ctx context.Context) ([]*lol.League, error) {
+		m, err := c.LeaguesBySummonerID(ctx, region, summonerIDs).Do()
+		if err != nil {
+			return nil, err
+		}
+
+		type key struct{ queue, tier, name string }
+		seen := make(map[key]bool)
+		var leagues []*lol.League
+		for _, ls := range m {
+			for _, l := range ls {
+				k := key{l.Queue, l.Tier, l.Name}
+				if !seen[k] {
+					seen[k] = true
+					leagues = append(leagues, l)
+				}
+			}
+		}
+		return leagues, nil
+	}
+}
+
+// Poller polls sources and diffs snapshots.
+type Poller struct {
+	sources []Source
+
+	last *Snapshot
+}
+
+// NewPoller returns a poller of sources. Leagues of all sources are in a single snapshot.
+func NewPoller(sources ...Source) *Poller {
+	return &Poller{sources: sources}
+}
+
+// Last returns the last snapshot, or nil if not polled yet.
+func (p *Poller) Last() *Snapshot { return p.last }
+
+// Poll takes a snapshot, and returns changes from the last snapshot.
+// The first poll returns no changes. The last snapshot is kept on error.
+func (p *Poller) Poll(ctx context.Context) ([]Change, error) {
+	var leagues []*lol.League
+	for _, source := range p.sources {
+		ls, err := source(ctx)
+		if err != nil {
+			return nil, err
+		}
+		leagues = append(leagues, ls...)
+	}
+
+	s, err := NewSnapshot(time.Now(), leagues...)
+	if err != nil {
+		return nil, err
+	}
+
+	var changes []Change
+	if p.last != nil {
+		changes = Diff(p.last, s)
+	}
+	p.last = s
+	return changes, nil
+}
+
+// Run calls Poll every interval until ctx is done, and sends changes to ch.
+// Errors are logged. ch is not closed.
+func (p *Poller) Run(ctx context.Context, interval time.Duration, ch chan<- Change) {
+	t := time.NewTicker(interval)
+	defer t.Stop()
+
+	for {
+		changes, err := p.Poll(ctx)
+		if err != nil {
+			log.Warnf("Failed to poll leagues. %v", err)
+		}
+		for _, c := range changes {
+			select {
+			case <-ctx.Done():
+				return
+			case ch <- c:
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-t.C:
+		}
+	}
+}
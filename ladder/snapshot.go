@@ -0,0 +1,212 @@
+// Package ladder tracks movement of league ladders, by diffing snapshots
+// of leagues polled at an interval.
+package ladder
+
+import (
+	"sort"
+	"time"
+
+	"github.com/go-lol/lol"
+)
+
+// Key identifies an entry. A player may be in leagues of multiple queues.
+type Key struct {
+	// League.Queue. e.g. "RANKED_SOLO_5x5"
+	Queue string
+	// LeagueEntry.PlayerOrTeamID
+	PlayerOrTeamID string
+}
+
+// Entry is an entry of a league at a snapshot.
+type Entry struct {
+	Key
+	// LeagueEntry.PlayerOrTeamName
+	Name string
+	// League.Name
+	League string
+
+	Rank         lol.Rank
+	Wins, Losses int32
+
+	FreshBlood, Veteran, HotStreak, Inactive bool
+}
+
+// Snapshot is entries of leagues at a time.
+type Snapshot struct {
+	Time    time.Time
+	Entries map[Key]*Entry
+}
+
+// NewSnapshot returns a snapshot of leagues.
+// It returns an error if a tier or a division is invalid.
+func NewSnapshot(t time.Time, leagues ...*lol.League) (*Snapshot, error) {
+	s := &Snapshot{Time: t, Entries: make(map[Key]*Entry)}
+	for _, l := range leagues {
+		for _, e := range l.Entries {
+			rank, err := lol.NewRank(l, e)
+			if err != nil {
+				return nil, err
+			}
+			key := Key{Queue: l.Queue, PlayerOrTeamID: e.PlayerOrTeamID}
+			s.Entries[key] = &Entry{
+				Key:        key,
+				Name:       e.PlayerOrTeamName,
+				League:     l.Name,
+				Rank:       rank,
+				Wins:       e.Wins,
+				Losses:     e.Losses,
+				FreshBlood: e.IsFreshBlood,
+				Veteran:    e.IsVeteran,
+				HotStreak:  e.IsHotStreak,
+				Inactive:   e.IsInactive,
+			}
+		}
+	}
+	return s, nil
+}
+
+// Ladder returns entries of a queue from the highest rank.
+func (s *Snapshot) Ladder(queue string) []*Entry {
+	var entries []*Entry
+	for _, e := range s.Entries {
+		if e.Queue == queue {
+			entries = append(entries, e)
+		}
+	}
+	sort.Sort(byRank(entries))
+	return entries
+}
+
+// ChangeType is a type of Change.
+type ChangeType int
+
+// Change types.
+const (
+	// New entry. Old is nil.
+	Added ChangeType = iota
+	// Removed entry. New is nil.
+	Removed
+	// Higher tier or division.
+	Promoted
+	// Lower tier or division.
+	Demoted
+	// LP changed in the same tier and division.
+	LPChanged
+	// Promotion series started, progressed or ended in the same tier and division.
+	SeriesChanged
+	// Flags changed. See New for the current value.
+	FreshBloodChanged
+	VeteranChanged
+	HotStreakChanged
+	InactiveChanged
+)
+
+var changeTypeNames = []string{"Added", "Removed", "Promoted", "Demoted", "LPChanged",
+	"SeriesChanged", "FreshBloodChanged", "VeteranChanged", "HotStreakChanged", "InactiveChanged"}
+
+func (t ChangeType) String() string {
+	if t < 0 || int(t) >= len(changeTypeNames) {
+		return "ChangeType(?)"
+	}
+	return changeTypeNames[t]
+}
+
+// Change is a change of an entry between snapshots.
+type Change struct {
+	Type     ChangeType
+	Key      Key
+	Old, New *Entry
+	// Difference of LP in the same tier and division.
+	// 0 if added, removed, promoted or demoted.
+	LPDelta int64
+}
+
+// Diff returns changes from a to b, sorted by key and type.
+// An entry may have multiple changes, like LPChanged and HotStreakChanged.
+func Diff(a, b *Snapshot) []Change {
+	var changes []Change
+	for key, o := range a.Entries {
+		if _, ok := b.Entries[key]; !ok {
+			changes = append(changes, Change{Type: Removed, Key: key, Old: o})
+		}
+	}
+
+	for key, n := range b.Entries {
+		o, ok := a.Entries[key]
+		if !ok {
+			changes = append(changes, Change{Type: Added, Key: key, New: n})
+			continue
+		}
+
+		cmp := compareDivision(n.Rank, o.Rank)
+		var lpDelta int64
+		if cmp == 0 {
+			lpDelta = int64(n.Rank.LP - o.Rank.LP)
+		}
+		add := func(t ChangeType) {
+			changes = append(changes, Change{Type: t, Key: key, Old: o, New: n, LPDelta: lpDelta})
+		}
+		switch {
+		case cmp > 0:
+			add(Promoted)
+		case cmp < 0:
+			add(Demoted)
+		default:
+			if lpDelta != 0 {
+				add(LPChanged)
+			}
+			if progress(n.Rank.Series) != progress(o.Rank.Series) {
+				add(SeriesChanged)
+			}
+		}
+		if n.FreshBlood != o.FreshBlood {
+			add(FreshBloodChanged)
+		}
+		if n.Veteran != o.Veteran {
+			add(VeteranChanged)
+		}
+		if n.HotStreak != o.HotStreak {
+			add(HotStreakChanged)
+		}
+		if n.Inactive != o.Inactive {
+			add(InactiveChanged)
+		}
+	}
+
+	sort.Sort(byKey(changes))
+	return changes
+}
+
+// progress returns the progress of s, or "" if s is nil.
+func progress(s *lol.PromotionSeries) string {
+	if s == nil {
+		return ""
+	}
+	return s.Progress
+}
+
+// compareDivision compares tiers and divisions, ignoring LP.
+func compareDivision(a, b lol.Rank) int {
+	return lol.Rank{Tier: a.Tier, Division: a.Division}.Compare(lol.Rank{Tier: b.Tier, Division: b.Division})
+}
+
+type byRank []*Entry
+
+func (es byRank) Len() int           { return len(es) }
+func (es byRank) Swap(i, j int)      { es[i], es[j] = es[j], es[i] }
+func (es byRank) Less(i, j int) bool { return es[j].Rank.Less(es[i].Rank) }
+
+type byKey []Change
+
+func (cs byKey) Len() int      { return len(cs) }
+func (cs byKey) Swap(i, j int) { cs[i], cs[j] = cs[j], cs[i] }
+func (cs byKey) Less(i, j int) bool {
+	a, b := cs[i].Key, cs[j].Key
+	if a != b {
+		if a.Queue != b.Queue {
+			return a.Queue < b.Queue
+		}
+		return a.PlayerOrTeamID < b.PlayerOrTeamID
+	}
+	return cs[i].Type < cs[j].Type
+}
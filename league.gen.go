@@ -0,0 +1,602 @@
+// Generated by go-lol-generator. DO NOT EDIT.
+
+package lol
+
+import "encoding/json"
+import "io"
+import "net/http"
+import "net/url"
+import "golang.org/x/net/context"
+import "github.com/go-lol/lol/uritemplates"
+
+// LeagueDto - This object contains league information.
+//
+// resource: "league", original name: "LeagueDto"
+type League struct {
+	// The requested league entries.
+	Entries []*LeagueEntry `json:"entries,omitempty"`
+	// This name is an internal place-holder name only. Display and localization of names in the game client are handled client-side.
+	Name string `json:"name,omitempty"`
+	// Specifies the relevant participant that is a member of this league (i.e., a requested summoner ID, a requested team ID, or the ID of a team to which one of the requested summoners belongs). Only present when full league is requested so that participant's entry can be identified. Not present when individual entry is requested.
+	ParticipantID string `json:"participantId,omitempty"`
+	// The league's queue type. (Legal values: RANKED_SOLO_5x5, RANKED_TEAM_3x3, RANKED_TEAM_5x5)
+	Queue string `json:"queue,omitempty"`
+	// The league's tier. (Legal values: CHALLENGER, MASTER, DIAMOND, PLATINUM, GOLD, SILVER, BRONZE)
+	Tier string `json:"tier,omitempty"`
+}
+
+// LeagueEntryDto - This object contains league participant information representing a summoner or team.
+//
+// resource: "league", original name: "LeagueEntryDto"
+type LeagueEntry struct {
+	// The league division of the participant.
+	Division string `json:"division,omitempty"`
+	// Specifies if the participant is fresh blood.
+	IsFreshBlood bool `json:"isFreshBlood,omitempty"`
+	// Specifies if the participant is on a hot streak.
+	IsHotStreak bool `json:"isHotStreak,omitempty"`
+	// Specifies if the participant is inactive.
+	IsInactive bool `json:"isInactive,omitempty"`
+	// Specifies if the participant is a veteran.
+	IsVeteran bool `json:"isVeteran,omitempty"`
+	// The league points of the participant.
+	LeaguePoints int32 `json:"leaguePoints,omitempty"`
+	// The number of losses for the participant.
+	Losses int32 `json:"losses,omitempty"`
+	// Mini series data for the participant. Only present if the participant is currently in a mini series.
+	MiniSeries *MiniSeries `json:"miniSeries,omitempty"`
+	// The ID of the participant (i.e., summoner or team) represented by this entry.
+	PlayerOrTeamID string `json:"playerOrTeamId,omitempty"`
+	// The name of the the participant (i.e., summoner or team) represented by this entry.
+	PlayerOrTeamName string `json:"playerOrTeamName,omitempty"`
+	// The number of wins for the participant.
+	Wins int32 `json:"wins,omitempty"`
+}
+
+// MiniSeriesDto - This object contains mini series information.
+//
+// resource: "league", original name: "MiniSeriesDto"
+type MiniSeries struct {
+	// Number of current losses in the mini series.
+	Losses int32 `json:"losses,omitempty"`
+	// String showing the current, sequential mini series progress where 'W' represents a win, 'L' represents a loss, and 'N' represents a game that hasn't been played yet.
+	Progress string `json:"progress,omitempty"`
+	// Number of wins required for promotion.
+	Target int32 `json:"target,omitempty"`
+	// Number of current wins in the mini series.
+	Wins int32 `json:"wins,omitempty"`
+}
+
+// LeaguesBySummonerIDCall is a builder for "LeaguesBySummonerID"
+type LeaguesBySummonerIDCall struct {
+	ctx        context.Context
+	client     *Client
+	query      url.Values
+	pathParams map[string]string
+	region     Region
+}
+
+var leaguesBySummonerIDInfo = &OperationInfo{
+	Name:     "LeaguesBySummonerID",
+	Resource: "league",
+	Version:  "v2.5",
+	Method:   "GET",
+	Path:     "/api/lol/{region}/v2.5/league/by-summoner/{summonerIds}",
+	DocURL:   "https://developer.riotgames.com/api/methods#!/985/3351",
+	Regions:  []Region{BR, EUNE, EUW, KR, LAN, LAS, NA, OCE, RU, TR},
+	Errors: []OperationError{
+		{400, "Bad request"},
+		{401, "Unauthorized"},
+		{404, "League not found"},
+		{429, "Rate limit exceeded"},
+		{500, "Internal server error"},
+		{503, "Service unavailable"},
+	},
+}
+
+// Info returns information of the operation.
+func (c *LeaguesBySummonerIDCall) Info() *OperationInfo { return leaguesBySummonerIDInfo }
+
+// Get leagues mapped by summoner ID for a given list of summoner IDs.
+//
+//
+// Implementation notes: Returns all leagues for specified summoners and summoners' teams. Entries for each requested participant (i.e., each summoner and related teams) will be included in the returned leagues data, whether or not the participant is inactive. However, no entries for other inactive summoners or teams in the leagues will be included.
+//
+// GET: /api/lol/{region}/v2.5/league/by-summoner/{summonerIds}
+//
+// Reference: https://developer.riotgames.com/api/methods#!/985/3351
+func (c *Client) LeaguesBySummonerID(ctx context.Context, region Region, summonerIDs []int64) *LeaguesBySummonerIDCall {
+	path := make(map[string]string)
+	path["summonerIds"] = convertToString(summonerIDs)
+	return &LeaguesBySummonerIDCall{ctx: ctx, client: c, query: make(url.Values), pathParams: path, region: region}
+}
+
+func (c *LeaguesBySummonerIDCall) doRequest() (*http.Response, error) {
+	var body io.Reader
+	switch c.region {
+	case BR, EUNE, EUW, KR, LAN, LAS, NA, OCE, RU, TR:
+	default:
+		return nil, ErrNotSupportedRegion
+	}
+	c.query.Set("api_key", c.client.apiKey)
+	c.pathParams["region"] = c.region.Name()
+
+	path, err := uritemplates.Expand("/api/lol/{region}/v2.5/league/by-summoner/{summonerIds}", c.pathParams)
+	if err != nil {
+		return nil, err
+	}
+	urls := c.region.baseURL() + path + "?" + c.query.Encode()
+
+	return c.client.doRequest(c.ctx, leaguesBySummonerIDInfo, urls, body)
+}
+
+// Do executes api request.
+//
+// API Errors:
+//  400 - Bad request
+//  401 - Unauthorized
+//  404 - League not found
+//  429 - Rate limit exceeded
+//  500 - Internal server error
+//  503 - Service unavailable
+func (c *LeaguesBySummonerIDCall) Do() (map[string][]*League, error) {
+	res, err := c.doRequest()
+	if err != nil {
+		return nil, err
+	}
+	defer closeBody(res)
+	if err := verifyAPIResponse(res); err != nil {
+		return nil, err
+	}
+	ret := make(map[string][]*League)
+	if err := json.NewDecoder(res.Body).Decode(&ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// LeagueEntriesBySummonerIDCall is a builder for "LeagueEntriesBySummonerID"
+type LeagueEntriesBySummonerIDCall struct {
+	ctx        context.Context
+	client     *Client
+	query      url.Values
+	pathParams map[string]string
+	region     Region
+}
+
+var leagueEntriesBySummonerIDInfo = &OperationInfo{
+	Name:     "LeagueEntriesBySummonerID",
+	Resource: "league",
+	Version:  "v2.5",
+	Method:   "GET",
+	Path:     "/api/lol/{region}/v2.5/league/by-summoner/{summonerIds}/entry",
+	DocURL:   "https://developer.riotgames.com/api/methods#!/985/3356",
+	Regions:  []Region{BR, EUNE, EUW, KR, LAN, LAS, NA, OCE, RU, TR},
+	Errors: []OperationError{
+		{400, "Bad request"},
+		{401, "Unauthorized"},
+		{404, "League not found"},
+		{429, "Rate limit exceeded"},
+		{500, "Internal server error"},
+		{503, "Service unavailable"},
+	},
+}
+
+// Info returns information of the operation.
+func (c *LeagueEntriesBySummonerIDCall) Info() *OperationInfo { return leagueEntriesBySummonerIDInfo }
+
+// Get league entries mapped by summoner ID for a given list of summoner IDs.
+//
+//
+// Implementation notes: Returns all league entries for specified summoners and summoners' teams.
+//
+// GET: /api/lol/{region}/v2.5/league/by-summoner/{summonerIds}/entry
+//
+// Reference: https://developer.riotgames.com/api/methods#!/985/3356
+func (c *Client) LeagueEntriesBySummonerID(ctx context.Context, region Region, summonerIDs []int64) *LeagueEntriesBySummonerIDCall {
+	path := make(map[string]string)
+	path["summonerIds"] = convertToString(summonerIDs)
+	return &LeagueEntriesBySummonerIDCall{ctx: ctx, client: c, query: make(url.Values), pathParams: path, region: region}
+}
+
+func (c *LeagueEntriesBySummonerIDCall) doRequest() (*http.Response, error) {
+	var body io.Reader
+	switch c.region {
+	case BR, EUNE, EUW, KR, LAN, LAS, NA, OCE, RU, TR:
+	default:
+		return nil, ErrNotSupportedRegion
+	}
+	c.query.Set("api_key", c.client.apiKey)
+	c.pathParams["region"] = c.region.Name()
+
+	path, err := uritemplates.Expand("/api/lol/{region}/v2.5/league/by-summoner/{summonerIds}/entry", c.pathParams)
+	if err != nil {
+		return nil, err
+	}
+	urls := c.region.baseURL() + path + "?" + c.query.Encode()
+
+	return c.client.doRequest(c.ctx, leagueEntriesBySummonerIDInfo, urls, body)
+}
+
+// Do executes api request.
+//
+// API Errors:
+//  400 - Bad request
+//  401 - Unauthorized
+//  404 - League not found
+//  429 - Rate limit exceeded
+//  500 - Internal server error
+//  503 - Service unavailable
+func (c *LeagueEntriesBySummonerIDCall) Do() (map[string][]*League, error) {
+	res, err := c.doRequest()
+	if err != nil {
+		return nil, err
+	}
+	defer closeBody(res)
+	if err := verifyAPIResponse(res); err != nil {
+		return nil, err
+	}
+	ret := make(map[string][]*League)
+	if err := json.NewDecoder(res.Body).Decode(&ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// LeaguesByTeamIDCall is a builder for "LeaguesByTeamID"
+type LeaguesByTeamIDCall struct {
+	ctx        context.Context
+	client     *Client
+	query      url.Values
+	pathParams map[string]string
+	region     Region
+}
+
+var leaguesByTeamIDInfo = &OperationInfo{
+	Name:     "LeaguesByTeamID",
+	Resource: "league",
+	Version:  "v2.5",
+	Method:   "GET",
+	Path:     "/api/lol/{region}/v2.5/league/by-team/{teamIds}",
+	DocURL:   "https://developer.riotgames.com/api/methods#!/985/3352",
+	Regions:  []Region{BR, EUNE, EUW, KR, LAN, LAS, NA, OCE, RU, TR},
+	Errors: []OperationError{
+		{400, "Bad request"},
+		{401, "Unauthorized"},
+		{404, "League not found"},
+		{429, "Rate limit exceeded"},
+		{500, "Internal server error"},
+		{503, "Service unavailable"},
+	},
+}
+
+// Info returns information of the operation.
+func (c *LeaguesByTeamIDCall) Info() *OperationInfo { return leaguesByTeamIDInfo }
+
+// Get leagues mapped by team ID for a given list of team IDs.
+//
+//
+// Implementation notes: Returns all leagues for specified teams. Entries for each requested team will be included in the returned leagues data, whether or not the team is inactive. However, no entries for other inactive teams in the leagues will be included.
+//
+// GET: /api/lol/{region}/v2.5/league/by-team/{teamIds}
+//
+// Reference: https://developer.riotgames.com/api/methods#!/985/3352
+func (c *Client) LeaguesByTeamID(ctx context.Context, region Region, teamIDs []string) *LeaguesByTeamIDCall {
+	path := make(map[string]string)
+	path["teamIds"] = convertToString(teamIDs)
+	return &LeaguesByTeamIDCall{ctx: ctx, client: c, query: make(url.Values), pathParams: path, region: region}
+}
+
+func (c *LeaguesByTeamIDCall) doRequest() (*http.Response, error) {
+	var body io.Reader
+	switch c.region {
+	case BR, EUNE, EUW, KR, LAN, LAS, NA, OCE, RU, TR:
+	default:
+		return nil, ErrNotSupportedRegion
+	}
+	c.query.Set("api_key", c.client.apiKey)
+	c.pathParams["region"] = c.region.Name()
+
+	path, err := uritemplates.Expand("/api/lol/{region}/v2.5/league/by-team/{teamIds}", c.pathParams)
+	if err != nil {
+		return nil, err
+	}
+	urls := c.region.baseURL() + path + "?" + c.query.Encode()
+
+	return c.client.doRequest(c.ctx, leaguesByTeamIDInfo, urls, body)
+}
+
+// Do executes api request.
+//
+// API Errors:
+//  400 - Bad request
+//  401 - Unauthorized
+//  404 - League not found
+//  429 - Rate limit exceeded
+//  500 - Internal server error
+//  503 - Service unavailable
+func (c *LeaguesByTeamIDCall) Do() (map[string][]*League, error) {
+	res, err := c.doRequest()
+	if err != nil {
+		return nil, err
+	}
+	defer closeBody(res)
+	if err := verifyAPIResponse(res); err != nil {
+		return nil, err
+	}
+	ret := make(map[string][]*League)
+	if err := json.NewDecoder(res.Body).Decode(&ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// LeagueEntriesByTeamIDCall is a builder for "LeagueEntriesByTeamID"
+type LeagueEntriesByTeamIDCall struct {
+	ctx        context.Context
+	client     *Client
+	query      url.Values
+	pathParams map[string]string
+	region     Region
+}
+
+var leagueEntriesByTeamIDInfo = &OperationInfo{
+	Name:     "LeagueEntriesByTeamID",
+	Resource: "league",
+	Version:  "v2.5",
+	Method:   "GET",
+	Path:     "/api/lol/{region}/v2.5/league/by-team/{teamIds}/entry",
+	DocURL:   "https://developer.riotgames.com/api/methods#!/985/3355",
+	Regions:  []Region{BR, EUNE, EUW, KR, LAN, LAS, NA, OCE, RU, TR},
+	Errors: []OperationError{
+		{400, "Bad request"},
+		{401, "Unauthorized"},
+		{404, "League not found"},
+		{429, "Rate limit exceeded"},
+		{500, "Internal server error"},
+		{503, "Service unavailable"},
+	},
+}
+
+// Info returns information of the operation.
+func (c *LeagueEntriesByTeamIDCall) Info() *OperationInfo { return leagueEntriesByTeamIDInfo }
+
+// Get league entries mapped by team ID for a given list of team IDs.
+//
+//
+// Implementation notes: Returns all league entries for specified teams.
+//
+// GET: /api/lol/{region}/v2.5/league/by-team/{teamIds}/entry
+//
+// Reference: https://developer.riotgames.com/api/methods#!/985/3355
+func (c *Client) LeagueEntriesByTeamID(ctx context.Context, region Region, teamIDs []string) *LeagueEntriesByTeamIDCall {
+	path := make(map[string]string)
+	path["teamIds"] = convertToString(teamIDs)
+	return &LeagueEntriesByTeamIDCall{ctx: ctx, client: c, query: make(url.Values), pathParams: path, region: region}
+}
+
+func (c *LeagueEntriesByTeamIDCall) doRequest() (*http.Response, error) {
+	var body io.Reader
+	switch c.region {
+	case BR, EUNE, EUW, KR, LAN, LAS, NA, OCE, RU, TR:
+	default:
+		return nil, ErrNotSupportedRegion
+	}
+	c.query.Set("api_key", c.client.apiKey)
+	c.pathParams["region"] = c.region.Name()
+
+	path, err := uritemplates.Expand("/api/lol/{region}/v2.5/league/by-team/{teamIds}/entry", c.pathParams)
+	if err != nil {
+		return nil, err
+	}
+	urls := c.region.baseURL() + path + "?" + c.query.Encode()
+
+	return c.client.doRequest(c.ctx, leagueEntriesByTeamIDInfo, urls, body)
+}
+
+// Do executes api request.
+//
+// API Errors:
+//  400 - Bad request
+//  401 - Unauthorized
+//  404 - League not found
+//  429 - Rate limit exceeded
+//  500 - Internal server error
+//  503 - Service unavailable
+func (c *LeagueEntriesByTeamIDCall) Do() (map[string][]*League, error) {
+	res, err := c.doRequest()
+	if err != nil {
+		return nil, err
+	}
+	defer closeBody(res)
+	if err := verifyAPIResponse(res); err != nil {
+		return nil, err
+	}
+	ret := make(map[string][]*League)
+	if err := json.NewDecoder(res.Body).Decode(&ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// ChallengerCall is a builder for "Challenger"
+type ChallengerCall struct {
+	ctx        context.Context
+	client     *Client
+	query      url.Values
+	pathParams map[string]string
+	region     Region
+}
+
+var challengerInfo = &OperationInfo{
+	Name:     "Challenger",
+	Resource: "league",
+	Version:  "v2.5",
+	Method:   "GET",
+	Path:     "/api/lol/{region}/v2.5/league/challenger",
+	DocURL:   "https://developer.riotgames.com/api/methods#!/985/3353",
+	Regions:  []Region{BR, EUNE, EUW, KR, LAN, LAS, NA, OCE, RU, TR},
+	Errors: []OperationError{
+		{400, "Bad request"},
+		{401, "Unauthorized"},
+		{404, "League not found"},
+		{429, "Rate limit exceeded"},
+		{500, "Internal server error"},
+		{503, "Service unavailable"},
+	},
+}
+
+// Info returns information of the operation.
+func (c *ChallengerCall) Info() *OperationInfo { return challengerInfo }
+
+// Get challenger tier leagues.
+//
+//
+// GET: /api/lol/{region}/v2.5/league/challenger
+//
+// Reference: https://developer.riotgames.com/api/methods#!/985/3353
+func (c *Client) Challenger(ctx context.Context, region Region) *ChallengerCall {
+	path := make(map[string]string)
+	return &ChallengerCall{ctx: ctx, client: c, query: make(url.Values), pathParams: path, region: region}
+}
+
+// type configures query parameter "type".
+func (c *ChallengerCall) Type(v string) *ChallengerCall {
+	c.query.Set("type", convertToString(v))
+	return c
+}
+
+func (c *ChallengerCall) doRequest() (*http.Response, error) {
+	var body io.Reader
+	switch c.region {
+	case BR, EUNE, EUW, KR, LAN, LAS, NA, OCE, RU, TR:
+	default:
+		return nil, ErrNotSupportedRegion
+	}
+	c.query.Set("api_key", c.client.apiKey)
+	c.pathParams["region"] = c.region.Name()
+
+	path, err := uritemplates.Expand("/api/lol/{region}/v2.5/league/challenger", c.pathParams)
+	if err != nil {
+		return nil, err
+	}
+	urls := c.region.baseURL() + path + "?" + c.query.Encode()
+
+	return c.client.doRequest(c.ctx, challengerInfo, urls, body)
+}
+
+// Do executes api request.
+//
+// API Errors:
+//  400 - Bad request
+//  401 - Unauthorized
+//  404 - League not found
+//  429 - Rate limit exceeded
+//  500 - Internal server error
+//  503 - Service unavailable
+func (c *ChallengerCall) Do() (*League, error) {
+	res, err := c.doRequest()
+	if err != nil {
+		return nil, err
+	}
+	defer closeBody(res)
+	if err := verifyAPIResponse(res); err != nil {
+		return nil, err
+	}
+	ret := &League{}
+	if err := json.NewDecoder(res.Body).Decode(&ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// MasterCall is a builder for "Master"
+type MasterCall struct {
+	ctx        context.Context
+	client     *Client
+	query      url.Values
+	pathParams map[string]string
+	region     Region
+}
+
+var masterInfo = &OperationInfo{
+	Name:     "Master",
+	Resource: "league",
+	Version:  "v2.5",
+	Method:   "GET",
+	Path:     "/api/lol/{region}/v2.5/league/master",
+	DocURL:   "https://developer.riotgames.com/api/methods#!/985/3354",
+	Regions:  []Region{BR, EUNE, EUW, KR, LAN, LAS, NA, OCE, RU, TR},
+	Errors: []OperationError{
+		{400, "Bad request"},
+		{401, "Unauthorized"},
+		{404, "League not found"},
+		{429, "Rate limit exceeded"},
+		{500, "Internal server error"},
+		{503, "Service unavailable"},
+	},
+}
+
+// Info returns information of the operation.
+func (c *MasterCall) Info() *OperationInfo { return masterInfo }
+
+// Get master tier leagues.
+//
+//
+// GET: /api/lol/{region}/v2.5/league/master
+//
+// Reference: https://developer.riotgames.com/api/methods#!/985/3354
+func (c *Client) Master(ctx context.Context, region Region) *MasterCall {
+	path := make(map[string]string)
+	return &MasterCall{ctx: ctx, client: c, query: make(url.Values), pathParams: path, region: region}
+}
+
+// type configures query parameter "type".
+func (c *MasterCall) Type(v string) *MasterCall {
+	c.query.Set("type", convertToString(v))
+	return c
+}
+
+func (c *MasterCall) doRequest() (*http.Response, error) {
+	var body io.Reader
+	switch c.region {
+	case BR, EUNE, EUW, KR, LAN, LAS, NA, OCE, RU, TR:
+	default:
+		return nil, ErrNotSupportedRegion
+	}
+	c.query.Set("api_key", c.client.apiKey)
+	c.pathParams["region"] = c.region.Name()
+
+	path, err := uritemplates.Expand("/api/lol/{region}/v2.5/league/master", c.pathParams)
+	if err != nil {
+		return nil, err
+	}
+	urls := c.region.baseURL() + path + "?" + c.query.Encode()
+
+	return c.client.doRequest(c.ctx, masterInfo, urls, body)
+}
+
+// Do executes api request.
+//
+// API Errors:
+//  400 - Bad request
+//  401 - Unauthorized
+//  404 - League not found
+//  429 - Rate limit exceeded
+//  500 - Internal server error
+//  503 - Service unavailable
+func (c *MasterCall) Do() (*League, error) {
+	res, err := c.doRequest()
+	if err != nil {
+		return nil, err
+	}
+	defer closeBody(res)
+	if err := verifyAPIResponse(res); err != nil {
+		return nil, err
+	}
+	ret := &League{}
+	if err := json.NewDecoder(res.Body).Decode(&ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
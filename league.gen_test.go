@@ -0,0 +1,77 @@
+// Generated by go-lol-generator. DO NOT EDIT.
+
+package lol
+
+import "testing"
+import "golang.org/x/net/context"
+
+func TestLeagueOperations(t *testing.T) {
+	runOperationTests(t, []operationTest{
+		{
+			name:        "LeaguesBySummonerID",
+			region:      NA,
+			unsupported: Global,
+			path:        "/api/lol/na/v2.5/league/by-summoner/1",
+			apiKey:      true,
+			fixture:     "{\"1\":[{\"entries\":[{\"division\":\"example\",\"isFreshBlood\":true,\"isHotStreak\":true,\"isInactive\":true,\"isVeteran\":true,\"leaguePoints\":1,\"losses\":1,\"miniSeries\":{\"losses\":1,\"progress\":\"example\",\"target\":1,\"wins\":1},\"playerOrTeamId\":\"example\",\"playerOrTeamName\":\"example\",\"wins\":1}],\"name\":\"example\",\"participantId\":\"example\",\"queue\":\"example\",\"tier\":\"example\"}]}",
+			do: func(c *Client, region Region) (interface{}, error) {
+				return c.LeaguesBySummonerID(context.TODO(), region, []int64{1}).Do()
+			},
+		},
+		{
+			name:        "LeagueEntriesBySummonerID",
+			region:      NA,
+			unsupported: Global,
+			path:        "/api/lol/na/v2.5/league/by-summoner/1/entry",
+			apiKey:      true,
+			fixture:     "{\"1\":[{\"entries\":[{\"division\":\"example\",\"isFreshBlood\":true,\"isHotStreak\":true,\"isInactive\":true,\"isVeteran\":true,\"leaguePoints\":1,\"losses\":1,\"miniSeries\":{\"losses\":1,\"progress\":\"example\",\"target\":1,\"wins\":1},\"playerOrTeamId\":\"example\",\"playerOrTeamName\":\"example\",\"wins\":1}],\"name\":\"example\",\"participantId\":\"example\",\"queue\":\"example\",\"tier\":\"example\"}]}",
+			do: func(c *Client, region Region) (interface{}, error) {
+				return c.LeagueEntriesBySummonerID(context.TODO(), region, []int64{1}).Do()
+			},
+		},
+		{
+			name:        "LeaguesByTeamID",
+			region:      NA,
+			unsupported: Global,
+			path:        "/api/lol/na/v2.5/league/by-team/example",
+			apiKey:      true,
+			fixture:     "{\"1\":[{\"entries\":[{\"division\":\"example\",\"isFreshBlood\":true,\"isHotStreak\":true,\"isInactive\":true,\"isVeteran\":true,\"leaguePoints\":1,\"losses\":1,\"miniSeries\":{\"losses\":1,\"progress\":\"example\",\"target\":1,\"wins\":1},\"playerOrTeamId\":\"example\",\"playerOrTeamName\":\"example\",\"wins\":1}],\"name\":\"example\",\"participantId\":\"example\",\"queue\":\"example\",\"tier\":\"example\"}]}",
+			do: func(c *Client, region Region) (interface{}, error) {
+				return c.LeaguesByTeamID(context.TODO(), region, []string{"example"}).Do()
+			},
+		},
+		{
+			name:        "LeagueEntriesByTeamID",
+			region:      NA,
+			unsupported: Global,
+			path:        "/api/lol/na/v2.5/league/by-team/example/entry",
+			apiKey:      true,
+			fixture:     "{\"1\":[{\"entries\":[{\"division\":\"example\",\"isFreshBlood\":true,\"isHotStreak\":true,\"isInactive\":true,\"isVeteran\":true,\"leaguePoints\":1,\"losses\":1,\"miniSeries\":{\"losses\":1,\"progress\":\"example\",\"target\":1,\"wins\":1},\"playerOrTeamId\":\"example\",\"playerOrTeamName\":\"example\",\"wins\":1}],\"name\":\"example\",\"participantId\":\"example\",\"queue\":\"example\",\"tier\":\"example\"}]}",
+			do: func(c *Client, region Region) (interface{}, error) {
+				return c.LeagueEntriesByTeamID(context.TODO(), region, []string{"example"}).Do()
+			},
+		},
+		{
+			name:        "Challenger",
+			region:      NA,
+			unsupported: Global,
+			path:        "/api/lol/na/v2.5/league/challenger",
+			apiKey:      true,
+			fixture:     "{\"entries\":[{\"division\":\"example\",\"isFreshBlood\":true,\"isHotStreak\":true,\"isInactive\":true,\"isVeteran\":true,\"leaguePoints\":1,\"losses\":1,\"miniSeries\":{\"losses\":1,\"progress\":\"example\",\"target\":1,\"wins\":1},\"playerOrTeamId\":\"example\",\"playerOrTeamName\":\"example\",\"wins\":1}],\"name\":\"example\",\"participantId\":\"example\",\"queue\":\"example\",\"tier\":\"example\"}",
+			do: func(c *Client, region Region) (interface{}, error) {
+				return c.Challenger(context.TODO(), region).Do()
+			},
+		},
+		{
+			name:        "Master",
+			region:      NA,
+			unsupported: Global,
+			path:        "/api/lol/na/v2.5/league/master",
+			apiKey:      true,
+			fixture:     "{\"entries\":[{\"division\":\"example\",\"isFreshBlood\":true,\"isHotStreak\":true,\"isInactive\":true,\"isVeteran\":true,\"leaguePoints\":1,\"losses\":1,\"miniSeries\":{\"losses\":1,\"progress\":\"example\",\"target\":1,\"wins\":1},\"playerOrTeamId\":\"example\",\"playerOrTeamName\":\"example\",\"wins\":1}],\"name\":\"example\",\"participantId\":\"example\",\"queue\":\"example\",\"tier\":\"example\"}",
+			do: func(c *Client, region Region) (interface{}, error) {
+				return c.Master(context.TODO(), region).Do()
+			},
+		},
+	})
+}
@@ -0,0 +1,87 @@
+// Generated by go-lol-generator. DO NOT EDIT.
+
+package lol_test
+
+import "fmt"
+import "log"
+import "os"
+import "golang.org/x/net/context"
+import "github.com/go-lol/lol"
+
+func ExampleClient_LeaguesBySummonerID() {
+	client, err := lol.New(nil, os.Getenv("RIOT_API_KEY"))
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	ret, err := client.LeaguesBySummonerID(context.TODO(), lol.NA, []int64{1}).Do()
+	if err != nil {
+		log.Fatal(err)
+	}
+	fmt.Println(ret)
+}
+
+func ExampleClient_LeagueEntriesBySummonerID() {
+	client, err := lol.New(nil, os.Getenv("RIOT_API_KEY"))
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	ret, err := client.LeagueEntriesBySummonerID(context.TODO(), lol.NA, []int64{1}).Do()
+	if err != nil {
+		log.Fatal(err)
+	}
+	fmt.Println(ret)
+}
+
+func ExampleClient_LeaguesByTeamID() {
+	client, err := lol.New(nil, os.Getenv("RIOT_API_KEY"))
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	ret, err := client.LeaguesByTeamID(context.TODO(), lol.NA, []string{"example"}).Do()
+	if err != nil {
+		log.Fatal(err)
+	}
+	fmt.Println(ret)
+}
+
+func ExampleClient_LeagueEntriesByTeamID() {
+	client, err := lol.New(nil, os.Getenv("RIOT_API_KEY"))
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	ret, err := client.LeagueEntriesByTeamID(context.TODO(), lol.NA, []string{"example"}).Do()
+	if err != nil {
+		log.Fatal(err)
+	}
+	fmt.Println(ret)
+}
+
+func ExampleClient_Challenger() {
+	client, err := lol.New(nil, os.Getenv("RIOT_API_KEY"))
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	ret, err := client.Challenger(context.TODO(), lol.NA).Do()
+	if err != nil {
+		log.Fatal(err)
+	}
+	fmt.Println(ret)
+}
+
+func ExampleClient_Master() {
+	client, err := lol.New(nil, os.Getenv("RIOT_API_KEY"))
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	ret, err := client.Master(context.TODO(), lol.NA).Do()
+	if err != nil {
+		log.Fatal(err)
+	}
+	fmt.Println(ret)
+}
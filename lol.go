@@ -1,6 +1,7 @@
 package lol
 
-//go:generate go run go-lol-generator/main.go
+//go:generate go run go-lol-generator/main.go -desc lol.json
+//go:generate go run go-lol-generator/main.go -desc lol.json -format openapi
 
 import (
 	"errors"
@@ -8,6 +9,7 @@ import (
 	"io"
 	"io/ioutil"
 	"net/http"
+	"time"
 
 	"golang.org/x/net/context"
 	"golang.org/x/net/context/ctxhttp"
@@ -35,6 +37,12 @@ type ClientProviderFunc func(context.Context) *http.Client
 type Client struct {
 	getClient ClientProviderFunc
 	apiKey    string
+
+	limiter    RateLimiter
+	maxRetries int
+
+	// Used for lol-static-data operations if not nil.
+	staticData http.RoundTripper
 }
 
 // New creates a new league of legends client.
@@ -49,18 +57,52 @@ func New(clientProvider ClientProviderFunc, key string) (*Client, error) {
 	return &Client{getClient: clientProvider, apiKey: key}, nil
 }
 
-func (c *Client) doRequest(ctx context.Context, method, urlStr string, body io.Reader) (*http.Response, error) {
-	req, err := http.NewRequest(method, urlStr, body)
-	if err != nil {
-		return nil, err
-	}
-
+// doRequest sends a request, waiting for the rate limiter unless
+// the operation is exempt from the rate limit.
+// body must be nil if retries are enabled.
+func (c *Client) doRequest(ctx context.Context, info *OperationInfo, urlStr string, body io.Reader) (*http.Response, error) {
 	httpClient := c.getClient(ctx)
+	if c.staticData != nil && info.Resource == "lol-static-data" {
+		httpClient = &http.Client{Transport: c.staticData}
+	}
 
-	if ctx != nil {
-		return ctxhttp.Do(ctx, httpClient, req)
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	ctx = withOperation(ctx, info)
+
+	for retry := 0; ; retry++ {
+		if c.limiter != nil && !info.RateLimitExempt {
+			if err := c.limiter.Wait(ctx, info); err != nil {
+				return nil, err
+			}
+		}
+
+		req, err := http.NewRequest(info.Method, urlStr, body)
+		if err != nil {
+			return nil, err
+		}
+
+		res, err := ctxhttp.Do(ctx, httpClient, req)
+		if err != nil || res.StatusCode != 429 || retry >= c.maxRetries {
+			return res, err
+		}
+
+		wait := retryAfter(res)
+		closeBody(res)
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(wait):
+		}
 	}
-	return httpClient.Do(req)
+}
+
+// SetStaticDataTransport sets a transport used for lol-static-data operations
+// instead of the api, like staticdata.Bundles.
+// The context of requests carries OperationInfo. See OperationFromContext
+func (c *Client) SetStaticDataTransport(t http.RoundTripper) {
+	c.staticData = t
 }
 
 // RiotError represents an error returned from riot api server.
@@ -5,7 +5,7 @@ import (
 	"strings"
 	"testing"
 
-	"github.com/go-lol/go-lol"
+	"github.com/go-lol/lol"
 	"golang.org/x/net/context"
 )
 
@@ -15,7 +15,7 @@ func init() {
 	var err error
 	apiKey := os.Getenv("RIOT_API_KEY")
 	if apiKey == "" {
-		panic("Environment variable 'RIOT_API_KEY' is required.")
+		return // Tests using riot api server are skipped.
 	}
 
 	client, err = lol.New(nil, apiKey)
@@ -25,6 +25,10 @@ func init() {
 }
 
 func TestSummonersAPI(t *testing.T) {
+	if client == nil {
+		t.Skip("Environment variable 'RIOT_API_KEY' is required.")
+	}
+
 	// credit: https://github.com/kevinohashi/php-riot-api/blob/master/testing.php
 	const (
 		testID     = 585897
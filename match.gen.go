@@ -0,0 +1,752 @@
+// Generated by go-lol-generator. DO NOT EDIT.
+
+package lol
+
+import "encoding/json"
+import "io"
+import "net/http"
+import "net/url"
+import "golang.org/x/net/context"
+import "github.com/go-lol/lol/uritemplates"
+
+// BannedChampion - This object contains information about banned champions
+//
+// resource: "match", original name: "BannedChampion"
+type BannedChampion struct {
+	// Banned champion ID
+	ChampionID int32 `json:"championId,omitempty"`
+	// Turn during which the champion was banned
+	PickTurn int32 `json:"pickTurn,omitempty"`
+}
+
+// Event - This object contains game event information. Note that not all legal type values documented below are valid for all games. Event data evolves over time and certain values may be relevant only for older or newer games.
+//
+// resource: "match", original name: "Event"
+type Event struct {
+	// The ascended type of the event. Only present if relevant. Note that CLEAR_ASCENDED refers to when a participants kills the ascended player. (Legal values: CHAMPION_ASCENDED, CLEAR_ASCENDED, MINION_ASCENDED)
+	AscendedType string `json:"ascendedType,omitempty"`
+	// The assisting participant IDs of the event. Only present if relevant.
+	AssistingParticipantIds []int32 `json:"assistingParticipantIds,omitempty"`
+	// The building type of the event. Only present if relevant. (Legal values: INHIBITOR_BUILDING, TOWER_BUILDING)
+	BuildingType string `json:"buildingType,omitempty"`
+	// The creator ID of the event. Only present if relevant.
+	CreatorID int32 `json:"creatorId,omitempty"`
+	// Event type. (Legal values: ASCENDED_EVENT, BUILDING_KILL, CAPTURE_POINT, CHAMPION_KILL, ELITE_MONSTER_KILL, ITEM_DESTROYED, ITEM_PURCHASED, ITEM_SOLD, ITEM_UNDO, PORO_KING_SUMMON, SKILL_LEVEL_UP, WARD_KILL, WARD_PLACED)
+	EventType string `json:"eventType,omitempty"`
+	// The ending item ID of the event. Only present if relevant.
+	ItemAfter int32 `json:"itemAfter,omitempty"`
+	// The starting item ID of the event. Only present if relevant.
+	ItemBefore int32 `json:"itemBefore,omitempty"`
+	// The item ID of the event. Only present if relevant.
+	ItemID int32 `json:"itemId,omitempty"`
+	// The killer ID of the event. Only present if relevant. Killer ID 0 indicates a minion.
+	KillerID int32 `json:"killerId,omitempty"`
+	// The lane type of the event. Only present if relevant. (Legal values: BOT_LANE, MID_LANE, TOP_LANE)
+	LaneType string `json:"laneType,omitempty"`
+	// The level up type of the event. Only present if relevant. (Legal values: EVOLVE, NORMAL)
+	LevelUpType string `json:"levelUpType,omitempty"`
+	// The monster type of the event. Only present if relevant. (Legal values: BARON_NASHOR, BLUE_GOLEM, DRAGON, RED_LIZARD, RIFTHERALD, VILEMAW)
+	MonsterType string `json:"monsterType,omitempty"`
+	// The participant ID of the event. Only present if relevant.
+	ParticipantID int32 `json:"participantId,omitempty"`
+	// The point captured in the event. Only present if relevant. (Legal values: POINT_A, POINT_B, POINT_C, POINT_D, POINT_E)
+	PointCaptured string `json:"pointCaptured,omitempty"`
+	// The position of the event. Only present if relevant.
+	Position *Position `json:"position,omitempty"`
+	// The skill slot of the event. Only present if relevant.
+	SkillSlot int32 `json:"skillSlot,omitempty"`
+	// The team ID of the event. Only present if relevant.
+	TeamID int32 `json:"teamId,omitempty"`
+	// Represents how many milliseconds into the game the event occurred.
+	Timestamp Millis `json:"timestamp,omitempty"`
+	// The tower type of the event. Only present if relevant. (Legal values: BASE_TURRET, FOUNTAIN_TURRET, INNER_TURRET, NEXUS_TURRET, OUTER_TURRET, UNDEFINED_TURRET)
+	TowerType string `json:"towerType,omitempty"`
+	// The victim ID of the event. Only present if relevant.
+	VictimID int32 `json:"victimId,omitempty"`
+	// The ward type of the event. Only present if relevant. (Legal values: SIGHT_WARD, TEEMO_MUSHROOM, UNDEFINED, VISION_WARD, YELLOW_TRINKET, YELLOW_TRINKET_UPGRADE)
+	WardType string `json:"wardType,omitempty"`
+}
+
+// Frame - This object contains game frame information
+//
+// resource: "match", original name: "Frame"
+type Frame struct {
+	// List of events for this frame.
+	Events []*Event `json:"events,omitempty"`
+	// Map of each participant ID to the participant's information for the frame.
+	ParticipantFrames map[string]*ParticipantFrame `json:"participantFrames,omitempty"`
+	// Represents how many milliseconds into the game the frame occurred.
+	Timestamp Millis `json:"timestamp,omitempty"`
+}
+
+// MatchDetail - This object contains match detail information
+//
+// resource: "match", original name: "MatchDetail"
+type MatchDetail struct {
+	// Match map ID
+	MapID int32 `json:"mapId,omitempty"`
+	// Match creation time. Designates when the team select lobby is created and/or the match is made through match making, not when the game actually starts.
+	MatchCreation EpochMillis `json:"matchCreation,omitempty"`
+	// Match duration
+	MatchDuration Seconds `json:"matchDuration,omitempty"`
+	// ID of the match
+	MatchID int64 `json:"matchId,omitempty"`
+	// Match mode (Legal values: CLASSIC, ODIN, ARAM, TUTORIAL, ONEFORALL, ASCENSION, FIRSTBLOOD, KINGPORO)
+	MatchMode string `json:"matchMode,omitempty"`
+	// Match type (Legal values: CUSTOM_GAME, MATCHED_GAME, TUTORIAL_GAME)
+	MatchType string `json:"matchType,omitempty"`
+	// Match version
+	MatchVersion string `json:"matchVersion,omitempty"`
+	// Participant identity information
+	ParticipantIdentities []*MatchParticipantIdentity `json:"participantIdentities,omitempty"`
+	// Participant information
+	Participants []*MatchParticipant `json:"participants,omitempty"`
+	// Platform ID of the match
+	PlatformID string `json:"platformId,omitempty"`
+	// Match queue type (Legal values: CUSTOM, NORMAL_5x5_BLIND, RANKED_SOLO_5x5, RANKED_PREMADE_5x5, BOT_5x5, NORMAL_3x3, RANKED_PREMADE_3x3, NORMAL_5x5_DRAFT, ODIN_5x5_BLIND, ODIN_5x5_DRAFT, BOT_ODIN_5x5, BOT_5x5_INTRO, BOT_5x5_BEGINNER, BOT_5x5_INTERMEDIATE, RANKED_TEAM_3x3, RANKED_TEAM_5x5, BOT_TT_3x3, GROUP_FINDER_5x5, ARAM_5x5, ONEFORALL_5x5, FIRSTBLOOD_1x1, FIRSTBLOOD_2x2, SR_6x6, URF_5x5, ONEFORALL_MIRRORMODE_5x5, BOT_URF_5x5, NIGHTMARE_BOT_5x5_RANK1, NIGHTMARE_BOT_5x5_RANK2, NIGHTMARE_BOT_5x5_RANK5, ASCENSION_5x5, HEXAKILL, BILGEWATER_ARAM_5x5, KING_PORO_5x5, COUNTER_PICK, BILGEWATER_5x5)
+	QueueType string `json:"queueType,omitempty"`
+	// Region where the match was played
+	Region string `json:"region,omitempty"`
+	// Season match was played (Legal values: PRESEASON3, SEASON3, PRESEASON2014, SEASON2014, PRESEASON2015, SEASON2015, PRESEASON2016, SEASON2016)
+	Season string `json:"season,omitempty"`
+	// Team information
+	Teams []*Team `json:"teams,omitempty"`
+	// Match timeline data (not included by default)
+	Timeline *Timeline `json:"timeline,omitempty"`
+}
+
+// Participant - This object contains match participant information
+//
+// resource: "match", original name: "Participant"
+type MatchParticipant struct {
+	// Champion ID
+	ChampionID int32 `json:"championId,omitempty"`
+	// Highest ranked tier achieved for the previous season, if any, otherwise null. Used to display border in game loading screen. (Legal values: CHALLENGER, MASTER, DIAMOND, PLATINUM, GOLD, SILVER, BRONZE, UNRANKED)
+	HighestAchievedSeasonTier string `json:"highestAchievedSeasonTier,omitempty"`
+	// List of mastery information
+	Masteries []*UsedMastery `json:"masteries,omitempty"`
+	// Participant ID
+	ParticipantID int32 `json:"participantId,omitempty"`
+	// List of rune information
+	Runes []*UsedRune `json:"runes,omitempty"`
+	// First summoner spell ID
+	Spell1Id int32 `json:"spell1Id,omitempty"`
+	// Second summoner spell ID
+	Spell2Id int32 `json:"spell2Id,omitempty"`
+	// Participant statistics
+	Stats *ParticipantStats `json:"stats,omitempty"`
+	// Team ID
+	TeamID int32 `json:"teamId,omitempty"`
+	// Timeline data. Delta fields refer to values for the specified period (e.g., the gold per minute over the first 10 minutes of the game versus the second 20 minutes of the game. Diffs fields refer to the deltas versus the calculated lane opponent(s).
+	Timeline *ParticipantTimeline `json:"timeline,omitempty"`
+}
+
+// ParticipantIdentity - This object contains participant identity information
+//
+// resource: "match", original name: "ParticipantIdentity"
+type MatchParticipantIdentity struct {
+	// Participant ID
+	ParticipantID int32 `json:"participantId,omitempty"`
+	// Player information
+	Player *MatchPlayer `json:"player,omitempty"`
+}
+
+// Player - This object contains match player information
+//
+// resource: "match", original name: "Player"
+type MatchPlayer struct {
+	// Match history URI
+	MatchHistoryURI string `json:"matchHistoryUri,omitempty"`
+	// Profile icon ID
+	ProfileIcon int32 `json:"profileIcon,omitempty"`
+	// Summoner ID
+	SummonerID int64 `json:"summonerId,omitempty"`
+	// Summoner name
+	SummonerName string `json:"summonerName,omitempty"`
+}
+
+// ParticipantFrame - This object contains participant frame information
+//
+// resource: "match", original name: "ParticipantFrame"
+type ParticipantFrame struct {
+	// Participant's current gold
+	CurrentGold int32 `json:"currentGold,omitempty"`
+	// Dominion score of the participant
+	DominionScore int32 `json:"dominionScore,omitempty"`
+	// Number of jungle minions killed by participant
+	JungleMinionsKilled int32 `json:"jungleMinionsKilled,omitempty"`
+	// Participant's current level
+	Level int32 `json:"level,omitempty"`
+	// Number of minions killed by participant
+	MinionsKilled int32 `json:"minionsKilled,omitempty"`
+	// Participant ID
+	ParticipantID int32 `json:"participantId,omitempty"`
+	// Participant's position
+	Position *Position `json:"position,omitempty"`
+	// Team score of the participant
+	TeamScore int32 `json:"teamScore,omitempty"`
+	// Participant's total gold
+	TotalGold int32 `json:"totalGold,omitempty"`
+	// Experience earned by participant
+	Xp int32 `json:"xp,omitempty"`
+}
+
+// ParticipantStats - This object contains participant statistics information
+//
+// resource: "match", original name: "ParticipantStats"
+type ParticipantStats struct {
+	// Number of assists
+	Assists int64 `json:"assists,omitempty"`
+	// Champion level achieved
+	ChampLevel int64 `json:"champLevel,omitempty"`
+	// If game was a dominion game, player's combat score, otherwise 0
+	CombatPlayerScore int64 `json:"combatPlayerScore,omitempty"`
+	// Number of deaths
+	Deaths int64 `json:"deaths,omitempty"`
+	// Number of double kills
+	DoubleKills int64 `json:"doubleKills,omitempty"`
+	// Flag indicating if participant got an assist on first blood
+	FirstBloodAssist bool `json:"firstBloodAssist,omitempty"`
+	// Flag indicating if participant got first blood
+	FirstBloodKill bool `json:"firstBloodKill,omitempty"`
+	// Flag indicating if participant got an assist on the first inhibitor
+	FirstInhibitorAssist bool `json:"firstInhibitorAssist,omitempty"`
+	// Flag indicating if participant destroyed the first inhibitor
+	FirstInhibitorKill bool `json:"firstInhibitorKill,omitempty"`
+	// Flag indicating if participant got an assist on the first tower
+	FirstTowerAssist bool `json:"firstTowerAssist,omitempty"`
+	// Flag indicating if participant destroyed the first tower
+	FirstTowerKill bool `json:"firstTowerKill,omitempty"`
+	// Gold earned
+	GoldEarned int64 `json:"goldEarned,omitempty"`
+	// Gold spent
+	GoldSpent int64 `json:"goldSpent,omitempty"`
+	// Number of inhibitor kills
+	InhibitorKills int64 `json:"inhibitorKills,omitempty"`
+	// First item ID
+	Item0 int64 `json:"item0,omitempty"`
+	// Second item ID
+	Item1 int64 `json:"item1,omitempty"`
+	// Third item ID
+	Item2 int64 `json:"item2,omitempty"`
+	// Fourth item ID
+	Item3 int64 `json:"item3,omitempty"`
+	// Fifth item ID
+	Item4 int64 `json:"item4,omitempty"`
+	// Sixth item ID
+	Item5 int64 `json:"item5,omitempty"`
+	// Seventh item ID
+	Item6 int64 `json:"item6,omitempty"`
+	// Number of killing sprees
+	KillingSprees int64 `json:"killingSprees,omitempty"`
+	// Number of kills
+	Kills int64 `json:"kills,omitempty"`
+	// Largest critical strike
+	LargestCriticalStrike int64 `json:"largestCriticalStrike,omitempty"`
+	// Largest killing spree
+	LargestKillingSpree int64 `json:"largestKillingSpree,omitempty"`
+	// Largest multi kill
+	LargestMultiKill int64 `json:"largestMultiKill,omitempty"`
+	// Magical damage dealt
+	MagicDamageDealt int64 `json:"magicDamageDealt,omitempty"`
+	// Magical damage dealt to champions
+	MagicDamageDealtToChampions int64 `json:"magicDamageDealtToChampions,omitempty"`
+	// Magic damage taken
+	MagicDamageTaken int64 `json:"magicDamageTaken,omitempty"`
+	// Minions killed
+	MinionsKilled int64 `json:"minionsKilled,omitempty"`
+	// Neutral minions killed
+	NeutralMinionsKilled int64 `json:"neutralMinionsKilled,omitempty"`
+	// Neutral jungle minions killed in the enemy team's jungle
+	NeutralMinionsKilledEnemyJungle int64 `json:"neutralMinionsKilledEnemyJungle,omitempty"`
+	// Neutral jungle minions killed in your team's jungle
+	NeutralMinionsKilledTeamJungle int64 `json:"neutralMinionsKilledTeamJungle,omitempty"`
+	// If game was a dominion game, number of node captures
+	NodeCapture int64 `json:"nodeCapture,omitempty"`
+	// If game was a dominion game, number of node capture assists
+	NodeCaptureAssist int64 `json:"nodeCaptureAssist,omitempty"`
+	// If game was a dominion game, number of node neutralizations
+	NodeNeutralize int64 `json:"nodeNeutralize,omitempty"`
+	// If game was a dominion game, number of node neutralization assists
+	NodeNeutralizeAssist int64 `json:"nodeNeutralizeAssist,omitempty"`
+	// If game was a dominion game, player's objectives score, otherwise 0
+	ObjectivePlayerScore int64 `json:"objectivePlayerScore,omitempty"`
+	// Number of penta kills
+	PentaKills int64 `json:"pentaKills,omitempty"`
+	// Physical damage dealt
+	PhysicalDamageDealt int64 `json:"physicalDamageDealt,omitempty"`
+	// Physical damage dealt to champions
+	PhysicalDamageDealtToChampions int64 `json:"physicalDamageDealtToChampions,omitempty"`
+	// Physical damage taken
+	PhysicalDamageTaken int64 `json:"physicalDamageTaken,omitempty"`
+	// Number of quadra kills
+	QuadraKills int64 `json:"quadraKills,omitempty"`
+	// Sight wards purchased
+	SightWardsBoughtInGame int64 `json:"sightWardsBoughtInGame,omitempty"`
+	// If game was a dominion game, number of completed team objectives (i.e., quests)
+	TeamObjective int64 `json:"teamObjective,omitempty"`
+	// Total damage dealt
+	TotalDamageDealt int64 `json:"totalDamageDealt,omitempty"`
+	// Total damage dealt to champions
+	TotalDamageDealtToChampions int64 `json:"totalDamageDealtToChampions,omitempty"`
+	// Total damage taken
+	TotalDamageTaken int64 `json:"totalDamageTaken,omitempty"`
+	// Total heal amount
+	TotalHeal int64 `json:"totalHeal,omitempty"`
+	// If game was a dominion game, player's total score, otherwise 0
+	TotalPlayerScore int64 `json:"totalPlayerScore,omitempty"`
+	// If game was a dominion game, team rank of the player's total score (e.g., 1-5)
+	TotalScoreRank int64 `json:"totalScoreRank,omitempty"`
+	// Total dealt crowd control time
+	TotalTimeCrowdControlDealt int64 `json:"totalTimeCrowdControlDealt,omitempty"`
+	// Total units healed
+	TotalUnitsHealed int64 `json:"totalUnitsHealed,omitempty"`
+	// Number of tower kills
+	TowerKills int64 `json:"towerKills,omitempty"`
+	// Number of triple kills
+	TripleKills int64 `json:"tripleKills,omitempty"`
+	// True damage dealt
+	TrueDamageDealt int64 `json:"trueDamageDealt,omitempty"`
+	// True damage dealt to champions
+	TrueDamageDealtToChampions int64 `json:"trueDamageDealtToChampions,omitempty"`
+	// True damage taken
+	TrueDamageTaken int64 `json:"trueDamageTaken,omitempty"`
+	// Number of unreal kills
+	UnrealKills int64 `json:"unrealKills,omitempty"`
+	// Vision wards purchased
+	VisionWardsBoughtInGame int64 `json:"visionWardsBoughtInGame,omitempty"`
+	// Number of wards killed
+	WardsKilled int64 `json:"wardsKilled,omitempty"`
+	// Number of wards placed
+	WardsPlaced int64 `json:"wardsPlaced,omitempty"`
+	// Flag indicating whether or not the participant won
+	Winner bool `json:"winner,omitempty"`
+}
+
+// ParticipantTimeline - This object contains all timeline information
+//
+// resource: "match", original name: "ParticipantTimeline"
+type ParticipantTimeline struct {
+	// Ancient golem assists per minute timeline counts
+	AncientGolemAssistsPerMinCounts *ParticipantTimelineData `json:"ancientGolemAssistsPerMinCounts,omitempty"`
+	// Ancient golem kills per minute timeline counts
+	AncientGolemKillsPerMinCounts *ParticipantTimelineData `json:"ancientGolemKillsPerMinCounts,omitempty"`
+	// Assisted lane deaths per minute timeline data
+	AssistedLaneDeathsPerMinDeltas *ParticipantTimelineData `json:"assistedLaneDeathsPerMinDeltas,omitempty"`
+	// Assisted lane kills per minute timeline data
+	AssistedLaneKillsPerMinDeltas *ParticipantTimelineData `json:"assistedLaneKillsPerMinDeltas,omitempty"`
+	// Baron assists per minute timeline counts
+	BaronAssistsPerMinCounts *ParticipantTimelineData `json:"baronAssistsPerMinCounts,omitempty"`
+	// Baron kills per minute timeline counts
+	BaronKillsPerMinCounts *ParticipantTimelineData `json:"baronKillsPerMinCounts,omitempty"`
+	// Creeps per minute timeline data
+	CreepsPerMinDeltas *ParticipantTimelineData `json:"creepsPerMinDeltas,omitempty"`
+	// Creep score difference per minute timeline data
+	CsDiffPerMinDeltas *ParticipantTimelineData `json:"csDiffPerMinDeltas,omitempty"`
+	// Damage taken difference per minute timeline data
+	DamageTakenDiffPerMinDeltas *ParticipantTimelineData `json:"damageTakenDiffPerMinDeltas,omitempty"`
+	// Damage taken per minute timeline data
+	DamageTakenPerMinDeltas *ParticipantTimelineData `json:"damageTakenPerMinDeltas,omitempty"`
+	// Dragon assists per minute timeline counts
+	DragonAssistsPerMinCounts *ParticipantTimelineData `json:"dragonAssistsPerMinCounts,omitempty"`
+	// Dragon kills per minute timeline counts
+	DragonKillsPerMinCounts *ParticipantTimelineData `json:"dragonKillsPerMinCounts,omitempty"`
+	// Elder lizard assists per minute timeline counts
+	ElderLizardAssistsPerMinCounts *ParticipantTimelineData `json:"elderLizardAssistsPerMinCounts,omitempty"`
+	// Elder lizard kills per minute timeline counts
+	ElderLizardKillsPerMinCounts *ParticipantTimelineData `json:"elderLizardKillsPerMinCounts,omitempty"`
+	// Gold per minute timeline data
+	GoldPerMinDeltas *ParticipantTimelineData `json:"goldPerMinDeltas,omitempty"`
+	// Inhibitor assists per minute timeline counts
+	InhibitorAssistsPerMinCounts *ParticipantTimelineData `json:"inhibitorAssistsPerMinCounts,omitempty"`
+	// Inhibitor kills per minute timeline counts
+	InhibitorKillsPerMinCounts *ParticipantTimelineData `json:"inhibitorKillsPerMinCounts,omitempty"`
+	// Participant's lane (Legal values: MID, MIDDLE, TOP, JUNGLE, BOT, BOTTOM)
+	Lane string `json:"lane,omitempty"`
+	// Participant's role (Legal values: DUO, NONE, SOLO, DUO_CARRY, DUO_SUPPORT)
+	Role string `json:"role,omitempty"`
+	// Tower assists per minute timeline counts
+	TowerAssistsPerMinCounts *ParticipantTimelineData `json:"towerAssistsPerMinCounts,omitempty"`
+	// Tower kills per minute timeline counts
+	TowerKillsPerMinCounts *ParticipantTimelineData `json:"towerKillsPerMinCounts,omitempty"`
+	// Tower kills per minute timeline data
+	TowerKillsPerMinDeltas *ParticipantTimelineData `json:"towerKillsPerMinDeltas,omitempty"`
+	// Vilemaw assists per minute timeline counts
+	VilemawAssistsPerMinCounts *ParticipantTimelineData `json:"vilemawAssistsPerMinCounts,omitempty"`
+	// Vilemaw kills per minute timeline counts
+	VilemawKillsPerMinCounts *ParticipantTimelineData `json:"vilemawKillsPerMinCounts,omitempty"`
+	// Wards placed per minute timeline data
+	WardsPerMinDeltas *ParticipantTimelineData `json:"wardsPerMinDeltas,omitempty"`
+	// Experience difference per minute timeline data
+	XpDiffPerMinDeltas *ParticipantTimelineData `json:"xpDiffPerMinDeltas,omitempty"`
+	// Experience per minute timeline data
+	XpPerMinDeltas *ParticipantTimelineData `json:"xpPerMinDeltas,omitempty"`
+}
+
+// ParticipantTimelineData - This object contains timeline data
+//
+// resource: "match", original name: "ParticipantTimelineData"
+type ParticipantTimelineData struct {
+	// Value per minute from 10 min to 20 min
+	TenToTwenty float64 `json:"tenToTwenty,omitempty"`
+	// Value per minute from 30 min to the end of the game
+	ThirtyToEnd float64 `json:"thirtyToEnd,omitempty"`
+	// Value per minute from 20 min to 30 min
+	TwentyToThirty float64 `json:"twentyToThirty,omitempty"`
+	// Value per minute from the beginning of the game to 10 min
+	ZeroToTen float64 `json:"zeroToTen,omitempty"`
+}
+
+// Position - This object contains participant frame position information
+//
+// resource: "match", original name: "Position"
+type Position struct {
+	X int32 `json:"x,omitempty"`
+	Y int32 `json:"y,omitempty"`
+}
+
+// Team - This object contains team information
+//
+// resource: "match", original name: "Team"
+type Team struct {
+	// If game was draft mode, contains banned champion data, otherwise null
+	Bans []*BannedChampion `json:"bans,omitempty"`
+	// Number of times the team killed baron
+	BaronKills int32 `json:"baronKills,omitempty"`
+	// If game was a dominion game, specifies the points the team had at game end, otherwise null
+	DominionVictoryScore int64 `json:"dominionVictoryScore,omitempty"`
+	// Number of times the team killed dragon
+	DragonKills int32 `json:"dragonKills,omitempty"`
+	// Flag indicating whether or not the team got the first baron kill
+	FirstBaron bool `json:"firstBaron,omitempty"`
+	// Flag indicating whether or not the team got first blood
+	FirstBlood bool `json:"firstBlood,omitempty"`
+	// Flag indicating whether or not the team got the first dragon kill
+	FirstDragon bool `json:"firstDragon,omitempty"`
+	// Flag indicating whether or not the team destroyed the first inhibitor
+	FirstInhibitor bool `json:"firstInhibitor,omitempty"`
+	// Flag indicating whether or not the team got the first rift herald kill
+	FirstRiftHerald bool `json:"firstRiftHerald,omitempty"`
+	// Flag indicating whether or not the team destroyed the first tower
+	FirstTower bool `json:"firstTower,omitempty"`
+	// Number of inhibitors the team destroyed
+	InhibitorKills int32 `json:"inhibitorKills,omitempty"`
+	// Number of times the team killed rift herald
+	RiftHeraldKills int32 `json:"riftHeraldKills,omitempty"`
+	// Team ID
+	TeamID int32 `json:"teamId,omitempty"`
+	// Number of towers the team destroyed
+	TowerKills int32 `json:"towerKills,omitempty"`
+	// Number of times the team killed vilemaw
+	VilemawKills int32 `json:"vilemawKills,omitempty"`
+	// Flag indicating whether or not the team won
+	Winner bool `json:"winner,omitempty"`
+}
+
+// Timeline - This object contains game timeline information
+//
+// resource: "match", original name: "Timeline"
+type Timeline struct {
+	// Time between each returned frame in milliseconds.
+	FrameInterval Millis `json:"frameInterval,omitempty"`
+	// List of timeline frames for the game.
+	Frames []*Frame `json:"frames,omitempty"`
+}
+
+// Mastery - This object contains mastery information
+//
+// resource: "match", original name: "Mastery"
+type UsedMastery struct {
+	// Mastery ID
+	MasteryID int32 `json:"masteryId,omitempty"`
+	// Mastery rank
+	Rank int64 `json:"rank,omitempty"`
+}
+
+// Rune - This object contains rune information
+//
+// resource: "match", original name: "Rune"
+type UsedRune struct {
+	// Rune rank
+	Rank int64 `json:"rank,omitempty"`
+	// Rune ID
+	RuneID int32 `json:"runeId,omitempty"`
+}
+
+// MatchesByTournementCall is a builder for "MatchesByTournement"
+type MatchesByTournementCall struct {
+	ctx        context.Context
+	client     *Client
+	query      url.Values
+	pathParams map[string]string
+	region     Region
+}
+
+var matchesByTournementInfo = &OperationInfo{
+	Name:     "MatchesByTournement",
+	Resource: "match",
+	Version:  "v2.2",
+	Method:   "GET",
+	Path:     "/api/lol/{region}/v2.2/match/by-tournament/{tournamentCode}/ids",
+	DocURL:   "https://developer.riotgames.com/api/methods#!/1058/3654",
+	Regions:  []Region{BR, EUNE, EUW, KR, LAN, LAS, NA, OCE, RU, TR},
+	Errors: []OperationError{
+		{400, "Bad request"},
+		{401, "Unauthorized"},
+		{404, "No matches found"},
+		{429, "Rate limit exceeded"},
+		{500, "Internal server error"},
+		{503, "Service unavailable"},
+	},
+}
+
+// Info returns information of the operation.
+func (c *MatchesByTournementCall) Info() *OperationInfo { return matchesByTournementInfo }
+
+// Retrieve match IDs by tournament code.
+//
+//
+// GET: /api/lol/{region}/v2.2/match/by-tournament/{tournamentCode}/ids
+//
+// Reference: https://developer.riotgames.com/api/methods#!/1058/3654
+func (c *Client) MatchesByTournement(ctx context.Context, region Region, tournamentCode string) *MatchesByTournementCall {
+	path := make(map[string]string)
+	path["tournamentCode"] = convertToString(tournamentCode)
+	return &MatchesByTournementCall{ctx: ctx, client: c, query: make(url.Values), pathParams: path, region: region}
+}
+
+func (c *MatchesByTournementCall) doRequest() (*http.Response, error) {
+	var body io.Reader
+	switch c.region {
+	case BR, EUNE, EUW, KR, LAN, LAS, NA, OCE, RU, TR:
+	default:
+		return nil, ErrNotSupportedRegion
+	}
+	c.query.Set("api_key", c.client.apiKey)
+	c.pathParams["region"] = c.region.Name()
+
+	path, err := uritemplates.Expand("/api/lol/{region}/v2.2/match/by-tournament/{tournamentCode}/ids", c.pathParams)
+	if err != nil {
+		return nil, err
+	}
+	urls := c.region.baseURL() + path + "?" + c.query.Encode()
+
+	return c.client.doRequest(c.ctx, matchesByTournementInfo, urls, body)
+}
+
+// Do executes api request.
+//
+// API Errors:
+//  400 - Bad request
+//  401 - Unauthorized
+//  404 - No matches found
+//  429 - Rate limit exceeded
+//  500 - Internal server error
+//  503 - Service unavailable
+func (c *MatchesByTournementCall) Do() ([]int64, error) {
+	res, err := c.doRequest()
+	if err != nil {
+		return nil, err
+	}
+	defer closeBody(res)
+	if err := verifyAPIResponse(res); err != nil {
+		return nil, err
+	}
+	ret := make([]int64, 0)
+	if err := json.NewDecoder(res.Body).Decode(&ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// MatchForTournementCall is a builder for "MatchForTournement"
+type MatchForTournementCall struct {
+	ctx        context.Context
+	client     *Client
+	query      url.Values
+	pathParams map[string]string
+	region     Region
+}
+
+var matchForTournementInfo = &OperationInfo{
+	Name:     "MatchForTournement",
+	Resource: "match",
+	Version:  "v2.2",
+	Method:   "GET",
+	Path:     "/api/lol/{region}/v2.2/match/for-tournament/{matchId}",
+	DocURL:   "https://developer.riotgames.com/api/methods#!/1058/3655",
+	Regions:  []Region{BR, EUNE, EUW, KR, LAN, LAS, NA, OCE, RU, TR},
+	Errors: []OperationError{
+		{400, "Invalid tournament code"},
+		{401, "Unauthorized"},
+		{404, "Match not found"},
+		{429, "Rate limit exceeded"},
+		{500, "Internal server error"},
+		{503, "Service unavailable"},
+	},
+}
+
+// Info returns information of the operation.
+func (c *MatchForTournementCall) Info() *OperationInfo { return matchForTournementInfo }
+
+// Retrieve match by match ID and tournament code.
+//
+//
+// GET: /api/lol/{region}/v2.2/match/for-tournament/{matchId}
+//
+// Reference: https://developer.riotgames.com/api/methods#!/1058/3655
+func (c *Client) MatchForTournement(ctx context.Context, region Region, matchID int64) *MatchForTournementCall {
+	path := make(map[string]string)
+	path["matchId"] = convertToString(matchID)
+	return &MatchForTournementCall{ctx: ctx, client: c, query: make(url.Values), pathParams: path, region: region}
+}
+
+// tournamentCode configures query parameter "tournamentCode".
+func (c *MatchForTournementCall) TournamentCode(v string) *MatchForTournementCall {
+	c.query.Set("tournamentCode", convertToString(v))
+	return c
+}
+
+// includeTimeline configures query parameter "includeTimeline".
+func (c *MatchForTournementCall) IncludeTimeline(v bool) *MatchForTournementCall {
+	c.query.Set("includeTimeline", convertToString(v))
+	return c
+}
+
+func (c *MatchForTournementCall) doRequest() (*http.Response, error) {
+	var body io.Reader
+	switch c.region {
+	case BR, EUNE, EUW, KR, LAN, LAS, NA, OCE, RU, TR:
+	default:
+		return nil, ErrNotSupportedRegion
+	}
+	c.query.Set("api_key", c.client.apiKey)
+	c.pathParams["region"] = c.region.Name()
+
+	path, err := uritemplates.Expand("/api/lol/{region}/v2.2/match/for-tournament/{matchId}", c.pathParams)
+	if err != nil {
+		return nil, err
+	}
+	urls := c.region.baseURL() + path + "?" + c.query.Encode()
+
+	return c.client.doRequest(c.ctx, matchForTournementInfo, urls, body)
+}
+
+// Do executes api request.
+//
+// API Errors:
+//  400 - Invalid tournament code
+//  401 - Unauthorized
+//  404 - Match not found
+//  429 - Rate limit exceeded
+//  500 - Internal server error
+//  503 - Service unavailable
+func (c *MatchForTournementCall) Do() (*MatchDetail, error) {
+	res, err := c.doRequest()
+	if err != nil {
+		return nil, err
+	}
+	defer closeBody(res)
+	if err := verifyAPIResponse(res); err != nil {
+		return nil, err
+	}
+	ret := &MatchDetail{}
+	if err := json.NewDecoder(res.Body).Decode(&ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// MatchCall is a builder for "Match"
+type MatchCall struct {
+	ctx        context.Context
+	client     *Client
+	query      url.Values
+	pathParams map[string]string
+	region     Region
+}
+
+var matchInfo = &OperationInfo{
+	Name:     "Match",
+	Resource: "match",
+	Version:  "v2.2",
+	Method:   "GET",
+	Path:     "/api/lol/{region}/v2.2/match/{matchId}",
+	DocURL:   "https://developer.riotgames.com/api/methods#!/1058/3656",
+	Regions:  []Region{BR, EUNE, EUW, KR, LAN, LAS, NA, OCE, RU, TR},
+	Errors: []OperationError{
+		{400, "Bad request"},
+		{401, "Unauthorized"},
+		{404, "Match not found"},
+		{429, "Rate limit exceeded"},
+		{500, "Internal server error"},
+		{503, "Service unavailable"},
+	},
+}
+
+// Info returns information of the operation.
+func (c *MatchCall) Info() *OperationInfo { return matchInfo }
+
+// Retrieve match by match ID.
+//
+//
+// Implementation notes: Not all matches have timeline data. If timeline data is requested, but doesn't exist, then the response won't include it.
+//
+// GET: /api/lol/{region}/v2.2/match/{matchId}
+//
+// Reference: https://developer.riotgames.com/api/methods#!/1058/3656
+func (c *Client) Match(ctx context.Context, region Region, matchID int64) *MatchCall {
+	path := make(map[string]string)
+	path["matchId"] = convertToString(matchID)
+	return &MatchCall{ctx: ctx, client: c, query: make(url.Values), pathParams: path, region: region}
+}
+
+// includeTimeline configures query parameter "includeTimeline".
+func (c *MatchCall) IncludeTimeline(v bool) *MatchCall {
+	c.query.Set("includeTimeline", convertToString(v))
+	return c
+}
+
+func (c *MatchCall) doRequest() (*http.Response, error) {
+	var body io.Reader
+	switch c.region {
+	case BR, EUNE, EUW, KR, LAN, LAS, NA, OCE, RU, TR:
+	default:
+		return nil, ErrNotSupportedRegion
+	}
+	c.query.Set("api_key", c.client.apiKey)
+	c.pathParams["region"] = c.region.Name()
+
+	path, err := uritemplates.Expand("/api/lol/{region}/v2.2/match/{matchId}", c.pathParams)
+	if err != nil {
+		return nil, err
+	}
+	urls := c.region.baseURL() + path + "?" + c.query.Encode()
+
+	return c.client.doRequest(c.ctx, matchInfo, urls, body)
+}
+
+// Do executes api request.
+//
+// API Errors:
+//  400 - Bad request
+//  401 - Unauthorized
+//  404 - Match not found
+//  429 - Rate limit exceeded
+//  500 - Internal server error
+//  503 - Service unavailable
+func (c *MatchCall) Do() (*MatchDetail, error) {
+	res, err := c.doRequest()
+	if err != nil {
+		return nil, err
+	}
+	defer closeBody(res)
+	if err := verifyAPIResponse(res); err != nil {
+		return nil, err
+	}
+	ret := &MatchDetail{}
+	if err := json.NewDecoder(res.Body).Decode(&ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
@@ -0,0 +1,44 @@
+// Generated by go-lol-generator. DO NOT EDIT.
+
+package lol
+
+import "testing"
+import "golang.org/x/net/context"
+
+func TestMatchOperations(t *testing.T) {
+	runOperationTests(t, []operationTest{
+		{
+			name:        "MatchesByTournement",
+			region:      NA,
+			unsupported: Global,
+			path:        "/api/lol/na/v2.2/match/by-tournament/example/ids",
+			apiKey:      true,
+			fixture:     "[1]",
+			do: func(c *Client, region Region) (interface{}, error) {
+				return c.MatchesByTournement(context.TODO(), region, "example").Do()
+			},
+		},
+		{
+			name:        "MatchForTournement",
+			region:      NA,
+			unsupported: Global,
+			path:        "/api/lol/na/v2.2/match/for-tournament/1",
+			apiKey:      true,
+			fixture:     "{\"mapId\":1,\"matchCreation\":1,\"matchDuration\":1,\"matchId\":1,\"matchMode\":\"example\",\"matchType\":\"example\",\"matchVersion\":\"example\",\"participantIdentities\":[{\"participantId\":1,\"player\":{\"matchHistoryUri\":\"example\",\"profileIcon\":1,\"summonerId\":1,\"summonerName\":\"example\"}}],\"participants\":[{\"championId\":1,\"highestAchievedSeasonTier\":\"example\",\"masteries\":[{\"masteryId\":1,\"rank\":1}],\"participantId\":1,\"runes\":[{\"rank\":1,\"runeId\":1}],\"spell1Id\":1,\"spell2Id\":1,\"stats\":{\"assists\":1,\"champLevel\":1,\"combatPlayerScore\":1,\"deaths\":1,\"doubleKills\":1,\"firstBloodAssist\":true,\"firstBloodKill\":true,\"firstInhibitorAssist\":true,\"firstInhibitorKill\":true,\"firstTowerAssist\":true,\"firstTowerKill\":true,\"goldEarned\":1,\"goldSpent\":1,\"inhibitorKills\":1,\"item0\":1,\"item1\":1,\"item2\":1,\"item3\":1,\"item4\":1,\"item5\":1,\"item6\":1,\"killingSprees\":1,\"kills\":1,\"largestCriticalStrike\":1,\"largestKillingSpree\":1,\"largestMultiKill\":1,\"magicDamageDealt\":1,\"magicDamageDealtToChampions\":1,\"magicDamageTaken\":1,\"minionsKilled\":1,\"neutralMinionsKilled\":1,\"neutralMinionsKilledEnemyJungle\":1,\"neutralMinionsKilledTeamJungle\":1,\"nodeCapture\":1,\"nodeCaptureAssist\":1,\"nodeNeutralize\":1,\"nodeNeutralizeAssist\":1,\"objectivePlayerScore\":1,\"pentaKills\":1,\"physicalDamageDealt\":1,\"physicalDamageDealtToChampions\":1,\"physicalDamageTaken\":1,\"quadraKills\":1,\"sightWardsBoughtInGame\":1,\"teamObjective\":1,\"totalDamageDealt\":1,\"totalDamageDealtToChampions\":1,\"totalDamageTaken\":1,\"totalHeal\":1,\"totalPlayerScore\":1,\"totalScoreRank\":1,\"totalTimeCrowdControlDealt\":1,\"totalUnitsHealed\":1,\"towerKills\":1,\"tripleKills\":1,\"trueDamageDealt\":1,\"trueDamageDealtToChampions\":1,\"trueDamageTaken\":1,\"unrealKills\":1,\"visionWardsBoughtInGame\":1,\"wardsKilled\":1,\"wardsPlaced\":1,\"winner\":true},\"teamId\":1,\"timeline\":{\"ancientGolemAssistsPerMinCounts\":{\"tenToTwenty\":1,\"thirtyToEnd\":1,\"twentyToThirty\":1,\"zeroToTen\":1},\"ancientGolemKillsPerMinCounts\":{\"tenToTwenty\":1,\"thirtyToEnd\":1,\"twentyToThirty\":1,\"zeroToTen\":1},\"assistedLaneDeathsPerMinDeltas\":{\"tenToTwenty\":1,\"thirtyToEnd\":1,\"twentyToThirty\":1,\"zeroToTen\":1},\"assistedLaneKillsPerMinDeltas\":{\"tenToTwenty\":1,\"thirtyToEnd\":1,\"twentyToThirty\":1,\"zeroToTen\":1},\"baronAssistsPerMinCounts\":{\"tenToTwenty\":1,\"thirtyToEnd\":1,\"twentyToThirty\":1,\"zeroToTen\":1},\"baronKillsPerMinCounts\":{\"tenToTwenty\":1,\"thirtyToEnd\":1,\"twentyToThirty\":1,\"zeroToTen\":1},\"creepsPerMinDeltas\":{\"tenToTwenty\":1,\"thirtyToEnd\":1,\"twentyToThirty\":1,\"zeroToTen\":1},\"csDiffPerMinDeltas\":{\"tenToTwenty\":1,\"thirtyToEnd\":1,\"twentyToThirty\":1,\"zeroToTen\":1},\"damageTakenDiffPerMinDeltas\":{\"tenToTwenty\":1,\"thirtyToEnd\":1,\"twentyToThirty\":1,\"zeroToTen\":1},\"damageTakenPerMinDeltas\":{\"tenToTwenty\":1,\"thirtyToEnd\":1,\"twentyToThirty\":1,\"zeroToTen\":1},\"dragonAssistsPerMinCounts\":{\"tenToTwenty\":1,\"thirtyToEnd\":1,\"twentyToThirty\":1,\"zeroToTen\":1},\"dragonKillsPerMinCounts\":{\"tenToTwenty\":1,\"thirtyToEnd\":1,\"twentyToThirty\":1,\"zeroToTen\":1},\"elderLizardAssistsPerMinCounts\":{\"tenToTwenty\":1,\"thirtyToEnd\":1,\"twentyToThirty\":1,\"zeroToTen\":1},\"elderLizardKillsPerMinCounts\":{\"tenToTwenty\":1,\"thirtyToEnd\":1,\"twentyToThirty\":1,\"zeroToTen\":1},\"goldPerMinDeltas\":{\"tenToTwenty\":1,\"thirtyToEnd\":1,\"twentyToThirty\":1,\"zeroToTen\":1},\"inhibitorAssistsPerMinCounts\":{\"tenToTwenty\":1,\"thirtyToEnd\":1,\"twentyToThirty\":1,\"zeroToTen\":1},\"inhibitorKillsPerMinCounts\":{\"tenToTwenty\":1,\"thirtyToEnd\":1,\"twentyToThirty\":1,\"zeroToTen\":1},\"lane\":\"example\",\"role\":\"example\",\"towerAssistsPerMinCounts\":{\"tenToTwenty\":1,\"thirtyToEnd\":1,\"twentyToThirty\":1,\"zeroToTen\":1},\"towerKillsPerMinCounts\":{\"tenToTwenty\":1,\"thirtyToEnd\":1,\"twentyToThirty\":1,\"zeroToTen\":1},\"towerKillsPerMinDeltas\":{\"tenToTwenty\":1,\"thirtyToEnd\":1,\"twentyToThirty\":1,\"zeroToTen\":1},\"vilemawAssistsPerMinCounts\":{\"tenToTwenty\":1,\"thirtyToEnd\":1,\"twentyToThirty\":1,\"zeroToTen\":1},\"vilemawKillsPerMinCounts\":{\"tenToTwenty\":1,\"thirtyToEnd\":1,\"twentyToThirty\":1,\"zeroToTen\":1},\"wardsPerMinDeltas\":{\"tenToTwenty\":1,\"thirtyToEnd\":1,\"twentyToThirty\":1,\"zeroToTen\":1},\"xpDiffPerMinDeltas\":{\"tenToTwenty\":1,\"thirtyToEnd\":1,\"twentyToThirty\":1,\"zeroToTen\":1},\"xpPerMinDeltas\":{\"tenToTwenty\":1,\"thirtyToEnd\":1,\"twentyToThirty\":1,\"zeroToTen\":1}}}],\"platformId\":\"example\",\"queueType\":\"example\",\"region\":\"example\",\"season\":\"example\",\"teams\":[{\"bans\":[{\"championId\":1,\"pickTurn\":1}],\"baronKills\":1,\"dominionVictoryScore\":1,\"dragonKills\":1,\"firstBaron\":true,\"firstBlood\":true,\"firstDragon\":true,\"firstInhibitor\":true,\"firstRiftHerald\":true,\"firstTower\":true,\"inhibitorKills\":1,\"riftHeraldKills\":1,\"teamId\":1,\"towerKills\":1,\"vilemawKills\":1,\"winner\":true}],\"timeline\":{\"frameInterval\":1,\"frames\":[{\"events\":[{\"ascendedType\":\"example\",\"assistingParticipantIds\":[1],\"buildingType\":\"example\",\"creatorId\":1,\"eventType\":\"example\",\"itemAfter\":1,\"itemBefore\":1,\"itemId\":1,\"killerId\":1,\"laneType\":\"example\",\"levelUpType\":\"example\",\"monsterType\":\"example\",\"participantId\":1,\"pointCaptured\":\"example\",\"position\":{\"x\":1,\"y\":1},\"skillSlot\":1,\"teamId\":1,\"timestamp\":1,\"towerType\":\"example\",\"victimId\":1,\"wardType\":\"example\"}],\"participantFrames\":{\"1\":{\"currentGold\":1,\"dominionScore\":1,\"jungleMinionsKilled\":1,\"level\":1,\"minionsKilled\":1,\"participantId\":1,\"position\":{\"x\":1,\"y\":1},\"teamScore\":1,\"totalGold\":1,\"xp\":1}},\"timestamp\":1}]}}",
+			do: func(c *Client, region Region) (interface{}, error) {
+				return c.MatchForTournement(context.TODO(), region, 1).Do()
+			},
+		},
+		{
+			name:        "Match",
+			region:      NA,
+			unsupported: Global,
+			path:        "/api/lol/na/v2.2/match/1",
+			apiKey:      true,
+			fixture:     "{\"mapId\":1,\"matchCreation\":1,\"matchDuration\":1,\"matchId\":1,\"matchMode\":\"example\",\"matchType\":\"example\",\"matchVersion\":\"example\",\"participantIdentities\":[{\"participantId\":1,\"player\":{\"matchHistoryUri\":\"example\",\"profileIcon\":1,\"summonerId\":1,\"summonerName\":\"example\"}}],\"participants\":[{\"championId\":1,\"highestAchievedSeasonTier\":\"example\",\"masteries\":[{\"masteryId\":1,\"rank\":1}],\"participantId\":1,\"runes\":[{\"rank\":1,\"runeId\":1}],\"spell1Id\":1,\"spell2Id\":1,\"stats\":{\"assists\":1,\"champLevel\":1,\"combatPlayerScore\":1,\"deaths\":1,\"doubleKills\":1,\"firstBloodAssist\":true,\"firstBloodKill\":true,\"firstInhibitorAssist\":true,\"firstInhibitorKill\":true,\"firstTowerAssist\":true,\"firstTowerKill\":true,\"goldEarned\":1,\"goldSpent\":1,\"inhibitorKills\":1,\"item0\":1,\"item1\":1,\"item2\":1,\"item3\":1,\"item4\":1,\"item5\":1,\"item6\":1,\"killingSprees\":1,\"kills\":1,\"largestCriticalStrike\":1,\"largestKillingSpree\":1,\"largestMultiKill\":1,\"magicDamageDealt\":1,\"magicDamageDealtToChampions\":1,\"magicDamageTaken\":1,\"minionsKilled\":1,\"neutralMinionsKilled\":1,\"neutralMinionsKilledEnemyJungle\":1,\"neutralMinionsKilledTeamJungle\":1,\"nodeCapture\":1,\"nodeCaptureAssist\":1,\"nodeNeutralize\":1,\"nodeNeutralizeAssist\":1,\"objectivePlayerScore\":1,\"pentaKills\":1,\"physicalDamageDealt\":1,\"physicalDamageDealtToChampions\":1,\"physicalDamageTaken\":1,\"quadraKills\":1,\"sightWardsBoughtInGame\":1,\"teamObjective\":1,\"totalDamageDealt\":1,\"totalDamageDealtToChampions\":1,\"totalDamageTaken\":1,\"totalHeal\":1,\"totalPlayerScore\":1,\"totalScoreRank\":1,\"totalTimeCrowdControlDealt\":1,\"totalUnitsHealed\":1,\"towerKills\":1,\"tripleKills\":1,\"trueDamageDealt\":1,\"trueDamageDealtToChampions\":1,\"trueDamageTaken\":1,\"unrealKills\":1,\"visionWardsBoughtInGame\":1,\"wardsKilled\":1,\"wardsPlaced\":1,\"winner\":true},\"teamId\":1,\"timeline\":{\"ancientGolemAssistsPerMinCounts\":{\"tenToTwenty\":1,\"thirtyToEnd\":1,\"twentyToThirty\":1,\"zeroToTen\":1},\"ancientGolemKillsPerMinCounts\":{\"tenToTwenty\":1,\"thirtyToEnd\":1,\"twentyToThirty\":1,\"zeroToTen\":1},\"assistedLaneDeathsPerMinDeltas\":{\"tenToTwenty\":1,\"thirtyToEnd\":1,\"twentyToThirty\":1,\"zeroToTen\":1},\"assistedLaneKillsPerMinDeltas\":{\"tenToTwenty\":1,\"thirtyToEnd\":1,\"twentyToThirty\":1,\"zeroToTen\":1},\"baronAssistsPerMinCounts\":{\"tenToTwenty\":1,\"thirtyToEnd\":1,\"twentyToThirty\":1,\"zeroToTen\":1},\"baronKillsPerMinCounts\":{\"tenToTwenty\":1,\"thirtyToEnd\":1,\"twentyToThirty\":1,\"zeroToTen\":1},\"creepsPerMinDeltas\":{\"tenToTwenty\":1,\"thirtyToEnd\":1,\"twentyToThirty\":1,\"zeroToTen\":1},\"csDiffPerMinDeltas\":{\"tenToTwenty\":1,\"thirtyToEnd\":1,\"twentyToThirty\":1,\"zeroToTen\":1},\"damageTakenDiffPerMinDeltas\":{\"tenToTwenty\":1,\"thirtyToEnd\":1,\"twentyToThirty\":1,\"zeroToTen\":1},\"damageTakenPerMinDeltas\":{\"tenToTwenty\":1,\"thirtyToEnd\":1,\"twentyToThirty\":1,\"zeroToTen\":1},\"dragonAssistsPerMinCounts\":{\"tenToTwenty\":1,\"thirtyToEnd\":1,\"twentyToThirty\":1,\"zeroToTen\":1},\"dragonKillsPerMinCounts\":{\"tenToTwenty\":1,\"thirtyToEnd\":1,\"twentyToThirty\":1,\"zeroToTen\":1},\"elderLizardAssistsPerMinCounts\":{\"tenToTwenty\":1,\"thirtyToEnd\":1,\"twentyToThirty\":1,\"zeroToTen\":1},\"elderLizardKillsPerMinCounts\":{\"tenToTwenty\":1,\"thirtyToEnd\":1,\"twentyToThirty\":1,\"zeroToTen\":1},\"goldPerMinDeltas\":{\"tenToTwenty\":1,\"thirtyToEnd\":1,\"twentyToThirty\":1,\"zeroToTen\":1},\"inhibitorAssistsPerMinCounts\":{\"tenToTwenty\":1,\"thirtyToEnd\":1,\"twentyToThirty\":1,\"zeroToTen\":1},\"inhibitorKillsPerMinCounts\":{\"tenToTwenty\":1,\"thirtyToEnd\":1,\"twentyToThirty\":1,\"zeroToTen\":1},\"lane\":\"example\",\"role\":\"example\",\"towerAssistsPerMinCounts\":{\"tenToTwenty\":1,\"thirtyToEnd\":1,\"twentyToThirty\":1,\"zeroToTen\":1},\"towerKillsPerMinCounts\":{\"tenToTwenty\":1,\"thirtyToEnd\":1,\"twentyToThirty\":1,\"zeroToTen\":1},\"towerKillsPerMinDeltas\":{\"tenToTwenty\":1,\"thirtyToEnd\":1,\"twentyToThirty\":1,\"zeroToTen\":1},\"vilemawAssistsPerMinCounts\":{\"tenToTwenty\":1,\"thirtyToEnd\":1,\"twentyToThirty\":1,\"zeroToTen\":1},\"vilemawKillsPerMinCounts\":{\"tenToTwenty\":1,\"thirtyToEnd\":1,\"twentyToThirty\":1,\"zeroToTen\":1},\"wardsPerMinDeltas\":{\"tenToTwenty\":1,\"thirtyToEnd\":1,\"twentyToThirty\":1,\"zeroToTen\":1},\"xpDiffPerMinDeltas\":{\"tenToTwenty\":1,\"thirtyToEnd\":1,\"twentyToThirty\":1,\"zeroToTen\":1},\"xpPerMinDeltas\":{\"tenToTwenty\":1,\"thirtyToEnd\":1,\"twentyToThirty\":1,\"zeroToTen\":1}}}],\"platformId\":\"example\",\"queueType\":\"example\",\"region\":\"example\",\"season\":\"example\",\"teams\":[{\"bans\":[{\"championId\":1,\"pickTurn\":1}],\"baronKills\":1,\"dominionVictoryScore\":1,\"dragonKills\":1,\"firstBaron\":true,\"firstBlood\":true,\"firstDragon\":true,\"firstInhibitor\":true,\"firstRiftHerald\":true,\"firstTower\":true,\"inhibitorKills\":1,\"riftHeraldKills\":1,\"teamId\":1,\"towerKills\":1,\"vilemawKills\":1,\"winner\":true}],\"timeline\":{\"frameInterval\":1,\"frames\":[{\"events\":[{\"ascendedType\":\"example\",\"assistingParticipantIds\":[1],\"buildingType\":\"example\",\"creatorId\":1,\"eventType\":\"example\",\"itemAfter\":1,\"itemBefore\":1,\"itemId\":1,\"killerId\":1,\"laneType\":\"example\",\"levelUpType\":\"example\",\"monsterType\":\"example\",\"participantId\":1,\"pointCaptured\":\"example\",\"position\":{\"x\":1,\"y\":1},\"skillSlot\":1,\"teamId\":1,\"timestamp\":1,\"towerType\":\"example\",\"victimId\":1,\"wardType\":\"example\"}],\"participantFrames\":{\"1\":{\"currentGold\":1,\"dominionScore\":1,\"jungleMinionsKilled\":1,\"level\":1,\"minionsKilled\":1,\"participantId\":1,\"position\":{\"x\":1,\"y\":1},\"teamScore\":1,\"totalGold\":1,\"xp\":1}},\"timestamp\":1}]}}",
+			do: func(c *Client, region Region) (interface{}, error) {
+				return c.Match(context.TODO(), region, 1).Do()
+			},
+		},
+	})
+}
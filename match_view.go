@@ -0,0 +1,167 @@
+package lol
+
+import (
+	"sort"
+	"strconv"
+)
+
+// MatchView joins participants of a MatchDetail with identities, teams and
+// timeline frames by participant id.
+//
+// Identities are hidden in some games (e.g. non-ranked games of others),
+// then Player of participants is nil and BySummonerID returns nil.
+type MatchView struct {
+	Match *MatchDetail
+
+	participants []*ParticipantView
+	byID         map[int32]*ParticipantView
+	bySummoner   map[int64]*ParticipantView
+}
+
+// ParticipantView is a participant of MatchView.
+type ParticipantView struct {
+	*MatchParticipant
+
+	// nil if identities are hidden.
+	Player *MatchPlayer
+	// nil if not found.
+	Team *Team
+}
+
+// NewMatchView returns a view of the match.
+func NewMatchView(m *MatchDetail) *MatchView {
+	v := &MatchView{
+		Match:      m,
+		byID:       make(map[int32]*ParticipantView),
+		bySummoner: make(map[int64]*ParticipantView),
+	}
+
+	for _, p := range m.Participants {
+		pv := &ParticipantView{MatchParticipant: p, Team: v.Team(p.TeamID)}
+		v.participants = append(v.participants, pv)
+		v.byID[p.ParticipantID] = pv
+	}
+	sort.Sort(participantViews(v.participants))
+
+	for _, identity := range m.ParticipantIdentities {
+		pv := v.byID[identity.ParticipantID]
+		if pv == nil || identity.Player == nil {
+			continue
+		}
+		pv.Player = identity.Player
+		v.bySummoner[identity.Player.SummonerID] = pv
+	}
+	return v
+}
+
+// HasIdentities returns true if players of participants are known.
+func (v *MatchView) HasIdentities() bool { return len(v.bySummoner) != 0 }
+
+// Participants returns participants sorted by participant id.
+func (v *MatchView) Participants() []*ParticipantView { return v.participants }
+
+// Participant returns a participant, or nil if not found.
+func (v *MatchView) Participant(participantID int32) *ParticipantView { return v.byID[participantID] }
+
+// BySummonerID returns a participant, or nil if not found or identities are hidden.
+func (v *MatchView) BySummonerID(summonerID int64) *ParticipantView { return v.bySummoner[summonerID] }
+
+// Team returns a team, or nil if not found.
+func (v *MatchView) Team(teamID int32) *Team {
+	for _, t := range v.Match.Teams {
+		if t.TeamID == teamID {
+			return t
+		}
+	}
+	return nil
+}
+
+// TeamParticipants returns participants of a team.
+func (v *MatchView) TeamParticipants(teamID int32) []*ParticipantView {
+	var ps []*ParticipantView
+	for _, p := range v.participants {
+		if p.TeamID == teamID {
+			ps = append(ps, p)
+		}
+	}
+	return ps
+}
+
+// ByPosition returns participants of a team with the lane and role.
+// e.g. "BOTTOM", "DUO_CARRY"
+func (v *MatchView) ByPosition(teamID int32, lane, role string) []*ParticipantView {
+	var ps []*ParticipantView
+	for _, p := range v.TeamParticipants(teamID) {
+		if p.Lane() == lane && p.Role() == role {
+			ps = append(ps, p)
+		}
+	}
+	return ps
+}
+
+// Opponent returns the participant of the other team with the same lane and role,
+// or nil if there is not exactly one.
+func (v *MatchView) Opponent(participantID int32) *ParticipantView {
+	p := v.byID[participantID]
+	if p == nil || p.Timeline == nil {
+		return nil
+	}
+
+	var opponent *ParticipantView
+	for _, o := range v.participants {
+		if o.TeamID != p.TeamID && o.Lane() == p.Lane() && o.Role() == p.Role() {
+			if opponent != nil {
+				return nil
+			}
+			opponent = o
+		}
+	}
+	return opponent
+}
+
+// Frames returns frames of a participant in the timeline.
+// It returns nil if the match has no timeline.
+func (v *MatchView) Frames(participantID int32) []*ParticipantFrame {
+	if v.Match.Timeline == nil {
+		return nil
+	}
+	key := strconv.Itoa(int(participantID))
+
+	var frames []*ParticipantFrame
+	for _, f := range v.Match.Timeline.Frames {
+		if pf := f.ParticipantFrames[key]; pf != nil {
+			frames = append(frames, pf)
+		}
+	}
+	return frames
+}
+
+// Lane returns ParticipantTimeline.Lane, or "" if unknown.
+func (p *ParticipantView) Lane() string {
+	if p.Timeline == nil {
+		return ""
+	}
+	return p.Timeline.Lane
+}
+
+// Role returns ParticipantTimeline.Role, or "" if unknown.
+func (p *ParticipantView) Role() string {
+	if p.Timeline == nil {
+		return ""
+	}
+	return p.Timeline.Role
+}
+
+// SummonerName returns the name of the player, or "" if identities are hidden.
+func (p *ParticipantView) SummonerName() string {
+	if p.Player == nil {
+		return ""
+	}
+	return p.Player.SummonerName
+}
+
+type participantViews []*ParticipantView
+
+func (ps participantViews) Len() int           { return len(ps) }
+func (ps participantViews) Swap(i, j int)      { ps[i], ps[j] = ps[j], ps[i] }
+func (ps participantViews) Less(i, j int) bool { return ps[i].ParticipantID < ps[j].ParticipantID }
@@ -0,0 +1,58 @@
+package lol
+
+import "testing"
+
+func newTestMatchDetail() *MatchDetail {
+	mid := &ParticipantTimeline{Lane: "MIDDLE", Role: "SOLO"}
+	bot := &ParticipantTimeline{Lane: "BOTTOM", Role: "DUO_CARRY"}
+	return &MatchDetail{
+		Participants: []*MatchParticipant{
+			{ParticipantID: 3, TeamID: 200, Timeline: bot},
+			{ParticipantID: 1, TeamID: 100, Timeline: mid},
+			{ParticipantID: 2, TeamID: 200, Timeline: mid},
+		},
+		ParticipantIdentities: []*MatchParticipantIdentity{
+			{ParticipantID: 1, Player: &MatchPlayer{SummonerID: 1001, SummonerName: "one"}},
+			{ParticipantID: 2, Player: &MatchPlayer{SummonerID: 1002, SummonerName: "two"}},
+			{ParticipantID: 3},
+		},
+		Teams: []*Team{{TeamID: 100, Winner: true}, {TeamID: 200}},
+		Timeline: &Timeline{Frames: []*Frame{
+			{ParticipantFrames: map[string]*ParticipantFrame{"1": {ParticipantID: 1, TotalGold: 500}}},
+			{ParticipantFrames: map[string]*ParticipantFrame{"1": {ParticipantID: 1, TotalGold: 800}}},
+		}},
+	}
+}
+
+func TestMatchView(t *testing.T) {
+	v := NewMatchView(newTestMatchDetail())
+
+	if ps := v.Participants(); len(ps) != 3 || ps[0].ParticipantID != 1 || ps[2].ParticipantID != 3 {
+		t.Fatalf("Expected participants sorted by id, got %v", ps)
+		return
+	}
+	if p := v.BySummonerID(1002); p == nil || p.ParticipantID != 2 || p.SummonerName() != "two" || p.Team.TeamID != 200 {
+		t.Errorf("Invalid participant %v", p)
+	}
+	if p := v.Participant(3); p.Player != nil || p.SummonerName() != "" {
+		t.Errorf("Expected hidden identity, got %v", p.Player)
+	}
+	if o := v.Opponent(1); o == nil || o.ParticipantID != 2 {
+		t.Errorf("Invalid opponent %v", o)
+	}
+	if o := v.Opponent(3); o != nil {
+		t.Errorf("Expected no opponent, got %v", o)
+	}
+	if frames := v.Frames(1); len(frames) != 2 || frames[1].TotalGold != 800 {
+		t.Errorf("Invalid frames %v", frames)
+	}
+	if !v.Team(100).Winner || len(v.TeamParticipants(200)) != 2 {
+		t.Error("Invalid teams")
+	}
+
+	m := newTestMatchDetail()
+	m.ParticipantIdentities = nil
+	if v := NewMatchView(m); v.HasIdentities() || v.BySummonerID(1001) != nil {
+		t.Error("Expected no identities")
+	}
+}
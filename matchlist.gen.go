@@ -0,0 +1,171 @@
+// Generated by go-lol-generator. DO NOT EDIT.
+
+package lol
+
+import "encoding/json"
+import "io"
+import "net/http"
+import "net/url"
+import "golang.org/x/net/context"
+import "github.com/go-lol/lol/uritemplates"
+
+// MatchList - This object contains match list information
+//
+// resource: "matchlist", original name: "MatchList"
+type MatchList struct {
+	EndIndex   int32             `json:"endIndex,omitempty"`
+	Matches    []*MatchReference `json:"matches,omitempty"`
+	StartIndex int32             `json:"startIndex,omitempty"`
+	TotalGames int32             `json:"totalGames,omitempty"`
+}
+
+// MatchReference - This object contains match reference information
+//
+// resource: "matchlist", original name: "MatchReference"
+type MatchReference struct {
+	Champion int32 `json:"champion,omitempty"`
+	// Legal values: MID, MIDDLE, TOP, JUNGLE, BOT, BOTTOM
+	Lane       string `json:"lane,omitempty"`
+	MatchID    int64  `json:"matchId,omitempty"`
+	PlatformID string `json:"platformId,omitempty"`
+	// Legal values: RANKED_SOLO_5x5, RANKED_TEAM_3x3, RANKED_TEAM_5x5
+	Queue  string `json:"queue,omitempty"`
+	Region string `json:"region,omitempty"`
+	// Legal values: DUO, NONE, SOLO, DUO_CARRY, DUO_SUPPORT
+	Role string `json:"role,omitempty"`
+	// Legal values: PRESEASON3, SEASON3, PRESEASON2014, SEASON2014, PRESEASON2015, SEASON2015, PRESEASON2016, SEASON2016
+	Season    string      `json:"season,omitempty"`
+	Timestamp EpochMillis `json:"timestamp,omitempty"`
+}
+
+// MatchesBySummonerIDCall is a builder for "MatchesBySummonerID"
+type MatchesBySummonerIDCall struct {
+	ctx        context.Context
+	client     *Client
+	query      url.Values
+	pathParams map[string]string
+	region     Region
+}
+
+var matchesBySummonerIDInfo = &OperationInfo{
+	Name:     "MatchesBySummonerID",
+	Resource: "matchlist",
+	Version:  "v2.2",
+	Method:   "GET",
+	Path:     "/api/lol/{region}/v2.2/matchlist/by-summoner/{summonerId}",
+	DocURL:   "https://developer.riotgames.com/api/methods#!/1053/3617",
+	Regions:  []Region{BR, EUNE, EUW, KR, LAN, LAS, NA, OCE, RU, TR},
+	Errors: []OperationError{
+		{400, "Bad request"},
+		{401, "Unauthorized"},
+		{404, "Game not found"},
+		{422, "Summoner has an entry, but hasn't played since the start of 2013"},
+		{429, "Rate limit exceeded"},
+		{500, "Internal server error"},
+		{503, "Service unavailable"},
+	},
+}
+
+// Info returns information of the operation.
+func (c *MatchesBySummonerIDCall) Info() *OperationInfo { return matchesBySummonerIDInfo }
+
+// Retrieve match list by summoner ID.
+//
+//
+// Implementation notes: A number of optional parameters are provided for filtering. It is up to the caller to ensure that the combination of filter parameters provided is valid for the requested summoner, otherwise, no matches may be returned. If either of the beginTime or endTime parameters is set, they must both be set, although there is no maximum limit on their range. If the beginTime parameter is specified on its own, endTime is assumed to be the current time. If the endTime parameter is specified on its own, beginTime is assumed to be the start of the summoner's match history.
+//
+// GET: /api/lol/{region}/v2.2/matchlist/by-summoner/{summonerId}
+//
+// Reference: https://developer.riotgames.com/api/methods#!/1053/3617
+func (c *Client) MatchesBySummonerID(ctx context.Context, region Region, summonerID int64) *MatchesBySummonerIDCall {
+	path := make(map[string]string)
+	path["summonerId"] = convertToString(summonerID)
+	return &MatchesBySummonerIDCall{ctx: ctx, client: c, query: make(url.Values), pathParams: path, region: region}
+}
+
+// championIDs configures query parameter "championIds".
+func (c *MatchesBySummonerIDCall) ChampionIDs(v string) *MatchesBySummonerIDCall {
+	c.query.Set("championIDs", convertToString(v))
+	return c
+}
+
+// rankedQueues configures query parameter "rankedQueues".
+func (c *MatchesBySummonerIDCall) RankedQueues(v string) *MatchesBySummonerIDCall {
+	c.query.Set("rankedQueues", convertToString(v))
+	return c
+}
+
+// seasons configures query parameter "seasons".
+func (c *MatchesBySummonerIDCall) Seasons(v string) *MatchesBySummonerIDCall {
+	c.query.Set("seasons", convertToString(v))
+	return c
+}
+
+// beginTime configures query parameter "beginTime".
+func (c *MatchesBySummonerIDCall) BeginTime(v int64) *MatchesBySummonerIDCall {
+	c.query.Set("beginTime", convertToString(v))
+	return c
+}
+
+// endTime configures query parameter "endTime".
+func (c *MatchesBySummonerIDCall) EndTime(v int64) *MatchesBySummonerIDCall {
+	c.query.Set("endTime", convertToString(v))
+	return c
+}
+
+// beginIndex configures query parameter "beginIndex".
+func (c *MatchesBySummonerIDCall) BeginIndex(v int32) *MatchesBySummonerIDCall {
+	c.query.Set("beginIndex", convertToString(v))
+	return c
+}
+
+// endIndex configures query parameter "endIndex".
+func (c *MatchesBySummonerIDCall) EndIndex(v int32) *MatchesBySummonerIDCall {
+	c.query.Set("endIndex", convertToString(v))
+	return c
+}
+
+func (c *MatchesBySummonerIDCall) doRequest() (*http.Response, error) {
+	var body io.Reader
+	switch c.region {
+	case BR, EUNE, EUW, KR, LAN, LAS, NA, OCE, RU, TR:
+	default:
+		return nil, ErrNotSupportedRegion
+	}
+	c.query.Set("api_key", c.client.apiKey)
+	c.pathParams["region"] = c.region.Name()
+
+	path, err := uritemplates.Expand("/api/lol/{region}/v2.2/matchlist/by-summoner/{summonerId}", c.pathParams)
+	if err != nil {
+		return nil, err
+	}
+	urls := c.region.baseURL() + path + "?" + c.query.Encode()
+
+	return c.client.doRequest(c.ctx, matchesBySummonerIDInfo, urls, body)
+}
+
+// Do executes api request.
+//
+// API Errors:
+//  400 - Bad request
+//  401 - Unauthorized
+//  404 - Game not found
+//  422 - Summoner has an entry, but hasn't played since the start of 2013
+//  429 - Rate limit exceeded
+//  500 - Internal server error
+//  503 - Service unavailable
+func (c *MatchesBySummonerIDCall) Do() (*MatchList, error) {
+	res, err := c.doRequest()
+	if err != nil {
+		return nil, err
+	}
+	defer closeBody(res)
+	if err := verifyAPIResponse(res); err != nil {
+		return nil, err
+	}
+	ret := &MatchList{}
+	if err := json.NewDecoder(res.Body).Decode(&ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
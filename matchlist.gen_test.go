@@ -0,0 +1,22 @@
+// Generated by go-lol-generator. DO NOT EDIT.
+
+package lol
+
+import "testing"
+import "golang.org/x/net/context"
+
+func TestMatchlistOperations(t *testing.T) {
+	runOperationTests(t, []operationTest{
+		{
+			name:        "MatchesBySummonerID",
+			region:      NA,
+			unsupported: Global,
+			path:        "/api/lol/na/v2.2/matchlist/by-summoner/1",
+			apiKey:      true,
+			fixture:     "{\"endIndex\":1,\"matches\":[{\"champion\":1,\"lane\":\"example\",\"matchId\":1,\"platformId\":\"example\",\"queue\":\"example\",\"region\":\"example\",\"role\":\"example\",\"season\":\"example\",\"timestamp\":1}],\"startIndex\":1,\"totalGames\":1}",
+			do: func(c *Client, region Region) (interface{}, error) {
+				return c.MatchesBySummonerID(context.TODO(), region, 1).Do()
+			},
+		},
+	})
+}
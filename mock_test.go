@@ -0,0 +1,164 @@
+package lol
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"golang.org/x/net/context"
+)
+
+const mockAPIKey = "test-api-key"
+
+// mockTransport sends all requests to a local server.
+type mockTransport struct {
+	host string
+	// Operation of the last request.
+	op *OperationInfo
+}
+
+func (t *mockTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	t.op, _ = OperationFromContext(req.Context())
+	req.URL.Scheme = "http"
+	req.URL.Host = t.host
+	return http.DefaultTransport.RoundTrip(req)
+}
+
+// newMockClient returns a client which sends all requests to handler.
+// Call close after use.
+func newMockClient(handler http.Handler) (c *Client, close func()) {
+	srv := httptest.NewServer(handler)
+	httpClient := &http.Client{Transport: &mockTransport{host: srv.Listener.Addr().String()}}
+
+	c, err := New(func(context.Context) *http.Client { return httpClient }, mockAPIKey)
+	if err != nil {
+		panic(err)
+	}
+	return c, srv.Close
+}
+
+// operationTest is a test case of an operation. See *.gen_test.go
+type operationTest struct {
+	name string
+	// Supported region.
+	region Region
+	// Region which is not supported. Zero if all regions are supported.
+	unsupported Region
+	// Expected path of request.
+	path    string
+	apiKey  bool
+	fixture string
+
+	do func(c *Client, region Region) (interface{}, error)
+}
+
+func runOperationTests(t *testing.T, tests []operationTest) {
+	for _, tt := range tests {
+		var called bool
+		c, close := newMockClient(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			called = true
+			if r.URL.Path != tt.path {
+				t.Errorf("%s: Expected path %s, got %s", tt.name, tt.path, r.URL.Path)
+			}
+			if key := r.URL.Query().Get("api_key"); tt.apiKey && key != mockAPIKey {
+				t.Errorf("%s: Invalid api key %q", tt.name, key)
+			}
+
+			w.Header().Set("Content-Type", "application/json")
+			io.WriteString(w, tt.fixture)
+		}))
+
+		ret, err := tt.do(c, tt.region)
+		if err != nil {
+			t.Errorf("%s: %v", tt.name, err)
+		} else if !called {
+			t.Errorf("%s: Request was not sent", tt.name)
+		} else if op := c.getClient(nil).Transport.(*mockTransport).op; op != LookupOperation(tt.name) {
+			t.Errorf("%s: Invalid operation %v in request context", tt.name, op)
+		} else if err := checkDecoded(ret, tt.fixture); err != nil {
+			t.Errorf("%s: %v", tt.name, err)
+		}
+
+		if tt.unsupported != 0 {
+			called = false
+			if _, err := tt.do(c, tt.unsupported); err != ErrNotSupportedRegion {
+				t.Errorf("%s: Expected ErrNotSupportedRegion for %s, got %v", tt.name, tt.unsupported, err)
+			} else if called {
+				t.Errorf("%s: Request was sent for %s", tt.name, tt.unsupported)
+			}
+		}
+
+		close()
+	}
+}
+
+// checkDecoded returns an error if ret is not encoded back to fixture,
+// which has a value for each field.
+func checkDecoded(ret interface{}, fixture string) error {
+	data, err := json.Marshal(ret)
+	if err != nil {
+		return err
+	}
+
+	var want, got interface{}
+	if err := json.Unmarshal([]byte(fixture), &want); err != nil {
+		return err
+	}
+	if err := json.Unmarshal(data, &got); err != nil {
+		return err
+	}
+	if !reflect.DeepEqual(dropNulls(want), got) {
+		return fmt.Errorf("failed to decode %s, got %s", fixture, data)
+	}
+	return nil
+}
+
+// dropNulls removes null fields of objects, which are omitted when encoded.
+// Fixtures have nulls for recursive types.
+func dropNulls(v interface{}) interface{} {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		for k, e := range v {
+			if e == nil {
+				delete(v, k)
+			} else {
+				v[k] = dropNulls(e)
+			}
+		}
+	case []interface{}:
+		for i, e := range v {
+			v[i] = dropNulls(e)
+		}
+	}
+	return v
+}
+
+func TestOperations(t *testing.T) {
+	for name, info := range Operations {
+		if info.Name != name || info.Method == "" || info.Path == "" || len(info.Regions) == 0 {
+			t.Errorf("Invalid info %#v", info)
+		}
+	}
+
+	info := LookupOperation("Summoners")
+	if info == nil || info.Resource != "summoner" || !info.SupportsRegion(NA) {
+		t.Fatalf("Invalid Summoners %#v", info)
+		return
+	}
+	if info.Error(404) == nil {
+		t.Errorf("Expected documented 404 of Summoners, got %v", info.Errors)
+	}
+
+	c, err := New(nil, mockAPIKey)
+	if err != nil {
+		t.Fatal(err)
+		return
+	}
+	if call := c.Summoners(context.TODO(), NA, []int64{1}); call.Info() != info {
+		t.Errorf("Expected %v, got %v", info, call.Info())
+	}
+}
@@ -0,0 +1,128 @@
+package lol
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+
+	"golang.org/x/net/context"
+)
+
+// OperationInfo describes an api operation.
+type OperationInfo struct {
+	// Name of the method of Client. e.g. "Summoners"
+	//
+	// Old versions of a resource kept during deprecation windows
+	// have a version suffix. e.g. "MatchV2_2"
+	Name string
+
+	// Resource id and version. e.g. "summoner", "v1.4"
+	Resource, Version string
+
+	// HTTP method and path template.
+	// e.g. "GET", "/api/lol/{region}/v1.4/summoner/{summonerIds}"
+	Method, Path string
+
+	// Reference of the operation.
+	DocURL string
+
+	RateLimitNotes string
+	// True if requests are not counted in the rate limit. See RateLimiter
+	RateLimitExempt bool
+
+	// Regions supporting the operation.
+	Regions []Region
+
+	// Documented errors.
+	Errors []OperationError
+}
+
+// OperationError is a documented error of an operation.
+type OperationError struct {
+	// HTTP status code.
+	Code int
+	Desc string
+}
+
+// SupportsRegion returns true if the operation works for region r.
+func (info *OperationInfo) SupportsRegion(r Region) bool {
+	for _, region := range info.Regions {
+		if region == r {
+			return true
+		}
+	}
+	return false
+}
+
+// Error returns the documented error for HTTP status code, or nil.
+func (info *OperationInfo) Error(code int) *OperationError {
+	for i := range info.Errors {
+		if info.Errors[i].Code == code {
+			return &info.Errors[i]
+		}
+	}
+	return nil
+}
+
+// String implements fmt.Stringer
+func (info *OperationInfo) String() string {
+	return info.Resource + "-" + info.Version + " " + info.Name
+}
+
+// LookupOperation returns info of an operation by the name of Client method.
+// It returns nil if not found.
+func LookupOperation(name string) *OperationInfo {
+	return Operations[name]
+}
+
+type operationKey struct{}
+
+// OperationFromContext returns info of the operation sending a request.
+//
+// The context of requests sent by Client carries it,
+// so http.RoundTripper can use it like:
+//	info, ok := lol.OperationFromContext(req.Context())
+func OperationFromContext(ctx context.Context) (*OperationInfo, bool) {
+	info, ok := ctx.Value(operationKey{}).(*OperationInfo)
+	return info, ok
+}
+
+func withOperation(ctx context.Context, info *OperationInfo) context.Context {
+	return context.WithValue(ctx, operationKey{}, info)
+}
+
+// ResourceVersions returns versions of a resource supported by Client,
+// sorted in ascending order.
+func ResourceVersions(resource string) []string {
+	set := make(map[string]bool)
+	for _, info := range Operations {
+		if info.Resource == resource {
+			set[info.Version] = true
+		}
+	}
+
+	versions := make([]string, 0, len(set))
+	for v := range set {
+		versions = append(versions, v)
+	}
+	sort.Sort(byVersion(versions))
+	return versions
+}
+
+// byVersion sorts versions like "v1.4", "v1.10", "v2.2".
+type byVersion []string
+
+func (vs byVersion) Len() int      { return len(vs) }
+func (vs byVersion) Swap(i, j int) { vs[i], vs[j] = vs[j], vs[i] }
+func (vs byVersion) Less(i, j int) bool {
+	a := strings.Split(strings.TrimPrefix(vs[i], "v"), ".")
+	b := strings.Split(strings.TrimPrefix(vs[j], "v"), ".")
+	for k := 0; k < len(a) && k < len(b); k++ {
+		x, _ := strconv.Atoi(a[k])
+		y, _ := strconv.Atoi(b[k])
+		if x != y {
+			return x < y
+		}
+	}
+	return len(a) < len(b)
+}
@@ -0,0 +1,304 @@
+package lol
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Tier is a ranked tier. Higher tiers are greater.
+type Tier int
+
+// Tiers.
+const (
+	TierUnranked Tier = iota
+	TierBronze
+	TierSilver
+	TierGold
+	TierPlatinum
+	TierDiamond
+	TierMaster
+	TierChallenger
+)
+
+var tierNames = []string{"UNRANKED", "BRONZE", "SILVER", "GOLD", "PLATINUM", "DIAMOND", "MASTER", "CHALLENGER"}
+
+// ParseTier parses League.Tier. e.g. "GOLD"
+func ParseTier(s string) (Tier, error) {
+	for i, name := range tierNames {
+		if strings.EqualFold(s, name) {
+			return Tier(i), nil
+		}
+	}
+	return TierUnranked, fmt.Errorf("lol: invalid tier %q", s)
+}
+
+// HasDivisions returns false for TierUnranked, TierMaster and TierChallenger.
+func (t Tier) HasDivisions() bool { return t >= TierBronze && t <= TierDiamond }
+
+func (t Tier) String() string {
+	if t < 0 || int(t) >= len(tierNames) {
+		return "Tier(" + strconv.Itoa(int(t)) + ")"
+	}
+	return tierNames[t]
+}
+
+// Division is a division of a tier, from 1 (I) to 5 (V).
+// Lower divisions are higher ranks. 0 if the tier has no divisions.
+type Division int
+
+var divisionNames = []string{"", "I", "II", "III", "IV", "V"}
+
+// ParseDivision parses LeagueEntry.Division. e.g. "III"
+func ParseDivision(s string) (Division, error) {
+	for i, name := range divisionNames {
+		if i != 0 && s == name {
+			return Division(i), nil
+		}
+	}
+	return 0, fmt.Errorf("lol: invalid division %q", s)
+}
+
+func (d Division) String() string {
+	if d < 0 || int(d) >= len(divisionNames) {
+		return "Division(" + strconv.Itoa(int(d)) + ")"
+	}
+	return divisionNames[d]
+}
+
+// SeriesResult is a game of a promotion series.
+type SeriesResult byte
+
+// Series results in MiniSeries.Progress.
+const (
+	SeriesWin       SeriesResult = 'W'
+	SeriesLoss      SeriesResult = 'L'
+	SeriesNotPlayed SeriesResult = 'N'
+)
+
+// PromotionSeries is a state of a promotion series.
+type PromotionSeries struct {
+	Wins, Losses int32
+	// Wins needed to promote.
+	Target int32
+	// A result of each game. e.g. "WLN"
+	Progress string
+}
+
+// NewPromotionSeries returns a series of ms, or nil if ms is nil.
+func NewPromotionSeries(ms *MiniSeries) *PromotionSeries {
+	if ms == nil {
+		return nil
+	}
+	return &PromotionSeries{Wins: ms.Wins, Losses: ms.Losses, Target: ms.Target, Progress: ms.Progress}
+}
+
+// Results returns a result of each game.
+func (s *PromotionSeries) Results() []SeriesResult { return []SeriesResult(s.Progress) }
+
+// Games returns the maximum number of games. e.g. 3 for best of 3
+func (s *PromotionSeries) Games() int32 { return int32(len(s.Progress)) }
+
+// Remaining returns the number of games not played.
+func (s *PromotionSeries) Remaining() int32 {
+	return int32(strings.Count(s.Progress, string(SeriesNotPlayed)))
+}
+
+// Promoted returns true if the series is won.
+func (s *PromotionSeries) Promoted() bool { return s.Target != 0 && s.Wins >= s.Target }
+
+// Failed returns true if the series can not be won anymore.
+func (s *PromotionSeries) Failed() bool { return s.Losses > s.Games()-s.Target }
+
+// Rank is a tier, a division and league points, with a promotion series if any.
+type Rank struct {
+	Tier     Tier
+	Division Division
+	LP       int32
+	// nil if not in a promotion series.
+	Series *PromotionSeries
+}
+
+// NewRank returns a rank of an entry in a league.
+func NewRank(l *League, e *LeagueEntry) (Rank, error) {
+	return ParseRank(l.Tier, e.Division, e.LeaguePoints, e.MiniSeries)
+}
+
+// ParseRank parses a rank from fields of league DTOs.
+// Division is ignored for tiers without divisions.
+func ParseRank(tier, division string, lp int32, ms *MiniSeries) (Rank, error) {
+	t, err := ParseTier(tier)
+	if err != nil {
+		return Rank{}, err
+	}
+	r := Rank{Tier: t, LP: lp, Series: NewPromotionSeries(ms)}
+	if t.HasDivisions() {
+		if r.Division, err = ParseDivision(division); err != nil {
+			return Rank{}, err
+		}
+	}
+	return r, nil
+}
+
+// Compare returns -1, 0 or +1 if r is lower than, equal to or higher than o.
+// Ranks are ordered by tier, division, LP, then wins and losses in the series.
+// A zero division of a tier with divisions is V.
+func (r Rank) Compare(o Rank) int {
+	switch {
+	case r.Tier != o.Tier:
+		return sign(int64(r.Tier) - int64(o.Tier))
+	case r.division() != o.division():
+		return sign(int64(o.division()) - int64(r.division()))
+	case r.LP != o.LP:
+		return sign(int64(r.LP) - int64(o.LP))
+	}
+
+	var rw, rl, ow, ol int32
+	if r.Series != nil {
+		rw, rl = r.Series.Wins, r.Series.Losses
+	}
+	if o.Series != nil {
+		ow, ol = o.Series.Wins, o.Series.Losses
+	}
+	if rw != ow {
+		return sign(int64(rw - ow))
+	}
+	return sign(int64(ol - rl))
+}
+
+// Less returns true if r is lower than o.
+func (r Rank) Less(o Rank) bool { return r.Compare(o) < 0 }
+
+// Ranks implements sort.Interface, from the lowest to the highest.
+type Ranks []Rank
+
+func (rs Ranks) Len() int           { return len(rs) }
+func (rs Ranks) Swap(i, j int)      { rs[i], rs[j] = rs[j], rs[i] }
+func (rs Ranks) Less(i, j int) bool { return rs[i].Less(rs[j]) }
+
+// LPToNext returns LP needed to play the promotion series of the next division,
+// 0 if in the series. It returns false for tiers without divisions.
+func (r Rank) LPToNext() (int32, bool) {
+	if !r.Tier.HasDivisions() {
+		return 0, false
+	}
+	if r.Series != nil || r.LP >= 100 {
+		return 0, true
+	}
+	return 100 - r.LP, true
+}
+
+// apexTierLP is LP of the Master band of Value. LP of apex tiers has no limit,
+// but no player has come close to it.
+const apexTierLP = 10000
+
+// Value returns an MMR-like number for sorting, which is LP earned since Bronze V 0 LP,
+// counting 100 LP per division. Unranked is -1. Master starts at Diamond I 100 LP,
+// and Challenger starts apexTierLP above Master 0 LP, so values follow Compare
+// except for promotion series.
+func (r Rank) Value() int64 {
+	switch r.Tier {
+	case TierUnranked:
+		return -1
+	case TierMaster:
+		return 2500 + int64(r.LP)
+	case TierChallenger:
+		return 2500 + apexTierLP + int64(r.LP)
+	default:
+		return int64(r.Tier-TierBronze)*500 + int64(5-r.division())*100 + int64(r.LP)
+	}
+}
+
+// division returns the division, or V if it's zero in a tier with divisions.
+func (r Rank) division() Division {
+	if r.Division == 0 && r.Tier.HasDivisions() {
+		return 5
+	}
+	return r.Division
+}
+
+// String returns the rank as text. e.g. "GOLD III 45LP", "PLATINUM V 100LP WLN", "MASTER 120LP"
+func (r Rank) String() string {
+	if r.Tier == TierUnranked {
+		return r.Tier.String()
+	}
+	parts := []string{r.Tier.String()}
+	if r.Division != 0 {
+		parts = append(parts, r.Division.String())
+	}
+	parts = append(parts, strconv.Itoa(int(r.LP))+"LP")
+	if r.Series != nil {
+		parts = append(parts, r.Series.Progress)
+	}
+	return strings.Join(parts, " ")
+}
+
+// MarshalText returns String.
+func (r Rank) MarshalText() ([]byte, error) { return []byte(r.String()), nil }
+
+// UnmarshalText parses String. Wins, losses and target of the series
+// are restored from the progress.
+func (r *Rank) UnmarshalText(text []byte) error {
+	fields := strings.Fields(string(text))
+	if len(fields) == 0 {
+		return fmt.Errorf("lol: invalid rank %q", text)
+	}
+
+	t, err := ParseTier(fields[0])
+	if err != nil {
+		return err
+	}
+	rank := Rank{Tier: t}
+	fields = fields[1:]
+
+	if t.HasDivisions() {
+		if len(fields) == 0 {
+			return fmt.Errorf("lol: invalid rank %q", text)
+		}
+		if rank.Division, err = ParseDivision(fields[0]); err != nil {
+			return err
+		}
+		fields = fields[1:]
+	}
+
+	if t != TierUnranked {
+		if len(fields) == 0 || !strings.HasSuffix(fields[0], "LP") {
+			return fmt.Errorf("lol: invalid rank %q", text)
+		}
+		lp, err := strconv.ParseInt(strings.TrimSuffix(fields[0], "LP"), 10, 32)
+		if err != nil {
+			return fmt.Errorf("lol: invalid rank %q", text)
+		}
+		rank.LP = int32(lp)
+		fields = fields[1:]
+	}
+
+	if len(fields) == 1 && strings.Trim(fields[0], "WLN") == "" {
+		rank.Series = parseProgress(fields[0])
+	} else if len(fields) != 0 {
+		return fmt.Errorf("lol: invalid rank %q", text)
+	}
+	*r = rank
+	return nil
+}
+
+// parseProgress restores a series from the progress, assuming best of 3 or 5.
+func parseProgress(progress string) *PromotionSeries {
+	s := &PromotionSeries{
+		Progress: progress,
+		Target:   int32(len(progress))/2 + 1,
+	}
+	s.Wins = int32(strings.Count(progress, string(SeriesWin)))
+	s.Losses = int32(strings.Count(progress, string(SeriesLoss)))
+	return s
+}
+
+func sign(n int64) int {
+	switch {
+	case n < 0:
+		return -1
+	case n > 0:
+		return 1
+	}
+	return 0
+}
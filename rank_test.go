@@ -0,0 +1,114 @@
+package lol
+
+import (
+	"encoding/json"
+	"sort"
+	"testing"
+)
+
+func TestRank(t *testing.T) {
+	l := &League{Tier: "PLATINUM"}
+	r, err := NewRank(l, &LeagueEntry{Division: "V", LeaguePoints: 100, MiniSeries: &MiniSeries{Wins: 1, Losses: 1, Target: 2, Progress: "WLN"}})
+	if err != nil {
+		t.Fatal(err)
+		return
+	}
+	if r.Tier != TierPlatinum || r.Division != 5 || r.Series.Remaining() != 1 || r.Series.Promoted() || r.Series.Failed() {
+		t.Errorf("Invalid rank %+v", r)
+	}
+	if lp, ok := r.LPToNext(); !ok || lp != 0 {
+		t.Errorf("Expected 0 LP in series, got %v %v", lp, ok)
+	}
+	if r.String() != "PLATINUM V 100LP WLN" {
+		t.Errorf("Invalid text %q", r.String())
+	}
+
+	master, _ := ParseRank("MASTER", "I", 120, nil)
+	if master.Division != 0 || master.String() != "MASTER 120LP" {
+		t.Errorf("Invalid master %v", master)
+	}
+	if _, ok := master.LPToNext(); ok {
+		t.Error("Expected no next division of master")
+	}
+	if _, err := ParseRank("GOLD", "VI", 0, nil); err == nil {
+		t.Error("Expected an error for invalid division")
+	}
+}
+
+func TestRankOrder(t *testing.T) {
+	var ranks Ranks
+	for _, s := range []string{"MASTER 0LP", "GOLD I 0LP", "GOLD III 99LP", "UNRANKED", "GOLD I 100LP WNN", "GOLD I 100LP LNN", "CHALLENGER 0LP"} {
+		var r Rank
+		if err := r.UnmarshalText([]byte(s)); err != nil {
+			t.Fatal(err)
+			return
+		}
+		ranks = append(ranks, r)
+	}
+	sort.Sort(ranks)
+
+	expected := []string{"UNRANKED", "GOLD III 99LP", "GOLD I 0LP", "GOLD I 100LP LNN", "GOLD I 100LP WNN", "MASTER 0LP", "CHALLENGER 0LP"}
+	for i, r := range ranks {
+		if r.String() != expected[i] {
+			t.Errorf("Expected %v at %d, got %v", expected[i], i, r)
+		}
+	}
+	if ranks[0].Value() != -1 || ranks[1].Value() != 1000+299 || ranks[5].Value() != 2500 {
+		t.Errorf("Invalid values %v %v %v", ranks[0].Value(), ranks[1].Value(), ranks[5].Value())
+	}
+}
+
+func TestRankZeroDivision(t *testing.T) {
+	gold, goldV := Rank{Tier: TierGold, LP: 50}, Rank{Tier: TierGold, Division: 5, LP: 50}
+	if gold.Value() != goldV.Value() || gold.Compare(goldV) != 0 {
+		t.Errorf("Expected %v equal to %v, got values %d and %d", gold, goldV, gold.Value(), goldV.Value())
+	}
+	if platinum := (Rank{Tier: TierPlatinum, Division: 5}); gold.Value() >= platinum.Value() || !gold.Less(platinum) {
+		t.Errorf("Expected %v lower than %v", gold, platinum)
+	}
+}
+
+func TestRankValue(t *testing.T) {
+	// The lowest and the highest ranks of each division.
+	ranks := Ranks{{Tier: TierUnranked}}
+	for tier := TierBronze; tier <= TierDiamond; tier++ {
+		for d := Division(5); d >= 1; d-- {
+			ranks = append(ranks, Rank{Tier: tier, Division: d}, Rank{Tier: tier, Division: d, LP: 100})
+		}
+	}
+	ranks = append(ranks, Rank{Tier: TierMaster}, Rank{Tier: TierMaster, LP: 1500}, Rank{Tier: TierChallenger}, Rank{Tier: TierChallenger, LP: 1500})
+
+	if !sort.IsSorted(ranks) {
+		t.Fatalf("Expected sorted ranks %v", ranks)
+		return
+	}
+	for i := 1; i < len(ranks); i++ {
+		a, b := ranks[i-1], ranks[i]
+		// Promoting at 100 LP keeps the value.
+		promotion := a.LP == 100 && b.LP == 0
+		if a.Value() > b.Value() || a.Value() == b.Value() && !promotion {
+			t.Errorf("Expected value of %v (%d) lower than %v (%d)", a, a.Value(), b, b.Value())
+		}
+	}
+}
+
+func TestRankJSON(t *testing.T) {
+	r, _ := ParseRank("SILVER", "II", 42, &MiniSeries{Progress: "WLWNN", Wins: 2, Losses: 1, Target: 3})
+	b, err := json.Marshal(r)
+	if err != nil || string(b) != `"SILVER II 42LP WLWNN"` {
+		t.Fatalf("Invalid json %s %v", b, err)
+		return
+	}
+
+	var decoded Rank
+	if err := json.Unmarshal(b, &decoded); err != nil {
+		t.Fatal(err)
+		return
+	}
+	if decoded.Compare(r) != 0 || *decoded.Series != *r.Series {
+		t.Errorf("Expected %+v, got %+v", r, decoded)
+	}
+	if err := decoded.UnmarshalText([]byte("SILVER 42LP")); err == nil {
+		t.Error("Expected an error without division")
+	}
+}
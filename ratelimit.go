@@ -0,0 +1,107 @@
+package lol
+
+import (
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"golang.org/x/net/context"
+)
+
+// RateLimiter limits requests counted in the rate limit of an api key.
+//
+// Client does not call Wait for operations with RateLimitExempt, like lol-static-data.
+type RateLimiter interface {
+	// Wait blocks until a request of the operation can be sent,
+	// or returns an error if ctx is done.
+	Wait(ctx context.Context, info *OperationInfo) error
+}
+
+// RateLimit is a number of requests allowed in a duration.
+// e.g. RateLimit{10, 10 * time.Second}
+type RateLimit struct {
+	Requests int
+	Per      time.Duration
+}
+
+// NewRateLimiter returns a RateLimiter allowing requests within all limits.
+//
+// As the rate limit is per api key, the limiter only works if
+// a single process uses the key.
+func NewRateLimiter(limits ...RateLimit) RateLimiter {
+	return &rateLimiter{limits: limits, sent: make([][]time.Time, len(limits))}
+}
+
+type rateLimiter struct {
+	mu     sync.Mutex
+	limits []RateLimit
+	// Times of requests sent in the last RateLimit.Per, for each limit.
+	sent [][]time.Time
+}
+
+func (l *rateLimiter) Wait(ctx context.Context, info *OperationInfo) error {
+	for {
+		d := l.reserve(time.Now())
+		if d == 0 {
+			return nil
+		}
+
+		t := time.NewTimer(d)
+		select {
+		case <-ctx.Done():
+			t.Stop()
+			return ctx.Err()
+		case <-t.C:
+		}
+	}
+}
+
+// reserve records a request and returns 0 if it can be sent at now.
+// Otherwise it returns duration to wait.
+func (l *rateLimiter) reserve(now time.Time) time.Duration {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	var wait time.Duration
+	for i, limit := range l.limits {
+		sent := l.sent[i]
+		for len(sent) != 0 && now.Sub(sent[0]) >= limit.Per {
+			sent = sent[1:]
+		}
+		l.sent[i] = sent
+
+		if len(sent) >= limit.Requests {
+			if d := sent[0].Add(limit.Per).Sub(now); d > wait {
+				wait = d
+			}
+		}
+	}
+	if wait != 0 {
+		return wait
+	}
+
+	for i := range l.limits {
+		l.sent[i] = append(l.sent[i], now)
+	}
+	return 0
+}
+
+// SetRateLimiter sets a limiter used before sending requests counted in the rate limit.
+func (c *Client) SetRateLimiter(l RateLimiter) {
+	c.limiter = l
+}
+
+// SetMaxRetries sets the number of retries after HTTP 429 Too Many Requests.
+// Retries wait for Retry-After, and the rate limiter.
+func (c *Client) SetMaxRetries(n int) {
+	c.maxRetries = n
+}
+
+// retryAfter returns a duration to wait before retrying a request.
+func retryAfter(res *http.Response) time.Duration {
+	if s, err := strconv.Atoi(res.Header.Get("Retry-After")); err == nil && s >= 0 {
+		return time.Duration(s) * time.Second
+	}
+	return time.Second
+}
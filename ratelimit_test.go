@@ -0,0 +1,78 @@
+package lol
+
+import (
+	"io"
+	"net/http"
+	"testing"
+	"time"
+
+	"golang.org/x/net/context"
+)
+
+func TestRateLimiterReserve(t *testing.T) {
+	l := NewRateLimiter(RateLimit{2, time.Second}, RateLimit{3, time.Minute}).(*rateLimiter)
+	now := time.Now()
+
+	for i, tt := range []struct {
+		at   time.Duration
+		wait time.Duration
+	}{
+		{0, 0},
+		{100 * time.Millisecond, 0},
+		{200 * time.Millisecond, 800 * time.Millisecond}, // 2 per second
+		{time.Second, 0},
+		{1100 * time.Millisecond, 58900 * time.Millisecond}, // 3 per minute
+	} {
+		if wait := l.reserve(now.Add(tt.at)); wait != tt.wait {
+			t.Errorf("%d: Expected to wait %v, got %v", i, tt.wait, wait)
+		}
+	}
+}
+
+// countingLimiter counts operations passed to Wait.
+type countingLimiter map[string]int
+
+func (l countingLimiter) Wait(ctx context.Context, info *OperationInfo) error {
+	l[info.Name]++
+	return nil
+}
+
+func TestClientRateLimit(t *testing.T) {
+	var requests int
+	c, close := newMockClient(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if requests == 1 {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(429)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		io.WriteString(w, `{}`)
+	}))
+	defer close()
+
+	limiter := make(countingLimiter)
+	c.SetRateLimiter(limiter)
+	c.SetMaxRetries(1)
+
+	if _, err := c.Champions(context.TODO(), NA).Do(); err != nil {
+		t.Fatal(err)
+		return
+	}
+	if requests != 2 || limiter["Champions"] != 2 {
+		t.Fatalf("Expected a retry counted by the limiter, got %d requests, %v", requests, limiter)
+		return
+	}
+
+	if !LookupOperation("Realm").RateLimitExempt {
+		t.Fatal("Expected Realm to be exempt from the rate limit")
+		return
+	}
+	if _, err := c.Realm(context.TODO(), NA).Do(); err != nil {
+		t.Fatal(err)
+		return
+	}
+	if limiter["Realm"] != 0 {
+		t.Fatalf("Expected Realm not to be counted, got %v", limiter)
+	}
+}
@@ -0,0 +1,2111 @@
+// Generated by go-lol-generator. DO NOT EDIT.
+
+package lol
+
+import "encoding/json"
+import "io"
+import "net/http"
+import "net/url"
+import "golang.org/x/net/context"
+import "github.com/go-lol/lol/uritemplates"
+
+// BasicDataDto - This object contains basic data.
+//
+// resource: "lol-static-data", original name: "BasicDataDto"
+type BasicData struct {
+	Colloq        string   `json:"colloq,omitempty"`
+	ConsumeOnFull bool     `json:"consumeOnFull,omitempty"`
+	Consumed      bool     `json:"consumed,omitempty"`
+	Depth         int32    `json:"depth,omitempty"`
+	Description   string   `json:"description,omitempty"`
+	From          []string `json:"from,omitempty"`
+	// Data Dragon includes the gold field for basic data, which is shared by both rune and item. However, only items have a gold field on them, representing their gold cost in the store. Since runes are not sold in the store, they have no gold cost.
+	Gold                 *Gold           `json:"gold,omitempty"`
+	Group                string          `json:"group,omitempty"`
+	HideFromAll          bool            `json:"hideFromAll,omitempty"`
+	ID                   int32           `json:"id,omitempty"`
+	Image                *Image          `json:"image,omitempty"`
+	InStore              bool            `json:"inStore,omitempty"`
+	Into                 []string        `json:"into,omitempty"`
+	Maps                 map[string]bool `json:"maps,omitempty"`
+	Name                 string          `json:"name,omitempty"`
+	Plaintext            string          `json:"plaintext,omitempty"`
+	RequiredChampion     string          `json:"requiredChampion,omitempty"`
+	Rune                 *MetaData       `json:"rune,omitempty"`
+	SanitizedDescription string          `json:"sanitizedDescription,omitempty"`
+	SpecialRecipe        int32           `json:"specialRecipe,omitempty"`
+	Stacks               int32           `json:"stacks,omitempty"`
+	Stats                *BasicDataStats `json:"stats,omitempty"`
+	Tags                 []string        `json:"tags,omitempty"`
+}
+
+// BasicDataStatsDto - This object contains basic data stats.
+//
+// resource: "lol-static-data", original name: "BasicDataStatsDto"
+type BasicDataStats struct {
+	FlatArmorMod                        float64 `json:"FlatArmorMod,omitempty"`
+	FlatAttackSpeedMod                  float64 `json:"FlatAttackSpeedMod,omitempty"`
+	FlatBlockMod                        float64 `json:"FlatBlockMod,omitempty"`
+	FlatCritChanceMod                   float64 `json:"FlatCritChanceMod,omitempty"`
+	FlatCritDamageMod                   float64 `json:"FlatCritDamageMod,omitempty"`
+	FlatEXPBonus                        float64 `json:"FlatEXPBonus,omitempty"`
+	FlatEnergyPoolMod                   float64 `json:"FlatEnergyPoolMod,omitempty"`
+	FlatEnergyRegenMod                  float64 `json:"FlatEnergyRegenMod,omitempty"`
+	FlatHPPoolMod                       float64 `json:"FlatHPPoolMod,omitempty"`
+	FlatHPRegenMod                      float64 `json:"FlatHPRegenMod,omitempty"`
+	FlatMPPoolMod                       float64 `json:"FlatMPPoolMod,omitempty"`
+	FlatMPRegenMod                      float64 `json:"FlatMPRegenMod,omitempty"`
+	FlatMagicDamageMod                  float64 `json:"FlatMagicDamageMod,omitempty"`
+	FlatMovementSpeedMod                float64 `json:"FlatMovementSpeedMod,omitempty"`
+	FlatPhysicalDamageMod               float64 `json:"FlatPhysicalDamageMod,omitempty"`
+	FlatSpellBlockMod                   float64 `json:"FlatSpellBlockMod,omitempty"`
+	PercentArmorMod                     float64 `json:"PercentArmorMod,omitempty"`
+	PercentAttackSpeedMod               float64 `json:"PercentAttackSpeedMod,omitempty"`
+	PercentBlockMod                     float64 `json:"PercentBlockMod,omitempty"`
+	PercentCritChanceMod                float64 `json:"PercentCritChanceMod,omitempty"`
+	PercentCritDamageMod                float64 `json:"PercentCritDamageMod,omitempty"`
+	PercentDodgeMod                     float64 `json:"PercentDodgeMod,omitempty"`
+	PercentEXPBonus                     float64 `json:"PercentEXPBonus,omitempty"`
+	PercentHPPoolMod                    float64 `json:"PercentHPPoolMod,omitempty"`
+	PercentHPRegenMod                   float64 `json:"PercentHPRegenMod,omitempty"`
+	PercentLifeStealMod                 float64 `json:"PercentLifeStealMod,omitempty"`
+	PercentMPPoolMod                    float64 `json:"PercentMPPoolMod,omitempty"`
+	PercentMPRegenMod                   float64 `json:"PercentMPRegenMod,omitempty"`
+	PercentMagicDamageMod               float64 `json:"PercentMagicDamageMod,omitempty"`
+	PercentMovementSpeedMod             float64 `json:"PercentMovementSpeedMod,omitempty"`
+	PercentPhysicalDamageMod            float64 `json:"PercentPhysicalDamageMod,omitempty"`
+	PercentSpellBlockMod                float64 `json:"PercentSpellBlockMod,omitempty"`
+	PercentSpellVampMod                 float64 `json:"PercentSpellVampMod,omitempty"`
+	RFlatArmorModPerLevel               float64 `json:"rFlatArmorModPerLevel,omitempty"`
+	RFlatArmorPenetrationMod            float64 `json:"rFlatArmorPenetrationMod,omitempty"`
+	RFlatArmorPenetrationModPerLevel    float64 `json:"rFlatArmorPenetrationModPerLevel,omitempty"`
+	RFlatCritChanceModPerLevel          float64 `json:"rFlatCritChanceModPerLevel,omitempty"`
+	RFlatCritDamageModPerLevel          float64 `json:"rFlatCritDamageModPerLevel,omitempty"`
+	RFlatDodgeMod                       float64 `json:"rFlatDodgeMod,omitempty"`
+	RFlatDodgeModPerLevel               float64 `json:"rFlatDodgeModPerLevel,omitempty"`
+	RFlatEnergyModPerLevel              float64 `json:"rFlatEnergyModPerLevel,omitempty"`
+	RFlatEnergyRegenModPerLevel         float64 `json:"rFlatEnergyRegenModPerLevel,omitempty"`
+	RFlatGoldPer10Mod                   float64 `json:"rFlatGoldPer10Mod,omitempty"`
+	RFlatHPModPerLevel                  float64 `json:"rFlatHPModPerLevel,omitempty"`
+	RFlatHPRegenModPerLevel             float64 `json:"rFlatHPRegenModPerLevel,omitempty"`
+	RFlatMPModPerLevel                  float64 `json:"rFlatMPModPerLevel,omitempty"`
+	RFlatMPRegenModPerLevel             float64 `json:"rFlatMPRegenModPerLevel,omitempty"`
+	RFlatMagicDamageModPerLevel         float64 `json:"rFlatMagicDamageModPerLevel,omitempty"`
+	RFlatMagicPenetrationMod            float64 `json:"rFlatMagicPenetrationMod,omitempty"`
+	RFlatMagicPenetrationModPerLevel    float64 `json:"rFlatMagicPenetrationModPerLevel,omitempty"`
+	RFlatMovementSpeedModPerLevel       float64 `json:"rFlatMovementSpeedModPerLevel,omitempty"`
+	RFlatPhysicalDamageModPerLevel      float64 `json:"rFlatPhysicalDamageModPerLevel,omitempty"`
+	RFlatSpellBlockModPerLevel          float64 `json:"rFlatSpellBlockModPerLevel,omitempty"`
+	RFlatTimeDeadMod                    float64 `json:"rFlatTimeDeadMod,omitempty"`
+	RFlatTimeDeadModPerLevel            float64 `json:"rFlatTimeDeadModPerLevel,omitempty"`
+	RPercentArmorPenetrationMod         float64 `json:"rPercentArmorPenetrationMod,omitempty"`
+	RPercentArmorPenetrationModPerLevel float64 `json:"rPercentArmorPenetrationModPerLevel,omitempty"`
+	RPercentAttackSpeedModPerLevel      float64 `json:"rPercentAttackSpeedModPerLevel,omitempty"`
+	RPercentCooldownMod                 float64 `json:"rPercentCooldownMod,omitempty"`
+	RPercentCooldownModPerLevel         float64 `json:"rPercentCooldownModPerLevel,omitempty"`
+	RPercentMagicPenetrationMod         float64 `json:"rPercentMagicPenetrationMod,omitempty"`
+	RPercentMagicPenetrationModPerLevel float64 `json:"rPercentMagicPenetrationModPerLevel,omitempty"`
+	RPercentMovementSpeedModPerLevel    float64 `json:"rPercentMovementSpeedModPerLevel,omitempty"`
+	RPercentTimeDeadMod                 float64 `json:"rPercentTimeDeadMod,omitempty"`
+	RPercentTimeDeadModPerLevel         float64 `json:"rPercentTimeDeadModPerLevel,omitempty"`
+}
+
+// BlockDto - This object contains champion recommended block data.
+//
+// resource: "lol-static-data", original name: "BlockDto"
+type Block struct {
+	Items   []*BlockItem `json:"items,omitempty"`
+	RecMath bool         `json:"recMath,omitempty"`
+	Type    string       `json:"type,omitempty"`
+}
+
+// BlockItemDto - This object contains champion recommended block item data.
+//
+// resource: "lol-static-data", original name: "BlockItemDto"
+type BlockItem struct {
+	Count int32 `json:"count,omitempty"`
+	ID    int32 `json:"id,omitempty"`
+}
+
+// ChampionDto - This object contains champion data.
+//
+// resource: "lol-static-data", original name: "ChampionDto"
+type ChampionData struct {
+	Allytips    []string         `json:"allytips,omitempty"`
+	Blurb       string           `json:"blurb,omitempty"`
+	Enemytips   []string         `json:"enemytips,omitempty"`
+	ID          int32            `json:"id,omitempty"`
+	Image       *Image           `json:"image,omitempty"`
+	Info        *Info            `json:"info,omitempty"`
+	Key         string           `json:"key,omitempty"`
+	Lore        string           `json:"lore,omitempty"`
+	Name        string           `json:"name,omitempty"`
+	Partype     string           `json:"partype,omitempty"`
+	Passive     *Passive         `json:"passive,omitempty"`
+	Recommended []*Recommended   `json:"recommended,omitempty"`
+	Skins       []*Skin          `json:"skins,omitempty"`
+	Spells      []*ChampionSpell `json:"spells,omitempty"`
+	Stats       *Stats           `json:"stats,omitempty"`
+	Tags        []string         `json:"tags,omitempty"`
+	Title       string           `json:"title,omitempty"`
+}
+
+// ChampionListDto - This object contains champion list data.
+//
+// resource: "lol-static-data", original name: "ChampionListDto"
+type ChampionDataList struct {
+	Data    map[string]*ChampionData `json:"data,omitempty"`
+	Format  string                   `json:"format,omitempty"`
+	Keys    map[string]string        `json:"keys,omitempty"`
+	Type    string                   `json:"type,omitempty"`
+	Version string                   `json:"version,omitempty"`
+}
+
+// ChampionSpellDto - This object contains champion spell data.
+//
+// resource: "lol-static-data", original name: "ChampionSpellDto"
+type ChampionSpell struct {
+	Altimages    []*Image  `json:"altimages,omitempty"`
+	Cooldown     []float64 `json:"cooldown,omitempty"`
+	CooldownBurn string    `json:"cooldownBurn,omitempty"`
+	Cost         []int32   `json:"cost,omitempty"`
+	CostBurn     string    `json:"costBurn,omitempty"`
+	CostType     string    `json:"costType,omitempty"`
+	Description  string    `json:"description,omitempty"`
+	// This field is a List of List of Double.
+	Effect     [][]float64 `json:"effect,omitempty"`
+	EffectBurn []string    `json:"effectBurn,omitempty"`
+	Image      *Image      `json:"image,omitempty"`
+	Key        string      `json:"key,omitempty"`
+	Leveltip   *LevelTip   `json:"leveltip,omitempty"`
+	Maxrank    int32       `json:"maxrank,omitempty"`
+	Name       string      `json:"name,omitempty"`
+	// This field is either a List of Integer or the String 'self' for spells that target one's own champion.
+	Range                *SpellRange  `json:"range,omitempty"`
+	RangeBurn            string       `json:"rangeBurn,omitempty"`
+	Resource             string       `json:"resource,omitempty"`
+	SanitizedDescription string       `json:"sanitizedDescription,omitempty"`
+	SanitizedTooltip     string       `json:"sanitizedTooltip,omitempty"`
+	Tooltip              string       `json:"tooltip,omitempty"`
+	Vars                 []*SpellVars `json:"vars,omitempty"`
+}
+
+// GoldDto - This object contains item gold data.
+//
+// resource: "lol-static-data", original name: "GoldDto"
+type Gold struct {
+	Base        int32 `json:"base,omitempty"`
+	Purchasable bool  `json:"purchasable,omitempty"`
+	Sell        int32 `json:"sell,omitempty"`
+	Total       int32 `json:"total,omitempty"`
+}
+
+// GroupDto - This object contains item group data.
+//
+// resource: "lol-static-data", original name: "GroupDto"
+type Group struct {
+	MaxGroupOwnable string `json:"MaxGroupOwnable,omitempty"`
+	Key             string `json:"key,omitempty"`
+}
+
+// InfoDto - This object contains champion information.
+//
+// resource: "lol-static-data", original name: "InfoDto"
+type Info struct {
+	Attack     int32 `json:"attack,omitempty"`
+	Defense    int32 `json:"defense,omitempty"`
+	Difficulty int32 `json:"difficulty,omitempty"`
+	Magic      int32 `json:"magic,omitempty"`
+}
+
+// ItemDto - This object contains item data.
+//
+// resource: "lol-static-data", original name: "ItemDto"
+type Item struct {
+	Colloq        string            `json:"colloq,omitempty"`
+	ConsumeOnFull bool              `json:"consumeOnFull,omitempty"`
+	Consumed      bool              `json:"consumed,omitempty"`
+	Depth         int32             `json:"depth,omitempty"`
+	Description   string            `json:"description,omitempty"`
+	Effect        map[string]string `json:"effect,omitempty"`
+	From          []string          `json:"from,omitempty"`
+	// Data Dragon includes the gold field for basic data, which is shared by both rune and item. However, only items have a gold field on them, representing their gold cost in the store. Since runes are not sold in the store, they have no gold cost.
+	Gold                 *Gold           `json:"gold,omitempty"`
+	Group                string          `json:"group,omitempty"`
+	HideFromAll          bool            `json:"hideFromAll,omitempty"`
+	ID                   int32           `json:"id,omitempty"`
+	Image                *Image          `json:"image,omitempty"`
+	InStore              bool            `json:"inStore,omitempty"`
+	Into                 []string        `json:"into,omitempty"`
+	Maps                 map[string]bool `json:"maps,omitempty"`
+	Name                 string          `json:"name,omitempty"`
+	Plaintext            string          `json:"plaintext,omitempty"`
+	RequiredChampion     string          `json:"requiredChampion,omitempty"`
+	Rune                 *MetaData       `json:"rune,omitempty"`
+	SanitizedDescription string          `json:"sanitizedDescription,omitempty"`
+	SpecialRecipe        int32           `json:"specialRecipe,omitempty"`
+	Stacks               int32           `json:"stacks,omitempty"`
+	Stats                *BasicDataStats `json:"stats,omitempty"`
+	Tags                 []string        `json:"tags,omitempty"`
+}
+
+// ItemListDto - This object contains item list data.
+//
+// resource: "lol-static-data", original name: "ItemListDto"
+type ItemList struct {
+	Basic   *BasicData       `json:"basic,omitempty"`
+	Data    map[string]*Item `json:"data,omitempty"`
+	Groups  []*Group         `json:"groups,omitempty"`
+	Tree    []*ItemTree      `json:"tree,omitempty"`
+	Type    string           `json:"type,omitempty"`
+	Version string           `json:"version,omitempty"`
+}
+
+// ItemTreeDto - This object contains item tree data.
+//
+// resource: "lol-static-data", original name: "ItemTreeDto"
+type ItemTree struct {
+	Header string   `json:"header,omitempty"`
+	Tags   []string `json:"tags,omitempty"`
+}
+
+// LanguageStringsDto - This object contains language strings data.
+//
+// resource: "lol-static-data", original name: "LanguageStringsDto"
+type LanguageStrings struct {
+	Data    map[string]string `json:"data,omitempty"`
+	Type    string            `json:"type,omitempty"`
+	Version string            `json:"version,omitempty"`
+}
+
+// LevelTipDto - This object contains champion level tip data.
+//
+// resource: "lol-static-data", original name: "LevelTipDto"
+type LevelTip struct {
+	Effect []string `json:"effect,omitempty"`
+	Label  []string `json:"label,omitempty"`
+}
+
+// MapDataDto - This object contains map data.
+//
+// resource: "lol-static-data", original name: "MapDataDto"
+type MapData struct {
+	Data    map[string]*MapDetails `json:"data,omitempty"`
+	Type    string                 `json:"type,omitempty"`
+	Version string                 `json:"version,omitempty"`
+}
+
+// MapDetailsDto - This object contains map details data.
+//
+// resource: "lol-static-data", original name: "MapDetailsDto"
+type MapDetails struct {
+	Image                 *Image  `json:"image,omitempty"`
+	MapID                 int32   `json:"mapId,omitempty"`
+	MapName               string  `json:"mapName,omitempty"`
+	UnpurchasableItemList []int64 `json:"unpurchasableItemList,omitempty"`
+}
+
+// MasteryDto - This object contains mastery data.
+//
+// resource: "lol-static-data", original name: "MasteryDto"
+type Mastery struct {
+	Description []string `json:"description,omitempty"`
+	ID          int32    `json:"id,omitempty"`
+	Image       *Image   `json:"image,omitempty"`
+	// Legal values: Cunning, Ferocity, Resolve
+	MasteryTree          string   `json:"masteryTree,omitempty"`
+	Name                 string   `json:"name,omitempty"`
+	Prereq               string   `json:"prereq,omitempty"`
+	Ranks                int32    `json:"ranks,omitempty"`
+	SanitizedDescription []string `json:"sanitizedDescription,omitempty"`
+}
+
+// MasteryListDto - This object contains mastery list data.
+//
+// resource: "lol-static-data", original name: "MasteryListDto"
+type MasteryList struct {
+	Data    map[string]*Mastery `json:"data,omitempty"`
+	Tree    *MasteryTree        `json:"tree,omitempty"`
+	Type    string              `json:"type,omitempty"`
+	Version string              `json:"version,omitempty"`
+}
+
+// MasteryTreeDto - This object contains mastery tree data.
+//
+// resource: "lol-static-data", original name: "MasteryTreeDto"
+type MasteryTree struct {
+	Cunning  []*MasteryTreeList `json:"Cunning,omitempty"`
+	Ferocity []*MasteryTreeList `json:"Ferocity,omitempty"`
+	Resolve  []*MasteryTreeList `json:"Resolve,omitempty"`
+}
+
+// MasteryTreeItemDto - This object contains mastery tree item data.
+//
+// resource: "lol-static-data", original name: "MasteryTreeItemDto"
+type MasteryTreeItem struct {
+	MasteryID int32  `json:"masteryId,omitempty"`
+	Prereq    string `json:"prereq,omitempty"`
+}
+
+// MasteryTreeListDto - This object contains mastery tree list data.
+//
+// resource: "lol-static-data", original name: "MasteryTreeListDto"
+type MasteryTreeList struct {
+	MasteryTreeItems []*MasteryTreeItem `json:"masteryTreeItems,omitempty"`
+}
+
+// MetaDataDto - This object contains meta data.
+//
+// resource: "lol-static-data", original name: "MetaDataDto"
+type MetaData struct {
+	IsRune bool   `json:"isRune,omitempty"`
+	Tier   string `json:"tier,omitempty"`
+	Type   string `json:"type,omitempty"`
+}
+
+// PassiveDto - This object contains champion passive data.
+//
+// resource: "lol-static-data", original name: "PassiveDto"
+type Passive struct {
+	Description          string `json:"description,omitempty"`
+	Image                *Image `json:"image,omitempty"`
+	Name                 string `json:"name,omitempty"`
+	SanitizedDescription string `json:"sanitizedDescription,omitempty"`
+}
+
+// RealmDto - This object contains realm data.
+//
+// resource: "lol-static-data", original name: "RealmDto"
+type Realm struct {
+	// The base CDN url.
+	Cdn string `json:"cdn,omitempty"`
+	// Latest changed version of Dragon Magic's css file.
+	CSS string `json:"css,omitempty"`
+	// Latest changed version of Dragon Magic.
+	Dd string `json:"dd,omitempty"`
+	// Default language for this realm.
+	L string `json:"l,omitempty"`
+	// Legacy script mode for IE6 or older.
+	Lg string `json:"lg,omitempty"`
+	// Latest changed version for each data type listed.
+	N map[string]string `json:"n,omitempty"`
+	// Special behavior number identifying the largest profileicon id that can be used under 500. Any profileicon that is requested between this number and 500 should be mapped to 0.
+	Profileiconmax int32 `json:"profileiconmax,omitempty"`
+	// Additional api data drawn from other sources that may be related to data dragon functionality.
+	Store string `json:"store,omitempty"`
+	// Current version of this file for this realm.
+	V string `json:"v,omitempty"`
+}
+
+// RecommendedDto - This object contains champion recommended data.
+//
+// resource: "lol-static-data", original name: "RecommendedDto"
+type Recommended struct {
+	Blocks   []*Block `json:"blocks,omitempty"`
+	Champion string   `json:"champion,omitempty"`
+	Map      string   `json:"map,omitempty"`
+	Mode     string   `json:"mode,omitempty"`
+	Priority bool     `json:"priority,omitempty"`
+	Title    string   `json:"title,omitempty"`
+	Type     string   `json:"type,omitempty"`
+}
+
+// RuneDto - This object contains rune data.
+//
+// resource: "lol-static-data", original name: "RuneDto"
+type Rune struct {
+	Colloq               string          `json:"colloq,omitempty"`
+	ConsumeOnFull        bool            `json:"consumeOnFull,omitempty"`
+	Consumed             bool            `json:"consumed,omitempty"`
+	Depth                int32           `json:"depth,omitempty"`
+	Description          string          `json:"description,omitempty"`
+	From                 []string        `json:"from,omitempty"`
+	Group                string          `json:"group,omitempty"`
+	HideFromAll          bool            `json:"hideFromAll,omitempty"`
+	ID                   int32           `json:"id,omitempty"`
+	Image                *Image          `json:"image,omitempty"`
+	InStore              bool            `json:"inStore,omitempty"`
+	Into                 []string        `json:"into,omitempty"`
+	Maps                 map[string]bool `json:"maps,omitempty"`
+	Name                 string          `json:"name,omitempty"`
+	Plaintext            string          `json:"plaintext,omitempty"`
+	RequiredChampion     string          `json:"requiredChampion,omitempty"`
+	Rune                 *MetaData       `json:"rune,omitempty"`
+	SanitizedDescription string          `json:"sanitizedDescription,omitempty"`
+	SpecialRecipe        int32           `json:"specialRecipe,omitempty"`
+	Stacks               int32           `json:"stacks,omitempty"`
+	Stats                *BasicDataStats `json:"stats,omitempty"`
+	Tags                 []string        `json:"tags,omitempty"`
+}
+
+// RuneListDto - This object contains rune list data.
+//
+// resource: "lol-static-data", original name: "RuneListDto"
+type RuneList struct {
+	Basic   *BasicData       `json:"basic,omitempty"`
+	Data    map[string]*Rune `json:"data,omitempty"`
+	Type    string           `json:"type,omitempty"`
+	Version string           `json:"version,omitempty"`
+}
+
+// SkinDto - This object contains champion skin data.
+//
+// resource: "lol-static-data", original name: "SkinDto"
+type Skin struct {
+	ID   int32  `json:"id,omitempty"`
+	Name string `json:"name,omitempty"`
+	Num  int32  `json:"num,omitempty"`
+}
+
+// SpellVarsDto - This object contains spell vars data.
+//
+// resource: "lol-static-data", original name: "SpellVarsDto"
+type SpellVars struct {
+	Coeff     []float64 `json:"coeff,omitempty"`
+	Dyn       string    `json:"dyn,omitempty"`
+	Key       string    `json:"key,omitempty"`
+	Link      string    `json:"link,omitempty"`
+	RanksWith string    `json:"ranksWith,omitempty"`
+}
+
+// StatsDto - This object contains champion stats data.
+//
+// resource: "lol-static-data", original name: "StatsDto"
+type Stats struct {
+	Armor                float64 `json:"armor,omitempty"`
+	Armorperlevel        float64 `json:"armorperlevel,omitempty"`
+	Attackdamage         float64 `json:"attackdamage,omitempty"`
+	Attackdamageperlevel float64 `json:"attackdamageperlevel,omitempty"`
+	Attackrange          float64 `json:"attackrange,omitempty"`
+	Attackspeedoffset    float64 `json:"attackspeedoffset,omitempty"`
+	Attackspeedperlevel  float64 `json:"attackspeedperlevel,omitempty"`
+	Crit                 float64 `json:"crit,omitempty"`
+	Critperlevel         float64 `json:"critperlevel,omitempty"`
+	Hp                   float64 `json:"hp,omitempty"`
+	Hpperlevel           float64 `json:"hpperlevel,omitempty"`
+	Hpregen              float64 `json:"hpregen,omitempty"`
+	Hpregenperlevel      float64 `json:"hpregenperlevel,omitempty"`
+	Movespeed            float64 `json:"movespeed,omitempty"`
+	Mp                   float64 `json:"mp,omitempty"`
+	Mpperlevel           float64 `json:"mpperlevel,omitempty"`
+	Mpregen              float64 `json:"mpregen,omitempty"`
+	Mpregenperlevel      float64 `json:"mpregenperlevel,omitempty"`
+	Spellblock           float64 `json:"spellblock,omitempty"`
+	Spellblockperlevel   float64 `json:"spellblockperlevel,omitempty"`
+}
+
+// SummonerSpellDto - This object contains summoner spell data.
+//
+// resource: "lol-static-data", original name: "SummonerSpellDto"
+type SummonerSpell struct {
+	Cooldown     []float64 `json:"cooldown,omitempty"`
+	CooldownBurn string    `json:"cooldownBurn,omitempty"`
+	Cost         []int32   `json:"cost,omitempty"`
+	CostBurn     string    `json:"costBurn,omitempty"`
+	CostType     string    `json:"costType,omitempty"`
+	Description  string    `json:"description,omitempty"`
+	// This field is a List of List of Double.
+	Effect     [][]float64 `json:"effect,omitempty"`
+	EffectBurn []string    `json:"effectBurn,omitempty"`
+	ID         int32       `json:"id,omitempty"`
+	Image      *Image      `json:"image,omitempty"`
+	Key        string      `json:"key,omitempty"`
+	Leveltip   *LevelTip   `json:"leveltip,omitempty"`
+	Maxrank    int32       `json:"maxrank,omitempty"`
+	Modes      []string    `json:"modes,omitempty"`
+	Name       string      `json:"name,omitempty"`
+	// This field is either a List of Integer or the String 'self' for spells that target one's own champion.
+	Range                *SpellRange  `json:"range,omitempty"`
+	RangeBurn            string       `json:"rangeBurn,omitempty"`
+	Resource             string       `json:"resource,omitempty"`
+	SanitizedDescription string       `json:"sanitizedDescription,omitempty"`
+	SanitizedTooltip     string       `json:"sanitizedTooltip,omitempty"`
+	SummonerLevel        int32        `json:"summonerLevel,omitempty"`
+	Tooltip              string       `json:"tooltip,omitempty"`
+	Vars                 []*SpellVars `json:"vars,omitempty"`
+}
+
+// SummonerSpellListDto - This object contains summoner spell list data.
+//
+// resource: "lol-static-data", original name: "SummonerSpellListDto"
+type SummonerSpellList struct {
+	Data    map[string]*SummonerSpell `json:"data,omitempty"`
+	Type    string                    `json:"type,omitempty"`
+	Version string                    `json:"version,omitempty"`
+}
+
+// ChampionDatasCall is a builder for "ChampionDatas"
+type ChampionDatasCall struct {
+	ctx        context.Context
+	client     *Client
+	query      url.Values
+	pathParams map[string]string
+	region     Region
+}
+
+var championDatasInfo = &OperationInfo{
+	Name:            "ChampionDatas",
+	Resource:        "lol-static-data",
+	Version:         "v1.2",
+	Method:          "GET",
+	Path:            "/api/lol/static-data/{region}/v1.2/champion",
+	DocURL:          "https://developer.riotgames.com/api/methods#!/1055/3633",
+	RateLimitNotes:  "Requests to this API will not be counted in your Rate Limit.",
+	RateLimitExempt: true,
+	Regions:         []Region{BR, EUNE, EUW, KR, LAN, LAS, NA, OCE, PBE, RU, TR},
+	Errors: []OperationError{
+		{400, "Bad request"},
+		{401, "Unauthorized"},
+		{404, "Data not found"},
+		{429, "Rate limit exceeded"},
+		{500, "Internal server error"},
+		{503, "Service unavailable"},
+	},
+}
+
+// Info returns information of the operation.
+func (c *ChampionDatasCall) Info() *OperationInfo { return championDatasInfo }
+
+// Retrieves champion list.
+//
+//
+// Implementation notes: Not all data specified below is returned by default. See the champData parameter for more information.
+//
+// Rate limit notes: Requests to this API will not be counted in your Rate Limit.
+//
+// GET: https://global.api.pvp.net/api/lol/static-data/{region}/v1.2/champion
+//
+// Reference: https://developer.riotgames.com/api/methods#!/1055/3633
+func (c *Client) ChampionDatas(ctx context.Context, region Region) *ChampionDatasCall {
+	path := make(map[string]string)
+	return &ChampionDatasCall{ctx: ctx, client: c, query: make(url.Values), pathParams: path, region: region}
+}
+
+// locale configures query parameter "locale".
+func (c *ChampionDatasCall) Locale(v string) *ChampionDatasCall {
+	c.query.Set("locale", convertToString(v))
+	return c
+}
+
+// version configures query parameter "version".
+func (c *ChampionDatasCall) Version(v string) *ChampionDatasCall {
+	c.query.Set("version", convertToString(v))
+	return c
+}
+
+// dataByID configures query parameter "dataById".
+func (c *ChampionDatasCall) DataByID(v bool) *ChampionDatasCall {
+	c.query.Set("dataByID", convertToString(v))
+	return c
+}
+
+// champData configures query parameter "champData".
+func (c *ChampionDatasCall) ChampData(v string) *ChampionDatasCall {
+	c.query.Set("champData", convertToString(v))
+	return c
+}
+
+func (c *ChampionDatasCall) doRequest() (*http.Response, error) {
+	var body io.Reader
+	switch c.region {
+	case BR, EUNE, EUW, KR, LAN, LAS, NA, OCE, PBE, RU, TR:
+	default:
+		return nil, ErrNotSupportedRegion
+	}
+	c.query.Set("api_key", c.client.apiKey)
+	c.pathParams["region"] = c.region.Name()
+
+	path, err := uritemplates.Expand("/api/lol/static-data/{region}/v1.2/champion", c.pathParams)
+	if err != nil {
+		return nil, err
+	}
+	urls := "https://global.api.pvp.net" + path + "?" + c.query.Encode()
+
+	return c.client.doRequest(c.ctx, championDatasInfo, urls, body)
+}
+
+// Do executes api request.
+//
+// API Errors:
+//  400 - Bad request
+//  401 - Unauthorized
+//  404 - Data not found
+//  429 - Rate limit exceeded
+//  500 - Internal server error
+//  503 - Service unavailable
+func (c *ChampionDatasCall) Do() (*ChampionDataList, error) {
+	res, err := c.doRequest()
+	if err != nil {
+		return nil, err
+	}
+	defer closeBody(res)
+	if err := verifyAPIResponse(res); err != nil {
+		return nil, err
+	}
+	ret := &ChampionDataList{}
+	if err := json.NewDecoder(res.Body).Decode(&ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// ChampionDataCall is a builder for "ChampionData"
+type ChampionDataCall struct {
+	ctx        context.Context
+	client     *Client
+	query      url.Values
+	pathParams map[string]string
+	region     Region
+}
+
+var championDataInfo = &OperationInfo{
+	Name:            "ChampionData",
+	Resource:        "lol-static-data",
+	Version:         "v1.2",
+	Method:          "GET",
+	Path:            "/api/lol/static-data/{region}/v1.2/champion/{id}",
+	DocURL:          "https://developer.riotgames.com/api/methods#!/1055/3622",
+	RateLimitNotes:  "Requests to this API will not be counted in your Rate Limit.",
+	RateLimitExempt: true,
+	Regions:         []Region{BR, EUNE, EUW, KR, LAN, LAS, NA, OCE, PBE, RU, TR},
+	Errors: []OperationError{
+		{400, "Bad request"},
+		{401, "Unauthorized"},
+		{404, "Champion not found"},
+		{429, "Rate limit exceeded"},
+		{500, "Internal server error"},
+		{503, "Service unavailable"},
+	},
+}
+
+// Info returns information of the operation.
+func (c *ChampionDataCall) Info() *OperationInfo { return championDataInfo }
+
+// Retrieves a champion by its id.
+//
+//
+// Implementation notes: Not all data specified below is returned by default. See the champData parameter for more information.
+//
+// Rate limit notes: Requests to this API will not be counted in your Rate Limit.
+//
+// GET: https://global.api.pvp.net/api/lol/static-data/{region}/v1.2/champion/{id}
+//
+// Reference: https://developer.riotgames.com/api/methods#!/1055/3622
+func (c *Client) ChampionData(ctx context.Context, region Region, id int32) *ChampionDataCall {
+	path := make(map[string]string)
+	path["id"] = convertToString(id)
+	return &ChampionDataCall{ctx: ctx, client: c, query: make(url.Values), pathParams: path, region: region}
+}
+
+// locale configures query parameter "locale".
+func (c *ChampionDataCall) Locale(v string) *ChampionDataCall {
+	c.query.Set("locale", convertToString(v))
+	return c
+}
+
+// version configures query parameter "version".
+func (c *ChampionDataCall) Version(v string) *ChampionDataCall {
+	c.query.Set("version", convertToString(v))
+	return c
+}
+
+// champData configures query parameter "champData".
+func (c *ChampionDataCall) ChampData(v string) *ChampionDataCall {
+	c.query.Set("champData", convertToString(v))
+	return c
+}
+
+func (c *ChampionDataCall) doRequest() (*http.Response, error) {
+	var body io.Reader
+	switch c.region {
+	case BR, EUNE, EUW, KR, LAN, LAS, NA, OCE, PBE, RU, TR:
+	default:
+		return nil, ErrNotSupportedRegion
+	}
+	c.query.Set("api_key", c.client.apiKey)
+	c.pathParams["region"] = c.region.Name()
+
+	path, err := uritemplates.Expand("/api/lol/static-data/{region}/v1.2/champion/{id}", c.pathParams)
+	if err != nil {
+		return nil, err
+	}
+	urls := "https://global.api.pvp.net" + path + "?" + c.query.Encode()
+
+	return c.client.doRequest(c.ctx, championDataInfo, urls, body)
+}
+
+// Do executes api request.
+//
+// API Errors:
+//  400 - Bad request
+//  401 - Unauthorized
+//  404 - Champion not found
+//  429 - Rate limit exceeded
+//  500 - Internal server error
+//  503 - Service unavailable
+func (c *ChampionDataCall) Do() (*ChampionData, error) {
+	res, err := c.doRequest()
+	if err != nil {
+		return nil, err
+	}
+	defer closeBody(res)
+	if err := verifyAPIResponse(res); err != nil {
+		return nil, err
+	}
+	ret := &ChampionData{}
+	if err := json.NewDecoder(res.Body).Decode(&ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// ItemsCall is a builder for "Items"
+type ItemsCall struct {
+	ctx        context.Context
+	client     *Client
+	query      url.Values
+	pathParams map[string]string
+	region     Region
+}
+
+var itemsInfo = &OperationInfo{
+	Name:            "Items",
+	Resource:        "lol-static-data",
+	Version:         "v1.2",
+	Method:          "GET",
+	Path:            "/api/lol/static-data/{region}/v1.2/item",
+	DocURL:          "https://developer.riotgames.com/api/methods#!/1055/3621",
+	RateLimitNotes:  "Requests to this API will not be counted in your Rate Limit.",
+	RateLimitExempt: true,
+	Regions:         []Region{BR, EUNE, EUW, KR, LAN, LAS, NA, OCE, PBE, RU, TR},
+	Errors: []OperationError{
+		{400, "Bad request"},
+		{401, "Unauthorized"},
+		{404, "Data not found"},
+		{429, "Rate limit exceeded"},
+		{500, "Internal server error"},
+		{503, "Service unavailable"},
+	},
+}
+
+// Info returns information of the operation.
+func (c *ItemsCall) Info() *OperationInfo { return itemsInfo }
+
+// Retrieves item list.
+//
+//
+// Implementation notes: Not all data specified below is returned by default. See the itemListData parameter for more information.
+//
+// Rate limit notes: Requests to this API will not be counted in your Rate Limit.
+//
+// GET: https://global.api.pvp.net/api/lol/static-data/{region}/v1.2/item
+//
+// Reference: https://developer.riotgames.com/api/methods#!/1055/3621
+func (c *Client) Items(ctx context.Context, region Region) *ItemsCall {
+	path := make(map[string]string)
+	return &ItemsCall{ctx: ctx, client: c, query: make(url.Values), pathParams: path, region: region}
+}
+
+// locale configures query parameter "locale".
+func (c *ItemsCall) Locale(v string) *ItemsCall {
+	c.query.Set("locale", convertToString(v))
+	return c
+}
+
+// version configures query parameter "version".
+func (c *ItemsCall) Version(v string) *ItemsCall {
+	c.query.Set("version", convertToString(v))
+	return c
+}
+
+// itemListData configures query parameter "itemListData".
+func (c *ItemsCall) ItemListData(v string) *ItemsCall {
+	c.query.Set("itemListData", convertToString(v))
+	return c
+}
+
+func (c *ItemsCall) doRequest() (*http.Response, error) {
+	var body io.Reader
+	switch c.region {
+	case BR, EUNE, EUW, KR, LAN, LAS, NA, OCE, PBE, RU, TR:
+	default:
+		return nil, ErrNotSupportedRegion
+	}
+	c.query.Set("api_key", c.client.apiKey)
+	c.pathParams["region"] = c.region.Name()
+
+	path, err := uritemplates.Expand("/api/lol/static-data/{region}/v1.2/item", c.pathParams)
+	if err != nil {
+		return nil, err
+	}
+	urls := "https://global.api.pvp.net" + path + "?" + c.query.Encode()
+
+	return c.client.doRequest(c.ctx, itemsInfo, urls, body)
+}
+
+// Do executes api request.
+//
+// API Errors:
+//  400 - Bad request
+//  401 - Unauthorized
+//  404 - Data not found
+//  429 - Rate limit exceeded
+//  500 - Internal server error
+//  503 - Service unavailable
+func (c *ItemsCall) Do() (*ItemList, error) {
+	res, err := c.doRequest()
+	if err != nil {
+		return nil, err
+	}
+	defer closeBody(res)
+	if err := verifyAPIResponse(res); err != nil {
+		return nil, err
+	}
+	ret := &ItemList{}
+	if err := json.NewDecoder(res.Body).Decode(&ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// ItemCall is a builder for "Item"
+type ItemCall struct {
+	ctx        context.Context
+	client     *Client
+	query      url.Values
+	pathParams map[string]string
+	region     Region
+}
+
+var itemInfo = &OperationInfo{
+	Name:            "Item",
+	Resource:        "lol-static-data",
+	Version:         "v1.2",
+	Method:          "GET",
+	Path:            "/api/lol/static-data/{region}/v1.2/item/{id}",
+	DocURL:          "https://developer.riotgames.com/api/methods#!/1055/3627",
+	RateLimitNotes:  "Requests to this API will not be counted in your Rate Limit.",
+	RateLimitExempt: true,
+	Regions:         []Region{BR, EUNE, EUW, KR, LAN, LAS, NA, OCE, PBE, RU, TR},
+	Errors: []OperationError{
+		{400, "Bad request"},
+		{401, "Unauthorized"},
+		{404, "Item not found"},
+		{429, "Rate limit exceeded"},
+		{500, "Internal server error"},
+		{503, "Service unavailable"},
+	},
+}
+
+// Info returns information of the operation.
+func (c *ItemCall) Info() *OperationInfo { return itemInfo }
+
+// Retrieves item by its unique id.
+//
+//
+// Implementation notes: Not all data specified below is returned by default. See the itemData parameter for more information.
+//
+// Rate limit notes: Requests to this API will not be counted in your Rate Limit.
+//
+// GET: https://global.api.pvp.net/api/lol/static-data/{region}/v1.2/item/{id}
+//
+// Reference: https://developer.riotgames.com/api/methods#!/1055/3627
+func (c *Client) Item(ctx context.Context, region Region, id int32) *ItemCall {
+	path := make(map[string]string)
+	path["id"] = convertToString(id)
+	return &ItemCall{ctx: ctx, client: c, query: make(url.Values), pathParams: path, region: region}
+}
+
+// locale configures query parameter "locale".
+func (c *ItemCall) Locale(v string) *ItemCall {
+	c.query.Set("locale", convertToString(v))
+	return c
+}
+
+// version configures query parameter "version".
+func (c *ItemCall) Version(v string) *ItemCall {
+	c.query.Set("version", convertToString(v))
+	return c
+}
+
+// itemData configures query parameter "itemData".
+func (c *ItemCall) ItemData(v string) *ItemCall {
+	c.query.Set("itemData", convertToString(v))
+	return c
+}
+
+func (c *ItemCall) doRequest() (*http.Response, error) {
+	var body io.Reader
+	switch c.region {
+	case BR, EUNE, EUW, KR, LAN, LAS, NA, OCE, PBE, RU, TR:
+	default:
+		return nil, ErrNotSupportedRegion
+	}
+	c.query.Set("api_key", c.client.apiKey)
+	c.pathParams["region"] = c.region.Name()
+
+	path, err := uritemplates.Expand("/api/lol/static-data/{region}/v1.2/item/{id}", c.pathParams)
+	if err != nil {
+		return nil, err
+	}
+	urls := "https://global.api.pvp.net" + path + "?" + c.query.Encode()
+
+	return c.client.doRequest(c.ctx, itemInfo, urls, body)
+}
+
+// Do executes api request.
+//
+// API Errors:
+//  400 - Bad request
+//  401 - Unauthorized
+//  404 - Item not found
+//  429 - Rate limit exceeded
+//  500 - Internal server error
+//  503 - Service unavailable
+func (c *ItemCall) Do() (*Item, error) {
+	res, err := c.doRequest()
+	if err != nil {
+		return nil, err
+	}
+	defer closeBody(res)
+	if err := verifyAPIResponse(res); err != nil {
+		return nil, err
+	}
+	ret := &Item{}
+	if err := json.NewDecoder(res.Body).Decode(&ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// LanguageStringsCall is a builder for "LanguageStrings"
+type LanguageStringsCall struct {
+	ctx        context.Context
+	client     *Client
+	query      url.Values
+	pathParams map[string]string
+	region     Region
+}
+
+var languageStringsInfo = &OperationInfo{
+	Name:            "LanguageStrings",
+	Resource:        "lol-static-data",
+	Version:         "v1.2",
+	Method:          "GET",
+	Path:            "/api/lol/static-data/{region}/v1.2/language-strings",
+	DocURL:          "https://developer.riotgames.com/api/methods#!/1055/3624",
+	RateLimitNotes:  "Requests to this API will not be counted in your Rate Limit.",
+	RateLimitExempt: true,
+	Regions:         []Region{BR, EUNE, EUW, KR, LAN, LAS, NA, OCE, PBE, RU, TR},
+	Errors: []OperationError{
+		{400, "Bad request"},
+		{401, "Unauthorized"},
+		{404, "Data not found"},
+		{429, "Rate limit exceeded"},
+		{500, "Internal server error"},
+		{503, "Service unavailable"},
+	},
+}
+
+// Info returns information of the operation.
+func (c *LanguageStringsCall) Info() *OperationInfo { return languageStringsInfo }
+
+// Retrieve language strings data.
+//
+//
+// Rate limit notes: Requests to this API will not be counted in your Rate Limit.
+//
+// GET: https://global.api.pvp.net/api/lol/static-data/{region}/v1.2/language-strings
+//
+// Reference: https://developer.riotgames.com/api/methods#!/1055/3624
+func (c *Client) LanguageStrings(ctx context.Context, region Region) *LanguageStringsCall {
+	path := make(map[string]string)
+	return &LanguageStringsCall{ctx: ctx, client: c, query: make(url.Values), pathParams: path, region: region}
+}
+
+// locale configures query parameter "locale".
+func (c *LanguageStringsCall) Locale(v string) *LanguageStringsCall {
+	c.query.Set("locale", convertToString(v))
+	return c
+}
+
+// version configures query parameter "version".
+func (c *LanguageStringsCall) Version(v string) *LanguageStringsCall {
+	c.query.Set("version", convertToString(v))
+	return c
+}
+
+func (c *LanguageStringsCall) doRequest() (*http.Response, error) {
+	var body io.Reader
+	switch c.region {
+	case BR, EUNE, EUW, KR, LAN, LAS, NA, OCE, PBE, RU, TR:
+	default:
+		return nil, ErrNotSupportedRegion
+	}
+	c.query.Set("api_key", c.client.apiKey)
+	c.pathParams["region"] = c.region.Name()
+
+	path, err := uritemplates.Expand("/api/lol/static-data/{region}/v1.2/language-strings", c.pathParams)
+	if err != nil {
+		return nil, err
+	}
+	urls := "https://global.api.pvp.net" + path + "?" + c.query.Encode()
+
+	return c.client.doRequest(c.ctx, languageStringsInfo, urls, body)
+}
+
+// Do executes api request.
+//
+// API Errors:
+//  400 - Bad request
+//  401 - Unauthorized
+//  404 - Data not found
+//  429 - Rate limit exceeded
+//  500 - Internal server error
+//  503 - Service unavailable
+func (c *LanguageStringsCall) Do() (*LanguageStrings, error) {
+	res, err := c.doRequest()
+	if err != nil {
+		return nil, err
+	}
+	defer closeBody(res)
+	if err := verifyAPIResponse(res); err != nil {
+		return nil, err
+	}
+	ret := &LanguageStrings{}
+	if err := json.NewDecoder(res.Body).Decode(&ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// LanguagesCall is a builder for "Languages"
+type LanguagesCall struct {
+	ctx        context.Context
+	client     *Client
+	query      url.Values
+	pathParams map[string]string
+	region     Region
+}
+
+var languagesInfo = &OperationInfo{
+	Name:            "Languages",
+	Resource:        "lol-static-data",
+	Version:         "v1.2",
+	Method:          "GET",
+	Path:            "/api/lol/static-data/{region}/v1.2/languages",
+	DocURL:          "https://developer.riotgames.com/api/methods#!/1055/3631",
+	RateLimitNotes:  "Requests to this API will not be counted in your Rate Limit.",
+	RateLimitExempt: true,
+	Regions:         []Region{BR, EUNE, EUW, KR, LAN, LAS, NA, OCE, PBE, RU, TR},
+	Errors: []OperationError{
+		{400, "Bad request"},
+		{401, "Unauthorized"},
+		{404, "Data not found"},
+		{429, "Rate limit exceeded"},
+		{500, "Internal server error"},
+		{503, "Service unavailable"},
+	},
+}
+
+// Info returns information of the operation.
+func (c *LanguagesCall) Info() *OperationInfo { return languagesInfo }
+
+// Retrieve supported languages data.
+//
+//
+// Rate limit notes: Requests to this API will not be counted in your Rate Limit.
+//
+// GET: https://global.api.pvp.net/api/lol/static-data/{region}/v1.2/languages
+//
+// Reference: https://developer.riotgames.com/api/methods#!/1055/3631
+func (c *Client) Languages(ctx context.Context, region Region) *LanguagesCall {
+	path := make(map[string]string)
+	return &LanguagesCall{ctx: ctx, client: c, query: make(url.Values), pathParams: path, region: region}
+}
+
+func (c *LanguagesCall) doRequest() (*http.Response, error) {
+	var body io.Reader
+	switch c.region {
+	case BR, EUNE, EUW, KR, LAN, LAS, NA, OCE, PBE, RU, TR:
+	default:
+		return nil, ErrNotSupportedRegion
+	}
+	c.query.Set("api_key", c.client.apiKey)
+	c.pathParams["region"] = c.region.Name()
+
+	path, err := uritemplates.Expand("/api/lol/static-data/{region}/v1.2/languages", c.pathParams)
+	if err != nil {
+		return nil, err
+	}
+	urls := "https://global.api.pvp.net" + path + "?" + c.query.Encode()
+
+	return c.client.doRequest(c.ctx, languagesInfo, urls, body)
+}
+
+// Do executes api request.
+//
+// API Errors:
+//  400 - Bad request
+//  401 - Unauthorized
+//  404 - Data not found
+//  429 - Rate limit exceeded
+//  500 - Internal server error
+//  503 - Service unavailable
+func (c *LanguagesCall) Do() ([]string, error) {
+	res, err := c.doRequest()
+	if err != nil {
+		return nil, err
+	}
+	defer closeBody(res)
+	if err := verifyAPIResponse(res); err != nil {
+		return nil, err
+	}
+	ret := make([]string, 0)
+	if err := json.NewDecoder(res.Body).Decode(&ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// MapsCall is a builder for "Maps"
+type MapsCall struct {
+	ctx        context.Context
+	client     *Client
+	query      url.Values
+	pathParams map[string]string
+	region     Region
+}
+
+var mapsInfo = &OperationInfo{
+	Name:            "Maps",
+	Resource:        "lol-static-data",
+	Version:         "v1.2",
+	Method:          "GET",
+	Path:            "/api/lol/static-data/{region}/v1.2/map",
+	DocURL:          "https://developer.riotgames.com/api/methods#!/1055/3635",
+	RateLimitNotes:  "Requests to this API will not be counted in your Rate Limit.",
+	RateLimitExempt: true,
+	Regions:         []Region{BR, EUNE, EUW, KR, LAN, LAS, NA, OCE, PBE, RU, TR},
+	Errors: []OperationError{
+		{400, "Bad request"},
+		{401, "Unauthorized"},
+		{404, "Data not found"},
+		{429, "Rate limit exceeded"},
+		{500, "Internal server error"},
+		{503, "Service unavailable"},
+	},
+}
+
+// Info returns information of the operation.
+func (c *MapsCall) Info() *OperationInfo { return mapsInfo }
+
+// Retrieve map data.
+//
+//
+// Rate limit notes: Requests to this API will not be counted in your Rate Limit.
+//
+// GET: https://global.api.pvp.net/api/lol/static-data/{region}/v1.2/map
+//
+// Reference: https://developer.riotgames.com/api/methods#!/1055/3635
+func (c *Client) Maps(ctx context.Context, region Region) *MapsCall {
+	path := make(map[string]string)
+	return &MapsCall{ctx: ctx, client: c, query: make(url.Values), pathParams: path, region: region}
+}
+
+// locale configures query parameter "locale".
+func (c *MapsCall) Locale(v string) *MapsCall {
+	c.query.Set("locale", convertToString(v))
+	return c
+}
+
+// version configures query parameter "version".
+func (c *MapsCall) Version(v string) *MapsCall {
+	c.query.Set("version", convertToString(v))
+	return c
+}
+
+func (c *MapsCall) doRequest() (*http.Response, error) {
+	var body io.Reader
+	switch c.region {
+	case BR, EUNE, EUW, KR, LAN, LAS, NA, OCE, PBE, RU, TR:
+	default:
+		return nil, ErrNotSupportedRegion
+	}
+	c.query.Set("api_key", c.client.apiKey)
+	c.pathParams["region"] = c.region.Name()
+
+	path, err := uritemplates.Expand("/api/lol/static-data/{region}/v1.2/map", c.pathParams)
+	if err != nil {
+		return nil, err
+	}
+	urls := "https://global.api.pvp.net" + path + "?" + c.query.Encode()
+
+	return c.client.doRequest(c.ctx, mapsInfo, urls, body)
+}
+
+// Do executes api request.
+//
+// API Errors:
+//  400 - Bad request
+//  401 - Unauthorized
+//  404 - Data not found
+//  429 - Rate limit exceeded
+//  500 - Internal server error
+//  503 - Service unavailable
+func (c *MapsCall) Do() (*MapData, error) {
+	res, err := c.doRequest()
+	if err != nil {
+		return nil, err
+	}
+	defer closeBody(res)
+	if err := verifyAPIResponse(res); err != nil {
+		return nil, err
+	}
+	ret := &MapData{}
+	if err := json.NewDecoder(res.Body).Decode(&ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// MasteriesCall is a builder for "Masteries"
+type MasteriesCall struct {
+	ctx        context.Context
+	client     *Client
+	query      url.Values
+	pathParams map[string]string
+	region     Region
+}
+
+var masteriesInfo = &OperationInfo{
+	Name:            "Masteries",
+	Resource:        "lol-static-data",
+	Version:         "v1.2",
+	Method:          "GET",
+	Path:            "/api/lol/static-data/{region}/v1.2/mastery",
+	DocURL:          "https://developer.riotgames.com/api/methods#!/1055/3625",
+	RateLimitNotes:  "Requests to this API will not be counted in your Rate Limit.",
+	RateLimitExempt: true,
+	Regions:         []Region{BR, EUNE, EUW, KR, LAN, LAS, NA, OCE, PBE, RU, TR},
+	Errors: []OperationError{
+		{400, "Bad request"},
+		{401, "Unauthorized"},
+		{404, "Data not found"},
+		{429, "Rate limit exceeded"},
+		{500, "Internal server error"},
+		{503, "Service unavailable"},
+	},
+}
+
+// Info returns information of the operation.
+func (c *MasteriesCall) Info() *OperationInfo { return masteriesInfo }
+
+// Retrieves mastery list.
+//
+//
+// Implementation notes: Not all data specified below is returned by default. See the masteryListData parameter for more information.
+//
+// Rate limit notes: Requests to this API will not be counted in your Rate Limit.
+//
+// GET: https://global.api.pvp.net/api/lol/static-data/{region}/v1.2/mastery
+//
+// Reference: https://developer.riotgames.com/api/methods#!/1055/3625
+func (c *Client) Masteries(ctx context.Context, region Region) *MasteriesCall {
+	path := make(map[string]string)
+	return &MasteriesCall{ctx: ctx, client: c, query: make(url.Values), pathParams: path, region: region}
+}
+
+// locale configures query parameter "locale".
+func (c *MasteriesCall) Locale(v string) *MasteriesCall {
+	c.query.Set("locale", convertToString(v))
+	return c
+}
+
+// version configures query parameter "version".
+func (c *MasteriesCall) Version(v string) *MasteriesCall {
+	c.query.Set("version", convertToString(v))
+	return c
+}
+
+// masteryListData configures query parameter "masteryListData".
+func (c *MasteriesCall) MasteryListData(v string) *MasteriesCall {
+	c.query.Set("masteryListData", convertToString(v))
+	return c
+}
+
+func (c *MasteriesCall) doRequest() (*http.Response, error) {
+	var body io.Reader
+	switch c.region {
+	case BR, EUNE, EUW, KR, LAN, LAS, NA, OCE, PBE, RU, TR:
+	default:
+		return nil, ErrNotSupportedRegion
+	}
+	c.query.Set("api_key", c.client.apiKey)
+	c.pathParams["region"] = c.region.Name()
+
+	path, err := uritemplates.Expand("/api/lol/static-data/{region}/v1.2/mastery", c.pathParams)
+	if err != nil {
+		return nil, err
+	}
+	urls := "https://global.api.pvp.net" + path + "?" + c.query.Encode()
+
+	return c.client.doRequest(c.ctx, masteriesInfo, urls, body)
+}
+
+// Do executes api request.
+//
+// API Errors:
+//  400 - Bad request
+//  401 - Unauthorized
+//  404 - Data not found
+//  429 - Rate limit exceeded
+//  500 - Internal server error
+//  503 - Service unavailable
+func (c *MasteriesCall) Do() (*MasteryList, error) {
+	res, err := c.doRequest()
+	if err != nil {
+		return nil, err
+	}
+	defer closeBody(res)
+	if err := verifyAPIResponse(res); err != nil {
+		return nil, err
+	}
+	ret := &MasteryList{}
+	if err := json.NewDecoder(res.Body).Decode(&ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// MasteryCall is a builder for "Mastery"
+type MasteryCall struct {
+	ctx        context.Context
+	client     *Client
+	query      url.Values
+	pathParams map[string]string
+	region     Region
+}
+
+var masteryInfo = &OperationInfo{
+	Name:            "Mastery",
+	Resource:        "lol-static-data",
+	Version:         "v1.2",
+	Method:          "GET",
+	Path:            "/api/lol/static-data/{region}/v1.2/mastery/{id}",
+	DocURL:          "https://developer.riotgames.com/api/methods#!/1055/3626",
+	RateLimitNotes:  "Requests to this API will not be counted in your Rate Limit.",
+	RateLimitExempt: true,
+	Regions:         []Region{BR, EUNE, EUW, KR, LAN, LAS, NA, OCE, PBE, RU, TR},
+	Errors: []OperationError{
+		{400, "Bad request"},
+		{401, "Unauthorized"},
+		{404, "Mastery not found"},
+		{429, "Rate limit exceeded"},
+		{500, "Internal server error"},
+		{503, "Service unavailable"},
+	},
+}
+
+// Info returns information of the operation.
+func (c *MasteryCall) Info() *OperationInfo { return masteryInfo }
+
+// Retrieves mastery item by its unique id.
+//
+//
+// Implementation notes: Not all data specified below is returned by default. See the masteryData parameter for more information.
+//
+// Rate limit notes: Requests to this API will not be counted in your Rate Limit.
+//
+// GET: https://global.api.pvp.net/api/lol/static-data/{region}/v1.2/mastery/{id}
+//
+// Reference: https://developer.riotgames.com/api/methods#!/1055/3626
+func (c *Client) Mastery(ctx context.Context, region Region, id int32) *MasteryCall {
+	path := make(map[string]string)
+	path["id"] = convertToString(id)
+	return &MasteryCall{ctx: ctx, client: c, query: make(url.Values), pathParams: path, region: region}
+}
+
+// locale configures query parameter "locale".
+func (c *MasteryCall) Locale(v string) *MasteryCall {
+	c.query.Set("locale", convertToString(v))
+	return c
+}
+
+// version configures query parameter "version".
+func (c *MasteryCall) Version(v string) *MasteryCall {
+	c.query.Set("version", convertToString(v))
+	return c
+}
+
+// masteryData configures query parameter "masteryData".
+func (c *MasteryCall) MasteryData(v string) *MasteryCall {
+	c.query.Set("masteryData", convertToString(v))
+	return c
+}
+
+func (c *MasteryCall) doRequest() (*http.Response, error) {
+	var body io.Reader
+	switch c.region {
+	case BR, EUNE, EUW, KR, LAN, LAS, NA, OCE, PBE, RU, TR:
+	default:
+		return nil, ErrNotSupportedRegion
+	}
+	c.query.Set("api_key", c.client.apiKey)
+	c.pathParams["region"] = c.region.Name()
+
+	path, err := uritemplates.Expand("/api/lol/static-data/{region}/v1.2/mastery/{id}", c.pathParams)
+	if err != nil {
+		return nil, err
+	}
+	urls := "https://global.api.pvp.net" + path + "?" + c.query.Encode()
+
+	return c.client.doRequest(c.ctx, masteryInfo, urls, body)
+}
+
+// Do executes api request.
+//
+// API Errors:
+//  400 - Bad request
+//  401 - Unauthorized
+//  404 - Mastery not found
+//  429 - Rate limit exceeded
+//  500 - Internal server error
+//  503 - Service unavailable
+func (c *MasteryCall) Do() (*Mastery, error) {
+	res, err := c.doRequest()
+	if err != nil {
+		return nil, err
+	}
+	defer closeBody(res)
+	if err := verifyAPIResponse(res); err != nil {
+		return nil, err
+	}
+	ret := &Mastery{}
+	if err := json.NewDecoder(res.Body).Decode(&ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// RealmCall is a builder for "Realm"
+type RealmCall struct {
+	ctx        context.Context
+	client     *Client
+	query      url.Values
+	pathParams map[string]string
+	region     Region
+}
+
+var realmInfo = &OperationInfo{
+	Name:            "Realm",
+	Resource:        "lol-static-data",
+	Version:         "v1.2",
+	Method:          "GET",
+	Path:            "/api/lol/static-data/{region}/v1.2/realm",
+	DocURL:          "https://developer.riotgames.com/api/methods#!/1055/3632",
+	RateLimitNotes:  "Requests to this API will not be counted in your Rate Limit.",
+	RateLimitExempt: true,
+	Regions:         []Region{BR, EUNE, EUW, KR, LAN, LAS, NA, OCE, PBE, RU, TR},
+	Errors: []OperationError{
+		{400, "Bad request"},
+		{401, "Unauthorized"},
+		{404, "Data not found"},
+		{429, "Rate limit exceeded"},
+		{500, "Internal server error"},
+		{503, "Service unavailable"},
+	},
+}
+
+// Info returns information of the operation.
+func (c *RealmCall) Info() *OperationInfo { return realmInfo }
+
+// Retrieve realm data.
+//
+//
+// Rate limit notes: Requests to this API will not be counted in your Rate Limit.
+//
+// GET: https://global.api.pvp.net/api/lol/static-data/{region}/v1.2/realm
+//
+// Reference: https://developer.riotgames.com/api/methods#!/1055/3632
+func (c *Client) Realm(ctx context.Context, region Region) *RealmCall {
+	path := make(map[string]string)
+	return &RealmCall{ctx: ctx, client: c, query: make(url.Values), pathParams: path, region: region}
+}
+
+func (c *RealmCall) doRequest() (*http.Response, error) {
+	var body io.Reader
+	switch c.region {
+	case BR, EUNE, EUW, KR, LAN, LAS, NA, OCE, PBE, RU, TR:
+	default:
+		return nil, ErrNotSupportedRegion
+	}
+	c.query.Set("api_key", c.client.apiKey)
+	c.pathParams["region"] = c.region.Name()
+
+	path, err := uritemplates.Expand("/api/lol/static-data/{region}/v1.2/realm", c.pathParams)
+	if err != nil {
+		return nil, err
+	}
+	urls := "https://global.api.pvp.net" + path + "?" + c.query.Encode()
+
+	return c.client.doRequest(c.ctx, realmInfo, urls, body)
+}
+
+// Do executes api request.
+//
+// API Errors:
+//  400 - Bad request
+//  401 - Unauthorized
+//  404 - Data not found
+//  429 - Rate limit exceeded
+//  500 - Internal server error
+//  503 - Service unavailable
+func (c *RealmCall) Do() (*Realm, error) {
+	res, err := c.doRequest()
+	if err != nil {
+		return nil, err
+	}
+	defer closeBody(res)
+	if err := verifyAPIResponse(res); err != nil {
+		return nil, err
+	}
+	ret := &Realm{}
+	if err := json.NewDecoder(res.Body).Decode(&ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// RunesCall is a builder for "Runes"
+type RunesCall struct {
+	ctx        context.Context
+	client     *Client
+	query      url.Values
+	pathParams map[string]string
+	region     Region
+}
+
+var runesInfo = &OperationInfo{
+	Name:            "Runes",
+	Resource:        "lol-static-data",
+	Version:         "v1.2",
+	Method:          "GET",
+	Path:            "/api/lol/static-data/{region}/v1.2/rune",
+	DocURL:          "https://developer.riotgames.com/api/methods#!/1055/3623",
+	RateLimitNotes:  "Requests to this API will not be counted in your Rate Limit.",
+	RateLimitExempt: true,
+	Regions:         []Region{BR, EUNE, EUW, KR, LAN, LAS, NA, OCE, PBE, RU, TR},
+	Errors: []OperationError{
+		{400, "Bad request"},
+		{401, "Unauthorized"},
+		{404, "Data not found"},
+		{429, "Rate limit exceeded"},
+		{500, "Internal server error"},
+		{503, "Service unavailable"},
+	},
+}
+
+// Info returns information of the operation.
+func (c *RunesCall) Info() *OperationInfo { return runesInfo }
+
+// Retrieves rune list.
+//
+//
+// Implementation notes: Not all data specified below is returned by default. See the runeListData parameter for more information.
+//
+// Rate limit notes: Requests to this API will not be counted in your Rate Limit.
+//
+// GET: https://global.api.pvp.net/api/lol/static-data/{region}/v1.2/rune
+//
+// Reference: https://developer.riotgames.com/api/methods#!/1055/3623
+func (c *Client) Runes(ctx context.Context, region Region) *RunesCall {
+	path := make(map[string]string)
+	return &RunesCall{ctx: ctx, client: c, query: make(url.Values), pathParams: path, region: region}
+}
+
+// locale configures query parameter "locale".
+func (c *RunesCall) Locale(v string) *RunesCall {
+	c.query.Set("locale", convertToString(v))
+	return c
+}
+
+// version configures query parameter "version".
+func (c *RunesCall) Version(v string) *RunesCall {
+	c.query.Set("version", convertToString(v))
+	return c
+}
+
+// runeListData configures query parameter "runeListData".
+func (c *RunesCall) RuneListData(v string) *RunesCall {
+	c.query.Set("runeListData", convertToString(v))
+	return c
+}
+
+func (c *RunesCall) doRequest() (*http.Response, error) {
+	var body io.Reader
+	switch c.region {
+	case BR, EUNE, EUW, KR, LAN, LAS, NA, OCE, PBE, RU, TR:
+	default:
+		return nil, ErrNotSupportedRegion
+	}
+	c.query.Set("api_key", c.client.apiKey)
+	c.pathParams["region"] = c.region.Name()
+
+	path, err := uritemplates.Expand("/api/lol/static-data/{region}/v1.2/rune", c.pathParams)
+	if err != nil {
+		return nil, err
+	}
+	urls := "https://global.api.pvp.net" + path + "?" + c.query.Encode()
+
+	return c.client.doRequest(c.ctx, runesInfo, urls, body)
+}
+
+// Do executes api request.
+//
+// API Errors:
+//  400 - Bad request
+//  401 - Unauthorized
+//  404 - Data not found
+//  429 - Rate limit exceeded
+//  500 - Internal server error
+//  503 - Service unavailable
+func (c *RunesCall) Do() (*RuneList, error) {
+	res, err := c.doRequest()
+	if err != nil {
+		return nil, err
+	}
+	defer closeBody(res)
+	if err := verifyAPIResponse(res); err != nil {
+		return nil, err
+	}
+	ret := &RuneList{}
+	if err := json.NewDecoder(res.Body).Decode(&ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// RuneCall is a builder for "Rune"
+type RuneCall struct {
+	ctx        context.Context
+	client     *Client
+	query      url.Values
+	pathParams map[string]string
+	region     Region
+}
+
+var runeInfo = &OperationInfo{
+	Name:            "Rune",
+	Resource:        "lol-static-data",
+	Version:         "v1.2",
+	Method:          "GET",
+	Path:            "/api/lol/static-data/{region}/v1.2/rune/{id}",
+	DocURL:          "https://developer.riotgames.com/api/methods#!/1055/3629",
+	RateLimitNotes:  "Requests to this API will not be counted in your Rate Limit.",
+	RateLimitExempt: true,
+	Regions:         []Region{BR, EUNE, EUW, KR, LAN, LAS, NA, OCE, PBE, RU, TR},
+	Errors: []OperationError{
+		{400, "Bad request"},
+		{401, "Unauthorized"},
+		{404, "Rune not found"},
+		{429, "Rate limit exceeded"},
+		{500, "Internal server error"},
+		{503, "Service unavailable"},
+	},
+}
+
+// Info returns information of the operation.
+func (c *RuneCall) Info() *OperationInfo { return runeInfo }
+
+// Retrieves rune by its unique id.
+//
+//
+// Implementation notes: Not all data specified below is returned by default. See the runeData parameter for more information.
+//
+// Rate limit notes: Requests to this API will not be counted in your Rate Limit.
+//
+// GET: https://global.api.pvp.net/api/lol/static-data/{region}/v1.2/rune/{id}
+//
+// Reference: https://developer.riotgames.com/api/methods#!/1055/3629
+func (c *Client) Rune(ctx context.Context, region Region, id int32) *RuneCall {
+	path := make(map[string]string)
+	path["id"] = convertToString(id)
+	return &RuneCall{ctx: ctx, client: c, query: make(url.Values), pathParams: path, region: region}
+}
+
+// locale configures query parameter "locale".
+func (c *RuneCall) Locale(v string) *RuneCall {
+	c.query.Set("locale", convertToString(v))
+	return c
+}
+
+// version configures query parameter "version".
+func (c *RuneCall) Version(v string) *RuneCall {
+	c.query.Set("version", convertToString(v))
+	return c
+}
+
+// runeData configures query parameter "runeData".
+func (c *RuneCall) RuneData(v string) *RuneCall {
+	c.query.Set("runeData", convertToString(v))
+	return c
+}
+
+func (c *RuneCall) doRequest() (*http.Response, error) {
+	var body io.Reader
+	switch c.region {
+	case BR, EUNE, EUW, KR, LAN, LAS, NA, OCE, PBE, RU, TR:
+	default:
+		return nil, ErrNotSupportedRegion
+	}
+	c.query.Set("api_key", c.client.apiKey)
+	c.pathParams["region"] = c.region.Name()
+
+	path, err := uritemplates.Expand("/api/lol/static-data/{region}/v1.2/rune/{id}", c.pathParams)
+	if err != nil {
+		return nil, err
+	}
+	urls := "https://global.api.pvp.net" + path + "?" + c.query.Encode()
+
+	return c.client.doRequest(c.ctx, runeInfo, urls, body)
+}
+
+// Do executes api request.
+//
+// API Errors:
+//  400 - Bad request
+//  401 - Unauthorized
+//  404 - Rune not found
+//  429 - Rate limit exceeded
+//  500 - Internal server error
+//  503 - Service unavailable
+func (c *RuneCall) Do() (*Rune, error) {
+	res, err := c.doRequest()
+	if err != nil {
+		return nil, err
+	}
+	defer closeBody(res)
+	if err := verifyAPIResponse(res); err != nil {
+		return nil, err
+	}
+	ret := &Rune{}
+	if err := json.NewDecoder(res.Body).Decode(&ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// SummonerSpellsCall is a builder for "SummonerSpells"
+type SummonerSpellsCall struct {
+	ctx        context.Context
+	client     *Client
+	query      url.Values
+	pathParams map[string]string
+	region     Region
+}
+
+var summonerSpellsInfo = &OperationInfo{
+	Name:            "SummonerSpells",
+	Resource:        "lol-static-data",
+	Version:         "v1.2",
+	Method:          "GET",
+	Path:            "/api/lol/static-data/{region}/v1.2/summoner-spell",
+	DocURL:          "https://developer.riotgames.com/api/methods#!/1055/3634",
+	RateLimitNotes:  "Requests to this API will not be counted in your Rate Limit.",
+	RateLimitExempt: true,
+	Regions:         []Region{BR, EUNE, EUW, KR, LAN, LAS, NA, OCE, PBE, RU, TR},
+	Errors: []OperationError{
+		{400, "Bad request"},
+		{401, "Unauthorized"},
+		{404, "Data not found"},
+		{429, "Rate limit exceeded"},
+		{500, "Internal server error"},
+		{503, "Service unavailable"},
+	},
+}
+
+// Info returns information of the operation.
+func (c *SummonerSpellsCall) Info() *OperationInfo { return summonerSpellsInfo }
+
+// Retrieves summoner spell list.
+//
+//
+// Implementation notes: Not all data specified below is returned by default. See the spellData parameter for more information.
+//
+// Rate limit notes: Requests to this API will not be counted in your Rate Limit.
+//
+// GET: https://global.api.pvp.net/api/lol/static-data/{region}/v1.2/summoner-spell
+//
+// Reference: https://developer.riotgames.com/api/methods#!/1055/3634
+func (c *Client) SummonerSpells(ctx context.Context, region Region) *SummonerSpellsCall {
+	path := make(map[string]string)
+	return &SummonerSpellsCall{ctx: ctx, client: c, query: make(url.Values), pathParams: path, region: region}
+}
+
+// locale configures query parameter "locale".
+func (c *SummonerSpellsCall) Locale(v string) *SummonerSpellsCall {
+	c.query.Set("locale", convertToString(v))
+	return c
+}
+
+// version configures query parameter "version".
+func (c *SummonerSpellsCall) Version(v string) *SummonerSpellsCall {
+	c.query.Set("version", convertToString(v))
+	return c
+}
+
+// dataByID configures query parameter "dataById".
+func (c *SummonerSpellsCall) DataByID(v bool) *SummonerSpellsCall {
+	c.query.Set("dataByID", convertToString(v))
+	return c
+}
+
+// spellData configures query parameter "spellData".
+func (c *SummonerSpellsCall) SpellData(v string) *SummonerSpellsCall {
+	c.query.Set("spellData", convertToString(v))
+	return c
+}
+
+func (c *SummonerSpellsCall) doRequest() (*http.Response, error) {
+	var body io.Reader
+	switch c.region {
+	case BR, EUNE, EUW, KR, LAN, LAS, NA, OCE, PBE, RU, TR:
+	default:
+		return nil, ErrNotSupportedRegion
+	}
+	c.query.Set("api_key", c.client.apiKey)
+	c.pathParams["region"] = c.region.Name()
+
+	path, err := uritemplates.Expand("/api/lol/static-data/{region}/v1.2/summoner-spell", c.pathParams)
+	if err != nil {
+		return nil, err
+	}
+	urls := "https://global.api.pvp.net" + path + "?" + c.query.Encode()
+
+	return c.client.doRequest(c.ctx, summonerSpellsInfo, urls, body)
+}
+
+// Do executes api request.
+//
+// API Errors:
+//  400 - Bad request
+//  401 - Unauthorized
+//  404 - Data not found
+//  429 - Rate limit exceeded
+//  500 - Internal server error
+//  503 - Service unavailable
+func (c *SummonerSpellsCall) Do() (*SummonerSpellList, error) {
+	res, err := c.doRequest()
+	if err != nil {
+		return nil, err
+	}
+	defer closeBody(res)
+	if err := verifyAPIResponse(res); err != nil {
+		return nil, err
+	}
+	ret := &SummonerSpellList{}
+	if err := json.NewDecoder(res.Body).Decode(&ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// SummonerSpellCall is a builder for "SummonerSpell"
+type SummonerSpellCall struct {
+	ctx        context.Context
+	client     *Client
+	query      url.Values
+	pathParams map[string]string
+	region     Region
+}
+
+var summonerSpellInfo = &OperationInfo{
+	Name:            "SummonerSpell",
+	Resource:        "lol-static-data",
+	Version:         "v1.2",
+	Method:          "GET",
+	Path:            "/api/lol/static-data/{region}/v1.2/summoner-spell/{id}",
+	DocURL:          "https://developer.riotgames.com/api/methods#!/1055/3628",
+	RateLimitNotes:  "Requests to this API will not be counted in your Rate Limit.",
+	RateLimitExempt: true,
+	Regions:         []Region{BR, EUNE, EUW, KR, LAN, LAS, NA, OCE, PBE, RU, TR},
+	Errors: []OperationError{
+		{400, "Bad request"},
+		{401, "Unauthorized"},
+		{404, "Spell not found"},
+		{429, "Rate limit exceeded"},
+		{500, "Internal server error"},
+		{503, "Service unavailable"},
+	},
+}
+
+// Info returns information of the operation.
+func (c *SummonerSpellCall) Info() *OperationInfo { return summonerSpellInfo }
+
+// Retrieves summoner spell by its unique id.
+//
+//
+// Implementation notes: Not all data specified below is returned by default. See the spellData parameter for more information.
+//
+// Rate limit notes: Requests to this API will not be counted in your Rate Limit.
+//
+// GET: https://global.api.pvp.net/api/lol/static-data/{region}/v1.2/summoner-spell/{id}
+//
+// Reference: https://developer.riotgames.com/api/methods#!/1055/3628
+func (c *Client) SummonerSpell(ctx context.Context, region Region, id int32) *SummonerSpellCall {
+	path := make(map[string]string)
+	path["id"] = convertToString(id)
+	return &SummonerSpellCall{ctx: ctx, client: c, query: make(url.Values), pathParams: path, region: region}
+}
+
+// locale configures query parameter "locale".
+func (c *SummonerSpellCall) Locale(v string) *SummonerSpellCall {
+	c.query.Set("locale", convertToString(v))
+	return c
+}
+
+// version configures query parameter "version".
+func (c *SummonerSpellCall) Version(v string) *SummonerSpellCall {
+	c.query.Set("version", convertToString(v))
+	return c
+}
+
+// spellData configures query parameter "spellData".
+func (c *SummonerSpellCall) SpellData(v string) *SummonerSpellCall {
+	c.query.Set("spellData", convertToString(v))
+	return c
+}
+
+func (c *SummonerSpellCall) doRequest() (*http.Response, error) {
+	var body io.Reader
+	switch c.region {
+	case BR, EUNE, EUW, KR, LAN, LAS, NA, OCE, PBE, RU, TR:
+	default:
+		return nil, ErrNotSupportedRegion
+	}
+	c.query.Set("api_key", c.client.apiKey)
+	c.pathParams["region"] = c.region.Name()
+
+	path, err := uritemplates.Expand("/api/lol/static-data/{region}/v1.2/summoner-spell/{id}", c.pathParams)
+	if err != nil {
+		return nil, err
+	}
+	urls := "https://global.api.pvp.net" + path + "?" + c.query.Encode()
+
+	return c.client.doRequest(c.ctx, summonerSpellInfo, urls, body)
+}
+
+// Do executes api request.
+//
+// API Errors:
+//  400 - Bad request
+//  401 - Unauthorized
+//  404 - Spell not found
+//  429 - Rate limit exceeded
+//  500 - Internal server error
+//  503 - Service unavailable
+func (c *SummonerSpellCall) Do() (*SummonerSpell, error) {
+	res, err := c.doRequest()
+	if err != nil {
+		return nil, err
+	}
+	defer closeBody(res)
+	if err := verifyAPIResponse(res); err != nil {
+		return nil, err
+	}
+	ret := &SummonerSpell{}
+	if err := json.NewDecoder(res.Body).Decode(&ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// VersionsCall is a builder for "Versions"
+type VersionsCall struct {
+	ctx        context.Context
+	client     *Client
+	query      url.Values
+	pathParams map[string]string
+	region     Region
+}
+
+var versionsInfo = &OperationInfo{
+	Name:            "Versions",
+	Resource:        "lol-static-data",
+	Version:         "v1.2",
+	Method:          "GET",
+	Path:            "/api/lol/static-data/{region}/v1.2/versions",
+	DocURL:          "https://developer.riotgames.com/api/methods#!/1055/3630",
+	RateLimitNotes:  "Requests to this API will not be counted in your Rate Limit.",
+	RateLimitExempt: true,
+	Regions:         []Region{BR, EUNE, EUW, KR, LAN, LAS, NA, OCE, PBE, RU, TR},
+	Errors: []OperationError{
+		{400, "Bad request"},
+		{401, "Unauthorized"},
+		{404, "Data not found"},
+		{429, "Rate limit exceeded"},
+		{500, "Internal server error"},
+		{503, "Service unavailable"},
+	},
+}
+
+// Info returns information of the operation.
+func (c *VersionsCall) Info() *OperationInfo { return versionsInfo }
+
+// Retrieve version data.
+//
+//
+// Rate limit notes: Requests to this API will not be counted in your Rate Limit.
+//
+// GET: https://global.api.pvp.net/api/lol/static-data/{region}/v1.2/versions
+//
+// Reference: https://developer.riotgames.com/api/methods#!/1055/3630
+func (c *Client) Versions(ctx context.Context, region Region) *VersionsCall {
+	path := make(map[string]string)
+	return &VersionsCall{ctx: ctx, client: c, query: make(url.Values), pathParams: path, region: region}
+}
+
+func (c *VersionsCall) doRequest() (*http.Response, error) {
+	var body io.Reader
+	switch c.region {
+	case BR, EUNE, EUW, KR, LAN, LAS, NA, OCE, PBE, RU, TR:
+	default:
+		return nil, ErrNotSupportedRegion
+	}
+	c.query.Set("api_key", c.client.apiKey)
+	c.pathParams["region"] = c.region.Name()
+
+	path, err := uritemplates.Expand("/api/lol/static-data/{region}/v1.2/versions", c.pathParams)
+	if err != nil {
+		return nil, err
+	}
+	urls := "https://global.api.pvp.net" + path + "?" + c.query.Encode()
+
+	return c.client.doRequest(c.ctx, versionsInfo, urls, body)
+}
+
+// Do executes api request.
+//
+// API Errors:
+//  400 - Bad request
+//  401 - Unauthorized
+//  404 - Data not found
+//  429 - Rate limit exceeded
+//  500 - Internal server error
+//  503 - Service unavailable
+func (c *VersionsCall) Do() ([]string, error) {
+	res, err := c.doRequest()
+	if err != nil {
+		return nil, err
+	}
+	defer closeBody(res)
+	if err := verifyAPIResponse(res); err != nil {
+		return nil, err
+	}
+	ret := make([]string, 0)
+	if err := json.NewDecoder(res.Body).Decode(&ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
@@ -0,0 +1,176 @@
+// Generated by go-lol-generator. DO NOT EDIT.
+
+package lol
+
+import "testing"
+import "golang.org/x/net/context"
+
+func TestLolStaticDataOperations(t *testing.T) {
+	runOperationTests(t, []operationTest{
+		{
+			name:        "ChampionDatas",
+			region:      NA,
+			unsupported: Global,
+			path:        "/api/lol/static-data/na/v1.2/champion",
+			apiKey:      true,
+			fixture:     "{\"data\":{\"1\":{\"allytips\":[\"example\"],\"blurb\":\"example\",\"enemytips\":[\"example\"],\"id\":1,\"image\":{\"full\":\"example\",\"group\":\"example\",\"h\":1,\"sprite\":\"example\",\"w\":1,\"x\":1,\"y\":1},\"info\":{\"attack\":1,\"defense\":1,\"difficulty\":1,\"magic\":1},\"key\":\"example\",\"lore\":\"example\",\"name\":\"example\",\"partype\":\"example\",\"passive\":{\"description\":\"example\",\"image\":{\"full\":\"example\",\"group\":\"example\",\"h\":1,\"sprite\":\"example\",\"w\":1,\"x\":1,\"y\":1},\"name\":\"example\",\"sanitizedDescription\":\"example\"},\"recommended\":[{\"blocks\":[{\"items\":[{\"count\":1,\"id\":1}],\"recMath\":true,\"type\":\"example\"}],\"champion\":\"example\",\"map\":\"example\",\"mode\":\"example\",\"priority\":true,\"title\":\"example\",\"type\":\"example\"}],\"skins\":[{\"id\":1,\"name\":\"example\",\"num\":1}],\"spells\":[{\"altimages\":[{\"full\":\"example\",\"group\":\"example\",\"h\":1,\"sprite\":\"example\",\"w\":1,\"x\":1,\"y\":1}],\"cooldown\":[1],\"cooldownBurn\":\"example\",\"cost\":[1],\"costBurn\":\"example\",\"costType\":\"example\",\"description\":\"example\",\"effect\":[[1]],\"effectBurn\":[\"example\"],\"image\":{\"full\":\"example\",\"group\":\"example\",\"h\":1,\"sprite\":\"example\",\"w\":1,\"x\":1,\"y\":1},\"key\":\"example\",\"leveltip\":{\"effect\":[\"example\"],\"label\":[\"example\"]},\"maxrank\":1,\"name\":\"example\",\"range\":\"self\",\"rangeBurn\":\"example\",\"resource\":\"example\",\"sanitizedDescription\":\"example\",\"sanitizedTooltip\":\"example\",\"tooltip\":\"example\",\"vars\":[{\"coeff\":[1],\"dyn\":\"example\",\"key\":\"example\",\"link\":\"example\",\"ranksWith\":\"example\"}]}],\"stats\":{\"armor\":1,\"armorperlevel\":1,\"attackdamage\":1,\"attackdamageperlevel\":1,\"attackrange\":1,\"attackspeedoffset\":1,\"attackspeedperlevel\":1,\"crit\":1,\"critperlevel\":1,\"hp\":1,\"hpperlevel\":1,\"hpregen\":1,\"hpregenperlevel\":1,\"movespeed\":1,\"mp\":1,\"mpperlevel\":1,\"mpregen\":1,\"mpregenperlevel\":1,\"spellblock\":1,\"spellblockperlevel\":1},\"tags\":[\"example\"],\"title\":\"example\"}},\"format\":\"example\",\"keys\":{\"1\":\"example\"},\"type\":\"example\",\"version\":\"example\"}",
+			do: func(c *Client, region Region) (interface{}, error) {
+				return c.ChampionDatas(context.TODO(), region).Do()
+			},
+		},
+		{
+			name:        "ChampionData",
+			region:      NA,
+			unsupported: Global,
+			path:        "/api/lol/static-data/na/v1.2/champion/1",
+			apiKey:      true,
+			fixture:     "{\"allytips\":[\"example\"],\"blurb\":\"example\",\"enemytips\":[\"example\"],\"id\":1,\"image\":{\"full\":\"example\",\"group\":\"example\",\"h\":1,\"sprite\":\"example\",\"w\":1,\"x\":1,\"y\":1},\"info\":{\"attack\":1,\"defense\":1,\"difficulty\":1,\"magic\":1},\"key\":\"example\",\"lore\":\"example\",\"name\":\"example\",\"partype\":\"example\",\"passive\":{\"description\":\"example\",\"image\":{\"full\":\"example\",\"group\":\"example\",\"h\":1,\"sprite\":\"example\",\"w\":1,\"x\":1,\"y\":1},\"name\":\"example\",\"sanitizedDescription\":\"example\"},\"recommended\":[{\"blocks\":[{\"items\":[{\"count\":1,\"id\":1}],\"recMath\":true,\"type\":\"example\"}],\"champion\":\"example\",\"map\":\"example\",\"mode\":\"example\",\"priority\":true,\"title\":\"example\",\"type\":\"example\"}],\"skins\":[{\"id\":1,\"name\":\"example\",\"num\":1}],\"spells\":[{\"altimages\":[{\"full\":\"example\",\"group\":\"example\",\"h\":1,\"sprite\":\"example\",\"w\":1,\"x\":1,\"y\":1}],\"cooldown\":[1],\"cooldownBurn\":\"example\",\"cost\":[1],\"costBurn\":\"example\",\"costType\":\"example\",\"description\":\"example\",\"effect\":[[1]],\"effectBurn\":[\"example\"],\"image\":{\"full\":\"example\",\"group\":\"example\",\"h\":1,\"sprite\":\"example\",\"w\":1,\"x\":1,\"y\":1},\"key\":\"example\",\"leveltip\":{\"effect\":[\"example\"],\"label\":[\"example\"]},\"maxrank\":1,\"name\":\"example\",\"range\":\"self\",\"rangeBurn\":\"example\",\"resource\":\"example\",\"sanitizedDescription\":\"example\",\"sanitizedTooltip\":\"example\",\"tooltip\":\"example\",\"vars\":[{\"coeff\":[1],\"dyn\":\"example\",\"key\":\"example\",\"link\":\"example\",\"ranksWith\":\"example\"}]}],\"stats\":{\"armor\":1,\"armorperlevel\":1,\"attackdamage\":1,\"attackdamageperlevel\":1,\"attackrange\":1,\"attackspeedoffset\":1,\"attackspeedperlevel\":1,\"crit\":1,\"critperlevel\":1,\"hp\":1,\"hpperlevel\":1,\"hpregen\":1,\"hpregenperlevel\":1,\"movespeed\":1,\"mp\":1,\"mpperlevel\":1,\"mpregen\":1,\"mpregenperlevel\":1,\"spellblock\":1,\"spellblockperlevel\":1},\"tags\":[\"example\"],\"title\":\"example\"}",
+			do: func(c *Client, region Region) (interface{}, error) {
+				return c.ChampionData(context.TODO(), region, 1).Do()
+			},
+		},
+		{
+			name:        "Items",
+			region:      NA,
+			unsupported: Global,
+			path:        "/api/lol/static-data/na/v1.2/item",
+			apiKey:      true,
+			fixture:     "{\"basic\":{\"colloq\":\"example\",\"consumeOnFull\":true,\"consumed\":true,\"depth\":1,\"description\":\"example\",\"from\":[\"example\"],\"gold\":{\"base\":1,\"purchasable\":true,\"sell\":1,\"total\":1},\"group\":\"example\",\"hideFromAll\":true,\"id\":1,\"image\":{\"full\":\"example\",\"group\":\"example\",\"h\":1,\"sprite\":\"example\",\"w\":1,\"x\":1,\"y\":1},\"inStore\":true,\"into\":[\"example\"],\"maps\":{\"1\":true},\"name\":\"example\",\"plaintext\":\"example\",\"requiredChampion\":\"example\",\"rune\":{\"isRune\":true,\"tier\":\"example\",\"type\":\"example\"},\"sanitizedDescription\":\"example\",\"specialRecipe\":1,\"stacks\":1,\"stats\":{\"FlatArmorMod\":1,\"FlatAttackSpeedMod\":1,\"FlatBlockMod\":1,\"FlatCritChanceMod\":1,\"FlatCritDamageMod\":1,\"FlatEXPBonus\":1,\"FlatEnergyPoolMod\":1,\"FlatEnergyRegenMod\":1,\"FlatHPPoolMod\":1,\"FlatHPRegenMod\":1,\"FlatMPPoolMod\":1,\"FlatMPRegenMod\":1,\"FlatMagicDamageMod\":1,\"FlatMovementSpeedMod\":1,\"FlatPhysicalDamageMod\":1,\"FlatSpellBlockMod\":1,\"PercentArmorMod\":1,\"PercentAttackSpeedMod\":1,\"PercentBlockMod\":1,\"PercentCritChanceMod\":1,\"PercentCritDamageMod\":1,\"PercentDodgeMod\":1,\"PercentEXPBonus\":1,\"PercentHPPoolMod\":1,\"PercentHPRegenMod\":1,\"PercentLifeStealMod\":1,\"PercentMPPoolMod\":1,\"PercentMPRegenMod\":1,\"PercentMagicDamageMod\":1,\"PercentMovementSpeedMod\":1,\"PercentPhysicalDamageMod\":1,\"PercentSpellBlockMod\":1,\"PercentSpellVampMod\":1,\"rFlatArmorModPerLevel\":1,\"rFlatArmorPenetrationMod\":1,\"rFlatArmorPenetrationModPerLevel\":1,\"rFlatCritChanceModPerLevel\":1,\"rFlatCritDamageModPerLevel\":1,\"rFlatDodgeMod\":1,\"rFlatDodgeModPerLevel\":1,\"rFlatEnergyModPerLevel\":1,\"rFlatEnergyRegenModPerLevel\":1,\"rFlatGoldPer10Mod\":1,\"rFlatHPModPerLevel\":1,\"rFlatHPRegenModPerLevel\":1,\"rFlatMPModPerLevel\":1,\"rFlatMPRegenModPerLevel\":1,\"rFlatMagicDamageModPerLevel\":1,\"rFlatMagicPenetrationMod\":1,\"rFlatMagicPenetrationModPerLevel\":1,\"rFlatMovementSpeedModPerLevel\":1,\"rFlatPhysicalDamageModPerLevel\":1,\"rFlatSpellBlockModPerLevel\":1,\"rFlatTimeDeadMod\":1,\"rFlatTimeDeadModPerLevel\":1,\"rPercentArmorPenetrationMod\":1,\"rPercentArmorPenetrationModPerLevel\":1,\"rPercentAttackSpeedModPerLevel\":1,\"rPercentCooldownMod\":1,\"rPercentCooldownModPerLevel\":1,\"rPercentMagicPenetrationMod\":1,\"rPercentMagicPenetrationModPerLevel\":1,\"rPercentMovementSpeedModPerLevel\":1,\"rPercentTimeDeadMod\":1,\"rPercentTimeDeadModPerLevel\":1},\"tags\":[\"example\"]},\"data\":{\"1\":{\"colloq\":\"example\",\"consumeOnFull\":true,\"consumed\":true,\"depth\":1,\"description\":\"example\",\"effect\":{\"1\":\"example\"},\"from\":[\"example\"],\"gold\":{\"base\":1,\"purchasable\":true,\"sell\":1,\"total\":1},\"group\":\"example\",\"hideFromAll\":true,\"id\":1,\"image\":{\"full\":\"example\",\"group\":\"example\",\"h\":1,\"sprite\":\"example\",\"w\":1,\"x\":1,\"y\":1},\"inStore\":true,\"into\":[\"example\"],\"maps\":{\"1\":true},\"name\":\"example\",\"plaintext\":\"example\",\"requiredChampion\":\"example\",\"rune\":{\"isRune\":true,\"tier\":\"example\",\"type\":\"example\"},\"sanitizedDescription\":\"example\",\"specialRecipe\":1,\"stacks\":1,\"stats\":{\"FlatArmorMod\":1,\"FlatAttackSpeedMod\":1,\"FlatBlockMod\":1,\"FlatCritChanceMod\":1,\"FlatCritDamageMod\":1,\"FlatEXPBonus\":1,\"FlatEnergyPoolMod\":1,\"FlatEnergyRegenMod\":1,\"FlatHPPoolMod\":1,\"FlatHPRegenMod\":1,\"FlatMPPoolMod\":1,\"FlatMPRegenMod\":1,\"FlatMagicDamageMod\":1,\"FlatMovementSpeedMod\":1,\"FlatPhysicalDamageMod\":1,\"FlatSpellBlockMod\":1,\"PercentArmorMod\":1,\"PercentAttackSpeedMod\":1,\"PercentBlockMod\":1,\"PercentCritChanceMod\":1,\"PercentCritDamageMod\":1,\"PercentDodgeMod\":1,\"PercentEXPBonus\":1,\"PercentHPPoolMod\":1,\"PercentHPRegenMod\":1,\"PercentLifeStealMod\":1,\"PercentMPPoolMod\":1,\"PercentMPRegenMod\":1,\"PercentMagicDamageMod\":1,\"PercentMovementSpeedMod\":1,\"PercentPhysicalDamageMod\":1,\"PercentSpellBlockMod\":1,\"PercentSpellVampMod\":1,\"rFlatArmorModPerLevel\":1,\"rFlatArmorPenetrationMod\":1,\"rFlatArmorPenetrationModPerLevel\":1,\"rFlatCritChanceModPerLevel\":1,\"rFlatCritDamageModPerLevel\":1,\"rFlatDodgeMod\":1,\"rFlatDodgeModPerLevel\":1,\"rFlatEnergyModPerLevel\":1,\"rFlatEnergyRegenModPerLevel\":1,\"rFlatGoldPer10Mod\":1,\"rFlatHPModPerLevel\":1,\"rFlatHPRegenModPerLevel\":1,\"rFlatMPModPerLevel\":1,\"rFlatMPRegenModPerLevel\":1,\"rFlatMagicDamageModPerLevel\":1,\"rFlatMagicPenetrationMod\":1,\"rFlatMagicPenetrationModPerLevel\":1,\"rFlatMovementSpeedModPerLevel\":1,\"rFlatPhysicalDamageModPerLevel\":1,\"rFlatSpellBlockModPerLevel\":1,\"rFlatTimeDeadMod\":1,\"rFlatTimeDeadModPerLevel\":1,\"rPercentArmorPenetrationMod\":1,\"rPercentArmorPenetrationModPerLevel\":1,\"rPercentAttackSpeedModPerLevel\":1,\"rPercentCooldownMod\":1,\"rPercentCooldownModPerLevel\":1,\"rPercentMagicPenetrationMod\":1,\"rPercentMagicPenetrationModPerLevel\":1,\"rPercentMovementSpeedModPerLevel\":1,\"rPercentTimeDeadMod\":1,\"rPercentTimeDeadModPerLevel\":1},\"tags\":[\"example\"]}},\"groups\":[{\"MaxGroupOwnable\":\"example\",\"key\":\"example\"}],\"tree\":[{\"header\":\"example\",\"tags\":[\"example\"]}],\"type\":\"example\",\"version\":\"example\"}",
+			do: func(c *Client, region Region) (interface{}, error) {
+				return c.Items(context.TODO(), region).Do()
+			},
+		},
+		{
+			name:        "Item",
+			region:      NA,
+			unsupported: Global,
+			path:        "/api/lol/static-data/na/v1.2/item/1",
+			apiKey:      true,
+			fixture:     "{\"colloq\":\"example\",\"consumeOnFull\":true,\"consumed\":true,\"depth\":1,\"description\":\"example\",\"effect\":{\"1\":\"example\"},\"from\":[\"example\"],\"gold\":{\"base\":1,\"purchasable\":true,\"sell\":1,\"total\":1},\"group\":\"example\",\"hideFromAll\":true,\"id\":1,\"image\":{\"full\":\"example\",\"group\":\"example\",\"h\":1,\"sprite\":\"example\",\"w\":1,\"x\":1,\"y\":1},\"inStore\":true,\"into\":[\"example\"],\"maps\":{\"1\":true},\"name\":\"example\",\"plaintext\":\"example\",\"requiredChampion\":\"example\",\"rune\":{\"isRune\":true,\"tier\":\"example\",\"type\":\"example\"},\"sanitizedDescription\":\"example\",\"specialRecipe\":1,\"stacks\":1,\"stats\":{\"FlatArmorMod\":1,\"FlatAttackSpeedMod\":1,\"FlatBlockMod\":1,\"FlatCritChanceMod\":1,\"FlatCritDamageMod\":1,\"FlatEXPBonus\":1,\"FlatEnergyPoolMod\":1,\"FlatEnergyRegenMod\":1,\"FlatHPPoolMod\":1,\"FlatHPRegenMod\":1,\"FlatMPPoolMod\":1,\"FlatMPRegenMod\":1,\"FlatMagicDamageMod\":1,\"FlatMovementSpeedMod\":1,\"FlatPhysicalDamageMod\":1,\"FlatSpellBlockMod\":1,\"PercentArmorMod\":1,\"PercentAttackSpeedMod\":1,\"PercentBlockMod\":1,\"PercentCritChanceMod\":1,\"PercentCritDamageMod\":1,\"PercentDodgeMod\":1,\"PercentEXPBonus\":1,\"PercentHPPoolMod\":1,\"PercentHPRegenMod\":1,\"PercentLifeStealMod\":1,\"PercentMPPoolMod\":1,\"PercentMPRegenMod\":1,\"PercentMagicDamageMod\":1,\"PercentMovementSpeedMod\":1,\"PercentPhysicalDamageMod\":1,\"PercentSpellBlockMod\":1,\"PercentSpellVampMod\":1,\"rFlatArmorModPerLevel\":1,\"rFlatArmorPenetrationMod\":1,\"rFlatArmorPenetrationModPerLevel\":1,\"rFlatCritChanceModPerLevel\":1,\"rFlatCritDamageModPerLevel\":1,\"rFlatDodgeMod\":1,\"rFlatDodgeModPerLevel\":1,\"rFlatEnergyModPerLevel\":1,\"rFlatEnergyRegenModPerLevel\":1,\"rFlatGoldPer10Mod\":1,\"rFlatHPModPerLevel\":1,\"rFlatHPRegenModPerLevel\":1,\"rFlatMPModPerLevel\":1,\"rFlatMPRegenModPerLevel\":1,\"rFlatMagicDamageModPerLevel\":1,\"rFlatMagicPenetrationMod\":1,\"rFlatMagicPenetrationModPerLevel\":1,\"rFlatMovementSpeedModPerLevel\":1,\"rFlatPhysicalDamageModPerLevel\":1,\"rFlatSpellBlockModPerLevel\":1,\"rFlatTimeDeadMod\":1,\"rFlatTimeDeadModPerLevel\":1,\"rPercentArmorPenetrationMod\":1,\"rPercentArmorPenetrationModPerLevel\":1,\"rPercentAttackSpeedModPerLevel\":1,\"rPercentCooldownMod\":1,\"rPercentCooldownModPerLevel\":1,\"rPercentMagicPenetrationMod\":1,\"rPercentMagicPenetrationModPerLevel\":1,\"rPercentMovementSpeedModPerLevel\":1,\"rPercentTimeDeadMod\":1,\"rPercentTimeDeadModPerLevel\":1},\"tags\":[\"example\"]}",
+			do: func(c *Client, region Region) (interface{}, error) {
+				return c.Item(context.TODO(), region, 1).Do()
+			},
+		},
+		{
+			name:        "LanguageStrings",
+			region:      NA,
+			unsupported: Global,
+			path:        "/api/lol/static-data/na/v1.2/language-strings",
+			apiKey:      true,
+			fixture:     "{\"data\":{\"1\":\"example\"},\"type\":\"example\",\"version\":\"example\"}",
+			do: func(c *Client, region Region) (interface{}, error) {
+				return c.LanguageStrings(context.TODO(), region).Do()
+			},
+		},
+		{
+			name:        "Languages",
+			region:      NA,
+			unsupported: Global,
+			path:        "/api/lol/static-data/na/v1.2/languages",
+			apiKey:      true,
+			fixture:     "[\"example\"]",
+			do: func(c *Client, region Region) (interface{}, error) {
+				return c.Languages(context.TODO(), region).Do()
+			},
+		},
+		{
+			name:        "Maps",
+			region:      NA,
+			unsupported: Global,
+			path:        "/api/lol/static-data/na/v1.2/map",
+			apiKey:      true,
+			fixture:     "{\"data\":{\"1\":{\"image\":{\"full\":\"example\",\"group\":\"example\",\"h\":1,\"sprite\":\"example\",\"w\":1,\"x\":1,\"y\":1},\"mapId\":1,\"mapName\":\"example\",\"unpurchasableItemList\":[1]}},\"type\":\"example\",\"version\":\"example\"}",
+			do: func(c *Client, region Region) (interface{}, error) {
+				return c.Maps(context.TODO(), region).Do()
+			},
+		},
+		{
+			name:        "Masteries",
+			region:      NA,
+			unsupported: Global,
+			path:        "/api/lol/static-data/na/v1.2/mastery",
+			apiKey:      true,
+			fixture:     "{\"data\":{\"1\":{\"description\":[\"example\"],\"id\":1,\"image\":{\"full\":\"example\",\"group\":\"example\",\"h\":1,\"sprite\":\"example\",\"w\":1,\"x\":1,\"y\":1},\"masteryTree\":\"example\",\"name\":\"example\",\"prereq\":\"example\",\"ranks\":1,\"sanitizedDescription\":[\"example\"]}},\"tree\":{\"Cunning\":[{\"masteryTreeItems\":[{\"masteryId\":1,\"prereq\":\"example\"}]}],\"Ferocity\":[{\"masteryTreeItems\":[{\"masteryId\":1,\"prereq\":\"example\"}]}],\"Resolve\":[{\"masteryTreeItems\":[{\"masteryId\":1,\"prereq\":\"example\"}]}]},\"type\":\"example\",\"version\":\"example\"}",
+			do: func(c *Client, region Region) (interface{}, error) {
+				return c.Masteries(context.TODO(), region).Do()
+			},
+		},
+		{
+			name:        "Mastery",
+			region:      NA,
+			unsupported: Global,
+			path:        "/api/lol/static-data/na/v1.2/mastery/1",
+			apiKey:      true,
+			fixture:     "{\"description\":[\"example\"],\"id\":1,\"image\":{\"full\":\"example\",\"group\":\"example\",\"h\":1,\"sprite\":\"example\",\"w\":1,\"x\":1,\"y\":1},\"masteryTree\":\"example\",\"name\":\"example\",\"prereq\":\"example\",\"ranks\":1,\"sanitizedDescription\":[\"example\"]}",
+			do: func(c *Client, region Region) (interface{}, error) {
+				return c.Mastery(context.TODO(), region, 1).Do()
+			},
+		},
+		{
+			name:        "Realm",
+			region:      NA,
+			unsupported: Global,
+			path:        "/api/lol/static-data/na/v1.2/realm",
+			apiKey:      true,
+			fixture:     "{\"cdn\":\"example\",\"css\":\"example\",\"dd\":\"example\",\"l\":\"example\",\"lg\":\"example\",\"n\":{\"1\":\"example\"},\"profileiconmax\":1,\"store\":\"example\",\"v\":\"example\"}",
+			do: func(c *Client, region Region) (interface{}, error) {
+				return c.Realm(context.TODO(), region).Do()
+			},
+		},
+		{
+			name:        "Runes",
+			region:      NA,
+			unsupported: Global,
+			path:        "/api/lol/static-data/na/v1.2/rune",
+			apiKey:      true,
+			fixture:     "{\"basic\":{\"colloq\":\"example\",\"consumeOnFull\":true,\"consumed\":true,\"depth\":1,\"description\":\"example\",\"from\":[\"example\"],\"gold\":{\"base\":1,\"purchasable\":true,\"sell\":1,\"total\":1},\"group\":\"example\",\"hideFromAll\":true,\"id\":1,\"image\":{\"full\":\"example\",\"group\":\"example\",\"h\":1,\"sprite\":\"example\",\"w\":1,\"x\":1,\"y\":1},\"inStore\":true,\"into\":[\"example\"],\"maps\":{\"1\":true},\"name\":\"example\",\"plaintext\":\"example\",\"requiredChampion\":\"example\",\"rune\":{\"isRune\":true,\"tier\":\"example\",\"type\":\"example\"},\"sanitizedDescription\":\"example\",\"specialRecipe\":1,\"stacks\":1,\"stats\":{\"FlatArmorMod\":1,\"FlatAttackSpeedMod\":1,\"FlatBlockMod\":1,\"FlatCritChanceMod\":1,\"FlatCritDamageMod\":1,\"FlatEXPBonus\":1,\"FlatEnergyPoolMod\":1,\"FlatEnergyRegenMod\":1,\"FlatHPPoolMod\":1,\"FlatHPRegenMod\":1,\"FlatMPPoolMod\":1,\"FlatMPRegenMod\":1,\"FlatMagicDamageMod\":1,\"FlatMovementSpeedMod\":1,\"FlatPhysicalDamageMod\":1,\"FlatSpellBlockMod\":1,\"PercentArmorMod\":1,\"PercentAttackSpeedMod\":1,\"PercentBlockMod\":1,\"PercentCritChanceMod\":1,\"PercentCritDamageMod\":1,\"PercentDodgeMod\":1,\"PercentEXPBonus\":1,\"PercentHPPoolMod\":1,\"PercentHPRegenMod\":1,\"PercentLifeStealMod\":1,\"PercentMPPoolMod\":1,\"PercentMPRegenMod\":1,\"PercentMagicDamageMod\":1,\"PercentMovementSpeedMod\":1,\"PercentPhysicalDamageMod\":1,\"PercentSpellBlockMod\":1,\"PercentSpellVampMod\":1,\"rFlatArmorModPerLevel\":1,\"rFlatArmorPenetrationMod\":1,\"rFlatArmorPenetrationModPerLevel\":1,\"rFlatCritChanceModPerLevel\":1,\"rFlatCritDamageModPerLevel\":1,\"rFlatDodgeMod\":1,\"rFlatDodgeModPerLevel\":1,\"rFlatEnergyModPerLevel\":1,\"rFlatEnergyRegenModPerLevel\":1,\"rFlatGoldPer10Mod\":1,\"rFlatHPModPerLevel\":1,\"rFlatHPRegenModPerLevel\":1,\"rFlatMPModPerLevel\":1,\"rFlatMPRegenModPerLevel\":1,\"rFlatMagicDamageModPerLevel\":1,\"rFlatMagicPenetrationMod\":1,\"rFlatMagicPenetrationModPerLevel\":1,\"rFlatMovementSpeedModPerLevel\":1,\"rFlatPhysicalDamageModPerLevel\":1,\"rFlatSpellBlockModPerLevel\":1,\"rFlatTimeDeadMod\":1,\"rFlatTimeDeadModPerLevel\":1,\"rPercentArmorPenetrationMod\":1,\"rPercentArmorPenetrationModPerLevel\":1,\"rPercentAttackSpeedModPerLevel\":1,\"rPercentCooldownMod\":1,\"rPercentCooldownModPerLevel\":1,\"rPercentMagicPenetrationMod\":1,\"rPercentMagicPenetrationModPerLevel\":1,\"rPercentMovementSpeedModPerLevel\":1,\"rPercentTimeDeadMod\":1,\"rPercentTimeDeadModPerLevel\":1},\"tags\":[\"example\"]},\"data\":{\"1\":{\"colloq\":\"example\",\"consumeOnFull\":true,\"consumed\":true,\"depth\":1,\"description\":\"example\",\"from\":[\"example\"],\"group\":\"example\",\"hideFromAll\":true,\"id\":1,\"image\":{\"full\":\"example\",\"group\":\"example\",\"h\":1,\"sprite\":\"example\",\"w\":1,\"x\":1,\"y\":1},\"inStore\":true,\"into\":[\"example\"],\"maps\":{\"1\":true},\"name\":\"example\",\"plaintext\":\"example\",\"requiredChampion\":\"example\",\"rune\":{\"isRune\":true,\"tier\":\"example\",\"type\":\"example\"},\"sanitizedDescription\":\"example\",\"specialRecipe\":1,\"stacks\":1,\"stats\":{\"FlatArmorMod\":1,\"FlatAttackSpeedMod\":1,\"FlatBlockMod\":1,\"FlatCritChanceMod\":1,\"FlatCritDamageMod\":1,\"FlatEXPBonus\":1,\"FlatEnergyPoolMod\":1,\"FlatEnergyRegenMod\":1,\"FlatHPPoolMod\":1,\"FlatHPRegenMod\":1,\"FlatMPPoolMod\":1,\"FlatMPRegenMod\":1,\"FlatMagicDamageMod\":1,\"FlatMovementSpeedMod\":1,\"FlatPhysicalDamageMod\":1,\"FlatSpellBlockMod\":1,\"PercentArmorMod\":1,\"PercentAttackSpeedMod\":1,\"PercentBlockMod\":1,\"PercentCritChanceMod\":1,\"PercentCritDamageMod\":1,\"PercentDodgeMod\":1,\"PercentEXPBonus\":1,\"PercentHPPoolMod\":1,\"PercentHPRegenMod\":1,\"PercentLifeStealMod\":1,\"PercentMPPoolMod\":1,\"PercentMPRegenMod\":1,\"PercentMagicDamageMod\":1,\"PercentMovementSpeedMod\":1,\"PercentPhysicalDamageMod\":1,\"PercentSpellBlockMod\":1,\"PercentSpellVampMod\":1,\"rFlatArmorModPerLevel\":1,\"rFlatArmorPenetrationMod\":1,\"rFlatArmorPenetrationModPerLevel\":1,\"rFlatCritChanceModPerLevel\":1,\"rFlatCritDamageModPerLevel\":1,\"rFlatDodgeMod\":1,\"rFlatDodgeModPerLevel\":1,\"rFlatEnergyModPerLevel\":1,\"rFlatEnergyRegenModPerLevel\":1,\"rFlatGoldPer10Mod\":1,\"rFlatHPModPerLevel\":1,\"rFlatHPRegenModPerLevel\":1,\"rFlatMPModPerLevel\":1,\"rFlatMPRegenModPerLevel\":1,\"rFlatMagicDamageModPerLevel\":1,\"rFlatMagicPenetrationMod\":1,\"rFlatMagicPenetrationModPerLevel\":1,\"rFlatMovementSpeedModPerLevel\":1,\"rFlatPhysicalDamageModPerLevel\":1,\"rFlatSpellBlockModPerLevel\":1,\"rFlatTimeDeadMod\":1,\"rFlatTimeDeadModPerLevel\":1,\"rPercentArmorPenetrationMod\":1,\"rPercentArmorPenetrationModPerLevel\":1,\"rPercentAttackSpeedModPerLevel\":1,\"rPercentCooldownMod\":1,\"rPercentCooldownModPerLevel\":1,\"rPercentMagicPenetrationMod\":1,\"rPercentMagicPenetrationModPerLevel\":1,\"rPercentMovementSpeedModPerLevel\":1,\"rPercentTimeDeadMod\":1,\"rPercentTimeDeadModPerLevel\":1},\"tags\":[\"example\"]}},\"type\":\"example\",\"version\":\"example\"}",
+			do: func(c *Client, region Region) (interface{}, error) {
+				return c.Runes(context.TODO(), region).Do()
+			},
+		},
+		{
+			name:        "Rune",
+			region:      NA,
+			unsupported: Global,
+			path:        "/api/lol/static-data/na/v1.2/rune/1",
+			apiKey:      true,
+			fixture:     "{\"colloq\":\"example\",\"consumeOnFull\":true,\"consumed\":true,\"depth\":1,\"description\":\"example\",\"from\":[\"example\"],\"group\":\"example\",\"hideFromAll\":true,\"id\":1,\"image\":{\"full\":\"example\",\"group\":\"example\",\"h\":1,\"sprite\":\"example\",\"w\":1,\"x\":1,\"y\":1},\"inStore\":true,\"into\":[\"example\"],\"maps\":{\"1\":true},\"name\":\"example\",\"plaintext\":\"example\",\"requiredChampion\":\"example\",\"rune\":{\"isRune\":true,\"tier\":\"example\",\"type\":\"example\"},\"sanitizedDescription\":\"example\",\"specialRecipe\":1,\"stacks\":1,\"stats\":{\"FlatArmorMod\":1,\"FlatAttackSpeedMod\":1,\"FlatBlockMod\":1,\"FlatCritChanceMod\":1,\"FlatCritDamageMod\":1,\"FlatEXPBonus\":1,\"FlatEnergyPoolMod\":1,\"FlatEnergyRegenMod\":1,\"FlatHPPoolMod\":1,\"FlatHPRegenMod\":1,\"FlatMPPoolMod\":1,\"FlatMPRegenMod\":1,\"FlatMagicDamageMod\":1,\"FlatMovementSpeedMod\":1,\"FlatPhysicalDamageMod\":1,\"FlatSpellBlockMod\":1,\"PercentArmorMod\":1,\"PercentAttackSpeedMod\":1,\"PercentBlockMod\":1,\"PercentCritChanceMod\":1,\"PercentCritDamageMod\":1,\"PercentDodgeMod\":1,\"PercentEXPBonus\":1,\"PercentHPPoolMod\":1,\"PercentHPRegenMod\":1,\"PercentLifeStealMod\":1,\"PercentMPPoolMod\":1,\"PercentMPRegenMod\":1,\"PercentMagicDamageMod\":1,\"PercentMovementSpeedMod\":1,\"PercentPhysicalDamageMod\":1,\"PercentSpellBlockMod\":1,\"PercentSpellVampMod\":1,\"rFlatArmorModPerLevel\":1,\"rFlatArmorPenetrationMod\":1,\"rFlatArmorPenetrationModPerLevel\":1,\"rFlatCritChanceModPerLevel\":1,\"rFlatCritDamageModPerLevel\":1,\"rFlatDodgeMod\":1,\"rFlatDodgeModPerLevel\":1,\"rFlatEnergyModPerLevel\":1,\"rFlatEnergyRegenModPerLevel\":1,\"rFlatGoldPer10Mod\":1,\"rFlatHPModPerLevel\":1,\"rFlatHPRegenModPerLevel\":1,\"rFlatMPModPerLevel\":1,\"rFlatMPRegenModPerLevel\":1,\"rFlatMagicDamageModPerLevel\":1,\"rFlatMagicPenetrationMod\":1,\"rFlatMagicPenetrationModPerLevel\":1,\"rFlatMovementSpeedModPerLevel\":1,\"rFlatPhysicalDamageModPerLevel\":1,\"rFlatSpellBlockModPerLevel\":1,\"rFlatTimeDeadMod\":1,\"rFlatTimeDeadModPerLevel\":1,\"rPercentArmorPenetrationMod\":1,\"rPercentArmorPenetrationModPerLevel\":1,\"rPercentAttackSpeedModPerLevel\":1,\"rPercentCooldownMod\":1,\"rPercentCooldownModPerLevel\":1,\"rPercentMagicPenetrationMod\":1,\"rPercentMagicPenetrationModPerLevel\":1,\"rPercentMovementSpeedModPerLevel\":1,\"rPercentTimeDeadMod\":1,\"rPercentTimeDeadModPerLevel\":1},\"tags\":[\"example\"]}",
+			do: func(c *Client, region Region) (interface{}, error) {
+				return c.Rune(context.TODO(), region, 1).Do()
+			},
+		},
+		{
+			name:        "SummonerSpells",
+			region:      NA,
+			unsupported: Global,
+			path:        "/api/lol/static-data/na/v1.2/summoner-spell",
+			apiKey:      true,
+			fixture:     "{\"data\":{\"1\":{\"cooldown\":[1],\"cooldownBurn\":\"example\",\"cost\":[1],\"costBurn\":\"example\",\"costType\":\"example\",\"description\":\"example\",\"effect\":[[1]],\"effectBurn\":[\"example\"],\"id\":1,\"image\":{\"full\":\"example\",\"group\":\"example\",\"h\":1,\"sprite\":\"example\",\"w\":1,\"x\":1,\"y\":1},\"key\":\"example\",\"leveltip\":{\"effect\":[\"example\"],\"label\":[\"example\"]},\"maxrank\":1,\"modes\":[\"example\"],\"name\":\"example\",\"range\":\"self\",\"rangeBurn\":\"example\",\"resource\":\"example\",\"sanitizedDescription\":\"example\",\"sanitizedTooltip\":\"example\",\"summonerLevel\":1,\"tooltip\":\"example\",\"vars\":[{\"coeff\":[1],\"dyn\":\"example\",\"key\":\"example\",\"link\":\"example\",\"ranksWith\":\"example\"}]}},\"type\":\"example\",\"version\":\"example\"}",
+			do: func(c *Client, region Region) (interface{}, error) {
+				return c.SummonerSpells(context.TODO(), region).Do()
+			},
+		},
+		{
+			name:        "SummonerSpell",
+			region:      NA,
+			unsupported: Global,
+			path:        "/api/lol/static-data/na/v1.2/summoner-spell/1",
+			apiKey:      true,
+			fixture:     "{\"cooldown\":[1],\"cooldownBurn\":\"example\",\"cost\":[1],\"costBurn\":\"example\",\"costType\":\"example\",\"description\":\"example\",\"effect\":[[1]],\"effectBurn\":[\"example\"],\"id\":1,\"image\":{\"full\":\"example\",\"group\":\"example\",\"h\":1,\"sprite\":\"example\",\"w\":1,\"x\":1,\"y\":1},\"key\":\"example\",\"leveltip\":{\"effect\":[\"example\"],\"label\":[\"example\"]},\"maxrank\":1,\"modes\":[\"example\"],\"name\":\"example\",\"range\":\"self\",\"rangeBurn\":\"example\",\"resource\":\"example\",\"sanitizedDescription\":\"example\",\"sanitizedTooltip\":\"example\",\"summonerLevel\":1,\"tooltip\":\"example\",\"vars\":[{\"coeff\":[1],\"dyn\":\"example\",\"key\":\"example\",\"link\":\"example\",\"ranksWith\":\"example\"}]}",
+			do: func(c *Client, region Region) (interface{}, error) {
+				return c.SummonerSpell(context.TODO(), region, 1).Do()
+			},
+		},
+		{
+			name:        "Versions",
+			region:      NA,
+			unsupported: Global,
+			path:        "/api/lol/static-data/na/v1.2/versions",
+			apiKey:      true,
+			fixture:     "[\"example\"]",
+			do: func(c *Client, region Region) (interface{}, error) {
+				return c.Versions(context.TODO(), region).Do()
+			},
+		},
+	})
+}
@@ -0,0 +1,258 @@
+package staticdata
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path"
+	"strconv"
+
+	"github.com/go-lol/lol"
+	"golang.org/x/net/context"
+)
+
+// Bundle is everything returned by lol-static-data operations for a version and locale.
+// It is stored as gzip compressed json.
+type Bundle struct {
+	*Data
+
+	LanguageStrings *lol.LanguageStrings
+	Languages       []string
+	Realm           *lol.Realm
+}
+
+// FetchBundle fetches a bundle of a version and locale. See Load
+// If locale is empty, the bundle has the default locale of the region. (Realm.L)
+func FetchBundle(ctx context.Context, c *lol.Client, region lol.Region, version, locale string) (*Bundle, error) {
+	d, err := Load(ctx, c, region, version, locale)
+	if err != nil {
+		return nil, err
+	}
+	b := &Bundle{Data: d}
+
+	strs := c.LanguageStrings(ctx, region).Version(d.Version)
+	if locale != "" {
+		strs.Locale(locale)
+	}
+	if b.LanguageStrings, err = strs.Do(); err != nil {
+		return nil, err
+	}
+	if b.Languages, err = c.Languages(ctx, region).Do(); err != nil {
+		return nil, err
+	}
+	if b.Realm, err = c.Realm(ctx, region).Do(); err != nil {
+		return nil, err
+	}
+	// Requests with the default locale match the bundle.
+	if b.Locale == "" {
+		b.Locale = b.Realm.L
+	}
+	return b, nil
+}
+
+// ReadBundle decodes a bundle.
+func ReadBundle(r io.Reader) (*Bundle, error) {
+	zr, err := gzip.NewReader(r)
+	if err != nil {
+		return nil, err
+	}
+	defer zr.Close()
+
+	b := &Bundle{}
+	if err := json.NewDecoder(zr).Decode(b); err != nil {
+		return nil, err
+	}
+	if b.Data == nil {
+		return nil, fmt.Errorf("staticdata: no data in bundle")
+	}
+	b.index()
+	return b, nil
+}
+
+// ReadBundleFile decodes a bundle from the file.
+func ReadBundleFile(filename string) (*Bundle, error) {
+	f, err := os.Open(filename)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	return ReadBundle(f)
+}
+
+// Write encodes the bundle.
+func (b *Bundle) Write(w io.Writer) error {
+	zw := gzip.NewWriter(w)
+	if err := json.NewEncoder(zw).Encode(b); err != nil {
+		return err
+	}
+	return zw.Close()
+}
+
+// WriteFile writes the bundle to the file.
+func (b *Bundle) WriteFile(filename string) error {
+	f, err := os.Create(filename)
+	if err != nil {
+		return err
+	}
+
+	if err := b.Write(f); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+// Bundles serves lol-static-data requests from bundles,
+// instead of the api. Use it with Client.SetStaticDataTransport.
+//
+// A bundle is selected by "version" and "locale" query parameters.
+// If they are not given, the first bundle is used,
+// so put the latest version first.
+type Bundles []*Bundle
+
+// Find returns a bundle of version and locale, or nil.
+// Empty version or locale matches any bundle.
+func (bs Bundles) Find(version, locale string) *Bundle {
+	for _, b := range bs {
+		if (version == "" || b.Version == version) && (locale == "" || b.Locale == locale) {
+			return b
+		}
+	}
+	return nil
+}
+
+// RoundTrip implements http.RoundTripper.
+// It returns HTTP 404 if bundle or data was not found.
+func (bs Bundles) RoundTrip(req *http.Request) (*http.Response, error) {
+	info, ok := lol.OperationFromContext(req.Context())
+	if !ok || info.Resource != "lol-static-data" {
+		return nil, fmt.Errorf("staticdata: %s is not a lol-static-data request", req.URL)
+	}
+
+	q := req.URL.Query()
+	if info.Name == "Versions" {
+		return bs.versions(req)
+	}
+
+	b := bs.Find(q.Get("version"), q.Get("locale"))
+	if b == nil {
+		return newResponse(req, http.StatusNotFound, nil)
+	}
+
+	// Generated calls set "dataByID".
+	byID := q.Get("dataById") == "true" || q.Get("dataByID") == "true"
+	v := b.response(info.Name, path.Base(req.URL.Path), byID)
+	if v == nil {
+		return newResponse(req, http.StatusNotFound, nil)
+	}
+
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	return newResponse(req, http.StatusOK, data)
+}
+
+func (bs Bundles) versions(req *http.Request) (*http.Response, error) {
+	versions := make([]string, 0, len(bs))
+	seen := make(map[string]bool)
+	for _, b := range bs {
+		if !seen[b.Version] {
+			seen[b.Version] = true
+			versions = append(versions, b.Version)
+		}
+	}
+
+	data, err := json.Marshal(versions)
+	if err != nil {
+		return nil, err
+	}
+	return newResponse(req, http.StatusOK, data)
+}
+
+// response returns a response of an operation, or nil if not found.
+// id is the last path segment, used by operations returning single entity.
+func (b *Bundle) response(op, id string, byID bool) interface{} {
+	n, _ := strconv.ParseInt(id, 10, 32)
+
+	switch op {
+	case "ChampionDatas":
+		if byID && b.Champions != nil {
+			list := *b.Champions
+			list.Data = make(map[string]*lol.ChampionData)
+			for _, c := range b.Champions.Data {
+				list.Data[strconv.Itoa(int(c.ID))] = c
+			}
+			return &list
+		}
+		return nonNil(b.Champions != nil, b.Champions)
+	case "ChampionData":
+		c := b.ChampionByID(int32(n))
+		return nonNil(c != nil, c)
+	case "Items":
+		return nonNil(b.Items != nil, b.Items)
+	case "Item":
+		item := b.ItemByID(int32(n))
+		return nonNil(item != nil, item)
+	case "Runes":
+		return nonNil(b.Runes != nil, b.Runes)
+	case "Rune":
+		r := b.RuneByID(int32(n))
+		return nonNil(r != nil, r)
+	case "Masteries":
+		return nonNil(b.Masteries != nil, b.Masteries)
+	case "Mastery":
+		m := b.MasteryByID(int32(n))
+		return nonNil(m != nil, m)
+	case "SummonerSpells":
+		if byID && b.SummonerSpells != nil {
+			list := *b.SummonerSpells
+			list.Data = make(map[string]*lol.SummonerSpell)
+			for _, s := range b.SummonerSpells.Data {
+				list.Data[strconv.Itoa(int(s.ID))] = s
+			}
+			return &list
+		}
+		return nonNil(b.SummonerSpells != nil, b.SummonerSpells)
+	case "SummonerSpell":
+		s := b.SpellByID(int32(n))
+		return nonNil(s != nil, s)
+	case "Maps":
+		return nonNil(b.Maps != nil, b.Maps)
+	case "LanguageStrings":
+		return nonNil(b.LanguageStrings != nil, b.LanguageStrings)
+	case "Languages":
+		return nonNil(b.Languages != nil, b.Languages)
+	case "Realm":
+		return nonNil(b.Realm != nil, b.Realm)
+	}
+	return nil
+}
+
+// nonNil returns v if ok, to avoid typed nil in interface{}.
+func nonNil(ok bool, v interface{}) interface{} {
+	if !ok {
+		return nil
+	}
+	return v
+}
+
+func newResponse(req *http.Request, status int, body []byte) (*http.Response, error) {
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", status, http.StatusText(status)),
+		StatusCode:    status,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        http.Header{"Content-Type": {"application/json"}},
+		Body:          ioutil.NopCloser(bytes.NewReader(body)),
+		ContentLength: int64(len(body)),
+		Request:       req,
+	}, nil
+}
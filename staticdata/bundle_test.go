@@ -0,0 +1,86 @@
+package staticdata
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/go-lol/lol"
+	"golang.org/x/net/context"
+)
+
+func TestBundle(t *testing.T) {
+	realm := fixtures["/realm"]
+	c, close := newTestClient(t, &realm)
+	defer close()
+
+	b, err := FetchBundle(context.TODO(), c, lol.NA, "", "en_US")
+	if err != nil {
+		t.Fatal(err)
+		return
+	}
+
+	var buf bytes.Buffer
+	if err := b.Write(&buf); err != nil {
+		t.Fatal(err)
+		return
+	}
+	if b, err = ReadBundle(&buf); err != nil {
+		t.Fatal(err)
+		return
+	}
+	if b.Version != "6.1.1" || b.ChampionByKey("MonkeyKing") == nil || len(b.Languages) != 2 {
+		t.Fatalf("Invalid bundle %+v", b)
+		return
+	}
+
+	// Serve static data from the bundle, without the api.
+	offline, err := lol.New(nil, "test-api-key")
+	if err != nil {
+		t.Fatal(err)
+		return
+	}
+	offline.SetStaticDataTransport(Bundles{b})
+
+	champions, err := offline.ChampionDatas(context.TODO(), lol.NA).Version("6.1.1").Do()
+	if err != nil || champions.Data["MonkeyKing"] == nil {
+		t.Fatalf("Invalid champions %v, %v", champions, err)
+		return
+	}
+	champions, err = offline.ChampionDatas(context.TODO(), lol.NA).DataByID(true).Do()
+	if err != nil || champions.Data["62"] == nil {
+		t.Fatalf("Invalid champions by id %v, %v", champions, err)
+		return
+	}
+	if item, err := offline.Item(context.TODO(), lol.NA, 1001).Do(); err != nil || item.Name != "Boots of Speed" {
+		t.Fatalf("Invalid item %v, %v", item, err)
+		return
+	}
+	if versions, err := offline.Versions(context.TODO(), lol.NA).Do(); err != nil || len(versions) != 1 || versions[0] != "6.1.1" {
+		t.Fatalf("Invalid versions %v, %v", versions, err)
+		return
+	}
+
+	// A bundle fetched without a locale has the default locale of the region.
+	b, err = FetchBundle(context.TODO(), c, lol.NA, "", "")
+	if err != nil {
+		t.Fatal(err)
+		return
+	}
+	if b.Locale != "en_US" {
+		t.Fatalf("Expected locale en_US, got %q", b.Locale)
+		return
+	}
+	offline.SetStaticDataTransport(Bundles{b})
+	if item, err := offline.Item(context.TODO(), lol.NA, 1001).Locale("en_US").Do(); err != nil || item.Name != "Boots of Speed" {
+		t.Fatalf("Invalid item of locale en_US %v, %v", item, err)
+		return
+	}
+
+	if _, err := offline.Item(context.TODO(), lol.NA, 1).Do(); err == nil {
+		t.Fatal("Expected error for unknown item")
+		return
+	}
+	if _, err := offline.Items(context.TODO(), lol.NA).Version("5.1.1").Do(); err == nil {
+		t.Fatal("Expected error for unknown version")
+	}
+}
@@ -0,0 +1,239 @@
+package staticdata
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+
+	"github.com/go-lol/lol"
+)
+
+// RecipeGraph is a graph of items built from components. (Item.From and Item.Into)
+//
+// Ids in From/Into which are not in the item list are ignored.
+type RecipeGraph struct {
+	items map[int32]*lol.Item
+	from  map[int32][]int32
+	into  map[int32][]int32
+}
+
+// CycleError is returned if an item is built from (or into) itself.
+type CycleError struct {
+	// Items in the cycle, starting and ending with the same item.
+	Items []int32
+}
+
+func (e *CycleError) Error() string {
+	return fmt.Sprintf("staticdata: item recipe cycle %v", e.Items)
+}
+
+// NewRecipeGraph returns a recipe graph of items.
+// It returns *CycleError if an item is built from (or into) itself.
+func NewRecipeGraph(list *lol.ItemList) (*RecipeGraph, error) {
+	g := &RecipeGraph{
+		items: make(map[int32]*lol.Item),
+		from:  make(map[int32][]int32),
+		into:  make(map[int32][]int32),
+	}
+	for _, item := range list.Data {
+		g.items[item.ID] = item
+	}
+
+	ids := make([]int32, 0, len(g.items))
+	for id := range g.items {
+		ids = append(ids, id)
+	}
+	sort.Sort(int32s(ids))
+
+	for _, id := range ids {
+		item := g.items[id]
+		for _, s := range item.From {
+			if c, ok := g.lookup(s); ok {
+				g.from[id] = append(g.from[id], c)
+			}
+		}
+		for _, s := range item.Into {
+			if c, ok := g.lookup(s); ok {
+				g.into[id] = append(g.into[id], c)
+			}
+		}
+	}
+
+	for _, edges := range []map[int32][]int32{g.from, g.into} {
+		if cycle := findCycle(ids, edges); cycle != nil {
+			return nil, &CycleError{Items: cycle}
+		}
+	}
+	return g, nil
+}
+
+func (g *RecipeGraph) lookup(s string) (int32, bool) {
+	id, err := strconv.ParseInt(s, 10, 32)
+	if err != nil || g.items[int32(id)] == nil {
+		return 0, false
+	}
+	return int32(id), true
+}
+
+// findCycle returns a cycle in edges, or nil.
+func findCycle(ids []int32, edges map[int32][]int32) []int32 {
+	const (
+		visiting = 1
+		done     = 2
+	)
+	state := make(map[int32]int)
+	var path []int32
+
+	var visit func(id int32) []int32
+	visit = func(id int32) []int32 {
+		switch state[id] {
+		case visiting:
+			for i, p := range path {
+				if p == id {
+					return append(append([]int32{}, path[i:]...), id)
+				}
+			}
+		case done:
+			return nil
+		}
+
+		state[id] = visiting
+		path = append(path, id)
+		for _, c := range edges[id] {
+			if cycle := visit(c); cycle != nil {
+				return cycle
+			}
+		}
+		path = path[:len(path)-1]
+		state[id] = done
+		return nil
+	}
+
+	for _, id := range ids {
+		if cycle := visit(id); cycle != nil {
+			return cycle
+		}
+	}
+	return nil
+}
+
+// Item returns an item, or nil if not found.
+func (g *RecipeGraph) Item(id int32) *lol.Item { return g.items[id] }
+
+// Components returns items which the item is built from directly.
+func (g *RecipeGraph) Components(id int32) []int32 { return g.from[id] }
+
+// BaseComponents returns items without components, which the item is built from.
+// An item used twice is returned twice. It returns nil for a base item.
+func (g *RecipeGraph) BaseComponents(id int32) []int32 {
+	var base []int32
+	for _, c := range g.from[id] {
+		if len(g.from[c]) == 0 {
+			base = append(base, c)
+		} else {
+			base = append(base, g.BaseComponents(c)...)
+		}
+	}
+	return base
+}
+
+// RecipeNode is a node of a build tree.
+type RecipeNode struct {
+	Item *lol.Item
+	From []*RecipeNode
+}
+
+// Tree returns the build tree of an item, or nil if not found.
+func (g *RecipeGraph) Tree(id int32) *RecipeNode {
+	item := g.items[id]
+	if item == nil {
+		return nil
+	}
+	n := &RecipeNode{Item: item}
+	for _, c := range g.from[id] {
+		n.From = append(n.From, g.Tree(c))
+	}
+	return n
+}
+
+// CombineCost returns gold to combine components into the item.
+func (g *RecipeGraph) CombineCost(id int32) int32 {
+	if item := g.items[id]; item != nil && item.Gold != nil {
+		return item.Gold.Base
+	}
+	return 0
+}
+
+// CostToFinish returns gold to build the item from owned items.
+// Owned items are used as components at most once each.
+func (g *RecipeGraph) CostToFinish(id int32, owned []int32) int32 {
+	counts := make(map[int32]int)
+	for _, o := range owned {
+		counts[o]++
+	}
+	return g.costToFinish(id, counts)
+}
+
+func (g *RecipeGraph) costToFinish(id int32, owned map[int32]int) int32 {
+	if owned[id] > 0 {
+		owned[id]--
+		return 0
+	}
+	cost := g.CombineCost(id)
+	for _, c := range g.from[id] {
+		cost += g.costToFinish(c, owned)
+	}
+	return cost
+}
+
+// UpgradePaths returns paths from the item to items which are not built into others.
+// Each path starts with the item. It returns nil if the item is not built into others.
+func (g *RecipeGraph) UpgradePaths(id int32) [][]int32 {
+	var paths [][]int32
+	for _, u := range g.into[id] {
+		sub := g.UpgradePaths(u)
+		if len(sub) == 0 {
+			paths = append(paths, []int32{id, u})
+			continue
+		}
+		for _, p := range sub {
+			paths = append(paths, append([]int32{id}, p...))
+		}
+	}
+	return paths
+}
+
+// AvailableOn returns true if the item can be purchased on the map.
+func (g *RecipeGraph) AvailableOn(id int32, m *lol.MapDetails) bool {
+	item := g.items[id]
+	if item == nil || (item.Gold != nil && !item.Gold.Purchasable) {
+		return false
+	}
+	if available, ok := item.Maps[strconv.Itoa(int(m.MapID))]; ok && !available {
+		return false
+	}
+	for _, u := range m.UnpurchasableItemList {
+		if u == int64(id) {
+			return false
+		}
+	}
+	return true
+}
+
+// ItemsOn returns sorted ids of items which can be purchased on the map.
+func (g *RecipeGraph) ItemsOn(m *lol.MapDetails) []int32 {
+	var ids []int32
+	for id := range g.items {
+		if g.AvailableOn(id, m) {
+			ids = append(ids, id)
+		}
+	}
+	sort.Sort(int32s(ids))
+	return ids
+}
+
+type int32s []int32
+
+func (s int32s) Len() int           { return len(s) }
+func (s int32s) Swap(i, j int)      { s[i], s[j] = s[j], s[i] }
+func (s int32s) Less(i, j int) bool { return s[i] < s[j] }
@@ -0,0 +1,63 @@
+package staticdata
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/go-lol/lol"
+)
+
+func newTestItems() *lol.ItemList {
+	gold := func(base, total int32) *lol.Gold {
+		return &lol.Gold{Base: base, Total: total, Purchasable: true}
+	}
+	return &lol.ItemList{Data: map[string]*lol.Item{
+		"1036": {ID: 1036, Name: "Long Sword", Gold: gold(350, 350), Into: []string{"3134"}},
+		"3134": {ID: 3134, Name: "Serrated Dirk", Gold: gold(400, 1100), From: []string{"1036", "1036"}, Into: []string{"3142"}},
+		"1037": {ID: 1037, Name: "Pickaxe", Gold: gold(875, 875), Into: []string{"3142"}},
+		"3142": {ID: 3142, Name: "Youmuu's Ghostblade", Gold: gold(1000, 2975), From: []string{"3134", "1037"},
+			Maps: map[string]bool{"10": false}},
+	}}
+}
+
+func TestRecipeGraph(t *testing.T) {
+	g, err := NewRecipeGraph(newTestItems())
+	if err != nil {
+		t.Fatal(err)
+		return
+	}
+
+	if base := g.BaseComponents(3142); !reflect.DeepEqual(base, []int32{1036, 1036, 1037}) {
+		t.Errorf("Invalid base components %v", base)
+	}
+	if tree := g.Tree(3142); len(tree.From) != 2 || len(tree.From[0].From) != 2 {
+		t.Errorf("Invalid tree %v", tree)
+	}
+	if cost := g.CostToFinish(3142, nil); cost != 2975 {
+		t.Errorf("Expected 2975 for Youmuu's Ghostblade, got %d", cost)
+	}
+	if cost := g.CostToFinish(3142, []int32{1036, 1037}); cost != 1750 {
+		t.Errorf("Expected 1750 with Long Sword and Pickaxe, got %d", cost)
+	}
+	if paths := g.UpgradePaths(1036); !reflect.DeepEqual(paths, [][]int32{{1036, 3134, 3142}}) {
+		t.Errorf("Invalid upgrade paths %v", paths)
+	}
+
+	rift := &lol.MapDetails{MapID: 11, UnpurchasableItemList: []int64{1037}}
+	if ids := g.ItemsOn(rift); !reflect.DeepEqual(ids, []int32{1036, 3134, 3142}) {
+		t.Errorf("Invalid items on Summoner's Rift %v", ids)
+	}
+	if g.AvailableOn(3142, &lol.MapDetails{MapID: 10}) {
+		t.Error("Expected Youmuu's Ghostblade to be unavailable on map 10")
+	}
+}
+
+func TestRecipeGraphCycle(t *testing.T) {
+	items := newTestItems()
+	items.Data["1036"].From = []string{"3142"}
+
+	_, err := NewRecipeGraph(items)
+	if cerr, ok := err.(*CycleError); !ok || cerr.Items[0] != cerr.Items[len(cerr.Items)-1] {
+		t.Fatalf("Expected a cycle, got %v", err)
+	}
+}
@@ -0,0 +1,167 @@
+// Package staticdata keeps lol-static-data in memory, with lookups by id, key and name.
+package staticdata
+
+import (
+	"strings"
+
+	"github.com/go-lol/lol"
+	"golang.org/x/net/context"
+)
+
+// Data is static data of a version and locale.
+// It must not be modified after Load.
+type Data struct {
+	Version, Locale string
+
+	Champions      *lol.ChampionDataList
+	Items          *lol.ItemList
+	Runes          *lol.RuneList
+	Masteries      *lol.MasteryList
+	SummonerSpells *lol.SummonerSpellList
+	Maps           *lol.MapData
+
+	championByID   map[int32]*lol.ChampionData
+	championByKey  map[string]*lol.ChampionData
+	championByName map[string]*lol.ChampionData
+	itemByID       map[int32]*lol.Item
+	runeByID       map[int32]*lol.Rune
+	masteryByID    map[int32]*lol.Mastery
+	spellByID      map[int32]*lol.SummonerSpell
+	spellByKey     map[string]*lol.SummonerSpell
+	mapByID        map[int32]*lol.MapDetails
+}
+
+// Load fetches all static data of a version and locale.
+// If version or locale is empty, the default of the api is used. (the latest version and en_US)
+func Load(ctx context.Context, c *lol.Client, region lol.Region, version, locale string) (*Data, error) {
+	d := &Data{Version: version, Locale: locale}
+
+	champions := c.ChampionDatas(ctx, region).ChampData("all")
+	items := c.Items(ctx, region).ItemListData("all")
+	runes := c.Runes(ctx, region).RuneListData("all")
+	masteries := c.Masteries(ctx, region).MasteryListData("all")
+	spells := c.SummonerSpells(ctx, region).SpellData("all")
+	maps := c.Maps(ctx, region)
+	if version != "" {
+		champions.Version(version)
+		items.Version(version)
+		runes.Version(version)
+		masteries.Version(version)
+		spells.Version(version)
+		maps.Version(version)
+	}
+	if locale != "" {
+		champions.Locale(locale)
+		items.Locale(locale)
+		runes.Locale(locale)
+		masteries.Locale(locale)
+		spells.Locale(locale)
+		maps.Locale(locale)
+	}
+
+	var err error
+	if d.Champions, err = champions.Do(); err != nil {
+		return nil, err
+	}
+	if d.Items, err = items.Do(); err != nil {
+		return nil, err
+	}
+	if d.Runes, err = runes.Do(); err != nil {
+		return nil, err
+	}
+	if d.Masteries, err = masteries.Do(); err != nil {
+		return nil, err
+	}
+	if d.SummonerSpells, err = spells.Do(); err != nil {
+		return nil, err
+	}
+	if d.Maps, err = maps.Do(); err != nil {
+		return nil, err
+	}
+
+	if d.Version == "" {
+		d.Version = d.Champions.Version
+	}
+	d.index()
+	return d, nil
+}
+
+// index builds lookup tables.
+func (d *Data) index() {
+	d.championByID = make(map[int32]*lol.ChampionData)
+	d.championByKey = make(map[string]*lol.ChampionData)
+	d.championByName = make(map[string]*lol.ChampionData)
+	if d.Champions != nil {
+		for _, c := range d.Champions.Data {
+			d.championByID[c.ID] = c
+			d.championByKey[c.Key] = c
+			d.championByName[strings.ToLower(c.Name)] = c
+		}
+	}
+
+	d.itemByID = make(map[int32]*lol.Item)
+	if d.Items != nil {
+		for _, item := range d.Items.Data {
+			d.itemByID[item.ID] = item
+		}
+	}
+
+	d.runeByID = make(map[int32]*lol.Rune)
+	if d.Runes != nil {
+		for _, r := range d.Runes.Data {
+			d.runeByID[r.ID] = r
+		}
+	}
+
+	d.masteryByID = make(map[int32]*lol.Mastery)
+	if d.Masteries != nil {
+		for _, m := range d.Masteries.Data {
+			d.masteryByID[m.ID] = m
+		}
+	}
+
+	d.spellByID = make(map[int32]*lol.SummonerSpell)
+	d.spellByKey = make(map[string]*lol.SummonerSpell)
+	if d.SummonerSpells != nil {
+		for _, s := range d.SummonerSpells.Data {
+			d.spellByID[s.ID] = s
+			d.spellByKey[s.Key] = s
+		}
+	}
+
+	d.mapByID = make(map[int32]*lol.MapDetails)
+	if d.Maps != nil {
+		for _, m := range d.Maps.Data {
+			d.mapByID[m.MapID] = m
+		}
+	}
+}
+
+// ChampionByID returns a champion, or nil if not found.
+func (d *Data) ChampionByID(id int32) *lol.ChampionData { return d.championByID[id] }
+
+// ChampionByKey returns a champion by key like "MonkeyKing", or nil if not found.
+func (d *Data) ChampionByKey(key string) *lol.ChampionData { return d.championByKey[key] }
+
+// ChampionByName returns a champion by case-insensitive name like "Wukong", or nil if not found.
+func (d *Data) ChampionByName(name string) *lol.ChampionData {
+	return d.championByName[strings.ToLower(name)]
+}
+
+// ItemByID returns an item, or nil if not found.
+func (d *Data) ItemByID(id int32) *lol.Item { return d.itemByID[id] }
+
+// RuneByID returns a rune, or nil if not found.
+func (d *Data) RuneByID(id int32) *lol.Rune { return d.runeByID[id] }
+
+// MasteryByID returns a mastery, or nil if not found.
+func (d *Data) MasteryByID(id int32) *lol.Mastery { return d.masteryByID[id] }
+
+// SpellByID returns a summoner spell, or nil if not found.
+func (d *Data) SpellByID(id int32) *lol.SummonerSpell { return d.spellByID[id] }
+
+// SpellByKey returns a summoner spell by key like "SummonerFlash", or nil if not found.
+func (d *Data) SpellByKey(key string) *lol.SummonerSpell { return d.spellByKey[key] }
+
+// MapByID returns a map, or nil if not found.
+func (d *Data) MapByID(id int32) *lol.MapDetails { return d.mapByID[id] }
@@ -0,0 +1,108 @@
+package staticdata
+
+import (
+	"sync"
+	"sync/atomic"
+	"time"
+
+	log "github.com/Sirupsen/logrus"
+	"github.com/go-lol/lol"
+	"golang.org/x/net/context"
+)
+
+// Store keeps Data of the current patch, and replaces it atomically on Refresh.
+//
+// Use Data to get a consistent snapshot for multiple lookups.
+type Store struct {
+	client *lol.Client
+	region lol.Region
+	locale string
+
+	// Serializes Refresh.
+	mu   sync.Mutex
+	data atomic.Value // *Data
+}
+
+// NewStore returns an empty store. Call Refresh before use.
+func NewStore(client *lol.Client, region lol.Region, locale string) *Store {
+	return &Store{client: client, region: region, locale: locale}
+}
+
+// Data returns current data, or nil if not loaded yet.
+func (s *Store) Data() *Data {
+	d, _ := s.data.Load().(*Data)
+	return d
+}
+
+// Refresh loads data if Realm reports a new version.
+// It returns true if data was replaced.
+func (s *Store) Refresh(ctx context.Context) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	realm, err := s.client.Realm(ctx, s.region).Do()
+	if err != nil {
+		return false, err
+	}
+	if d := s.Data(); d != nil && d.Version == realm.V {
+		return false, nil
+	}
+
+	d, err := Load(ctx, s.client, s.region, realm.V, s.locale)
+	if err != nil {
+		return false, err
+	}
+	s.data.Store(d)
+	return true, nil
+}
+
+// Run calls Refresh every interval until ctx is done.
+// Errors are logged, and current data is kept.
+func (s *Store) Run(ctx context.Context, interval time.Duration) {
+	t := time.NewTicker(interval)
+	defer t.Stop()
+
+	for {
+		if updated, err := s.Refresh(ctx); err != nil {
+			log.Warnf("Failed to refresh static data. %v", err)
+		} else if updated {
+			log.Infof("Loaded static data %s", s.Data().Version)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-t.C:
+		}
+	}
+}
+
+// ChampionByID returns a champion of current data, or nil.
+func (s *Store) ChampionByID(id int32) *lol.ChampionData { return s.data0().ChampionByID(id) }
+
+// ChampionByKey returns a champion of current data, or nil.
+func (s *Store) ChampionByKey(key string) *lol.ChampionData { return s.data0().ChampionByKey(key) }
+
+// ChampionByName returns a champion of current data, or nil.
+func (s *Store) ChampionByName(name string) *lol.ChampionData {
+	return s.data0().ChampionByName(name)
+}
+
+// ItemByID returns an item of current data, or nil.
+func (s *Store) ItemByID(id int32) *lol.Item { return s.data0().ItemByID(id) }
+
+// SpellByKey returns a summoner spell of current data, or nil.
+func (s *Store) SpellByKey(key string) *lol.SummonerSpell { return s.data0().SpellByKey(key) }
+
+// MapByID returns a map of current data, or nil.
+func (s *Store) MapByID(id int32) *lol.MapDetails { return s.data0().MapByID(id) }
+
+var emptyData = new(Data)
+
+// data0 returns current data, or empty data if not loaded yet.
+func (s *Store) data0() *Data {
+	if d := s.Data(); d != nil {
+		return d
+	}
+	return emptyData
+}
@@ -0,0 +1,104 @@
+package staticdata
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/go-lol/lol"
+	"golang.org/x/net/context"
+)
+
+var fixtures = map[string]string{
+	"/realm":            `{"v": "6.1.1", "l": "en_US"}`,
+	"/champion":         `{"version": "6.1.1", "data": {"MonkeyKing": {"id": 62, "key": "MonkeyKing", "name": "Wukong"}}}`,
+	"/item":             `{"data": {"1001": {"id": 1001, "name": "Boots of Speed"}}}`,
+	"/rune":             `{"data": {"5001": {"id": 5001}}}`,
+	"/mastery":          `{"data": {"6111": {"id": 6111}}}`,
+	"/summoner-spell":   `{"data": {"SummonerFlash": {"id": 4, "key": "SummonerFlash"}}}`,
+	"/map":              `{"data": {"11": {"mapId": 11, "mapName": "Summoner's Rift"}}}`,
+	"/languages":        `["en_US", "ko_KR"]`,
+	"/language-strings": `{"data": {"Armor": "Armor"}}`,
+}
+
+// redirect sends all requests to a local server.
+type redirect string
+
+func (host redirect) RoundTrip(req *http.Request) (*http.Response, error) {
+	req.URL.Scheme = "http"
+	req.URL.Host = string(host)
+	return http.DefaultTransport.RoundTrip(req)
+}
+
+// newTestClient returns a client using fixtures, and realm for Realm.
+func newTestClient(t *testing.T, realm *string) (*lol.Client, func()) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		for suffix, fixture := range fixtures {
+			if strings.HasSuffix(r.URL.Path, suffix) {
+				if suffix == "/realm" {
+					fixture = *realm
+				}
+				w.Header().Set("Content-Type", "application/json")
+				io.WriteString(w, fixture)
+				return
+			}
+		}
+		http.NotFound(w, r)
+	}))
+
+	httpClient := &http.Client{Transport: redirect(srv.Listener.Addr().String())}
+	c, err := lol.New(func(context.Context) *http.Client { return httpClient }, "test-api-key")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return c, srv.Close
+}
+
+func TestStore(t *testing.T) {
+	realm := fixtures["/realm"]
+	c, close := newTestClient(t, &realm)
+	defer close()
+	s := NewStore(c, lol.NA, "en_US")
+
+	if s.ChampionByID(62) != nil {
+		t.Fatal("Expected no data before Refresh")
+		return
+	}
+
+	if updated, err := s.Refresh(context.TODO()); err != nil || !updated {
+		t.Fatalf("Expected update, got %v, %v", updated, err)
+		return
+	}
+	d := s.Data()
+	if d.Version != "6.1.1" {
+		t.Errorf("Invalid version %s", d.Version)
+	}
+	if c := s.ChampionByID(62); c == nil || c != s.ChampionByKey("MonkeyKing") || c != s.ChampionByName("wukong") {
+		t.Errorf("Invalid champion %v", c)
+	}
+	if item := s.ItemByID(1001); item == nil || item.Name != "Boots of Speed" {
+		t.Errorf("Invalid item %v", item)
+	}
+	if s.SpellByKey("SummonerFlash") == nil || s.MapByID(11) == nil || d.RuneByID(5001) == nil || d.MasteryByID(6111) == nil {
+		t.Error("Expected spell, map, rune and mastery")
+	}
+
+	if updated, err := s.Refresh(context.TODO()); err != nil || updated {
+		t.Fatalf("Expected no update for the same version, got %v, %v", updated, err)
+		return
+	}
+
+	realm = `{"v": "6.2.1"}`
+	if updated, err := s.Refresh(context.TODO()); err != nil || !updated {
+		t.Fatalf("Expected update for a new version, got %v, %v", updated, err)
+		return
+	}
+	if s.Data() == d || s.Data().Version != "6.2.1" {
+		t.Errorf("Expected data of 6.2.1, got %s", s.Data().Version)
+	}
+	if d.Version != "6.1.1" {
+		t.Error("Expected old data not to be modified")
+	}
+}
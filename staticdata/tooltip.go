@@ -0,0 +1,241 @@
+package staticdata
+
+import (
+	"html"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/go-lol/lol"
+)
+
+// Format is an output format of Tooltip.Render.
+type Format int
+
+const (
+	// PlainText strips html tags, and converts <br> to newlines.
+	PlainText Format = iota
+	// HTML keeps <br> and <span class="..."> only, and escapes other text.
+	HTML
+)
+
+// Tooltip renders placeholders like "{{ e1 }}" and "{{ a1 }}" in a spell tooltip.
+type Tooltip struct {
+	// Tooltip with placeholders.
+	Text string
+
+	// Effect[n] are values of "{{ eN }}" for each rank. Effect[0] is unused.
+	Effect     [][]float64
+	EffectBurn []string
+	// Coefficients of "{{ aN }}", "{{ fN }}", etc.
+	Vars []*lol.SpellVars
+
+	Cost     []int32
+	Cooldown []float64
+	Range    *lol.SpellRange
+}
+
+// ChampionSpellTooltip returns a tooltip of a champion spell.
+func ChampionSpellTooltip(s *lol.ChampionSpell) *Tooltip {
+	return &Tooltip{
+		Text:       s.Tooltip,
+		Effect:     s.Effect,
+		EffectBurn: s.EffectBurn,
+		Vars:       s.Vars,
+		Cost:       s.Cost,
+		Cooldown:   s.Cooldown,
+		Range:      s.Range,
+	}
+}
+
+// SummonerSpellTooltip returns a tooltip of a summoner spell.
+func SummonerSpellTooltip(s *lol.SummonerSpell) *Tooltip {
+	return &Tooltip{
+		Text:       s.Tooltip,
+		Effect:     s.Effect,
+		EffectBurn: s.EffectBurn,
+		Vars:       s.Vars,
+		Cost:       s.Cost,
+		Cooldown:   s.Cooldown,
+		Range:      s.Range,
+	}
+}
+
+// ratioLinks are names of SpellVars.Link rendered as a ratio, like "60% AP".
+var ratioLinks = map[string]string{
+	"spelldamage":           "AP",
+	"attackdamage":          "AD",
+	"bonusattackdamage":     "bonus AD",
+	"armor":                 "armor",
+	"bonusarmor":            "bonus armor",
+	"spellblock":            "magic resist",
+	"bonusspellblock":       "bonus magic resist",
+	"health":                "health",
+	"bonushealth":           "bonus health",
+	"mana":                  "mana",
+	"bonusmana":             "bonus mana",
+	"@dynamic.abilitypower": "AP",
+	"@dynamic.attackdamage": "AD",
+}
+
+// e.g. "{{ e1 }}", "{{ a1 }}", "{{ f2*100 }}"
+var placeholderRegexp = regexp.MustCompile(`\{\{\s*(\w+)\s*(?:\*\s*(-?[\d.]+)\s*)?\}\}`)
+
+// Render substitutes placeholders with values at rank (starting from 1),
+// or values of all ranks like "80/120/160" if rank is 0.
+// Unknown placeholders are rendered as "?".
+func (t *Tooltip) Render(rank int, f Format) string {
+	text := placeholderRegexp.ReplaceAllStringFunc(t.Text, func(s string) string {
+		m := placeholderRegexp.FindStringSubmatch(s)
+		mul := 1.0
+		if m[2] != "" {
+			mul, _ = strconv.ParseFloat(m[2], 64)
+		}
+		if v, ok := t.value(m[1], rank, mul); ok {
+			return v
+		}
+		return "?"
+	})
+
+	if f == HTML {
+		return sanitizeHTML(text)
+	}
+	return plainText(text)
+}
+
+// value returns a value of a placeholder.
+func (t *Tooltip) value(key string, rank int, mul float64) (string, bool) {
+	switch key {
+	case "cost":
+		values := make([]float64, len(t.Cost))
+		for i, c := range t.Cost {
+			values[i] = float64(c)
+		}
+		return perRank(values, rank, mul), len(values) != 0
+	case "cooldown":
+		return perRank(t.Cooldown, rank, mul), len(t.Cooldown) != 0
+	case "range":
+		return RangeText(t.Range, rank), t.Range != nil
+	}
+
+	if strings.HasPrefix(key, "e") {
+		if n, err := strconv.Atoi(key[1:]); err == nil {
+			if n < len(t.Effect) && len(t.Effect[n]) != 0 {
+				return perRank(t.Effect[n], rank, mul), true
+			}
+			if n < len(t.EffectBurn) && t.EffectBurn[n] != "" && rank == 0 && mul == 1 {
+				return t.EffectBurn[n], true
+			}
+			return "", false
+		}
+	}
+
+	for _, v := range t.Vars {
+		if v.Key != key || len(v.Coeff) == 0 {
+			continue
+		}
+		if label, ok := ratioLinks[v.Link]; ok {
+			return perRank(v.Coeff, rank, mul*100) + "% " + label, true
+		}
+		return perRank(v.Coeff, rank, mul), true
+	}
+	return "", false
+}
+
+// perRank returns a value at rank, or values of all ranks joined with "/".
+// Same values are rendered once.
+func perRank(values []float64, rank int, mul float64) string {
+	if len(values) == 0 {
+		return ""
+	}
+	if rank > 0 {
+		if rank > len(values) {
+			rank = len(values)
+		}
+		return formatFloat(values[rank-1] * mul)
+	}
+
+	same := true
+	strs := make([]string, len(values))
+	for i, v := range values {
+		strs[i] = formatFloat(v * mul)
+		same = same && v == values[0]
+	}
+	if same {
+		return strs[0]
+	}
+	return strings.Join(strs, "/")
+}
+
+// RangeText returns "Self", or range at rank (or of all ranks if rank is 0) like "600/650/700".
+func RangeText(r *lol.SpellRange, rank int) string {
+	if r == nil {
+		return ""
+	}
+	if r.Self {
+		return "Self"
+	}
+	values := make([]float64, len(r.Ranges))
+	for i, v := range r.Ranges {
+		values[i] = float64(v)
+	}
+	return perRank(values, rank, 1)
+}
+
+// formatFloat formats v with at most 4 decimal places.
+// v is rounded to 4 decimal places first, as ratios multiplied by 100
+// have errors like 0.6*100 = 60.00000000000001.
+func formatFloat(v float64) string {
+	s := strconv.FormatFloat(v, 'f', 4, 64)
+	s = strings.TrimRight(strings.TrimRight(s, "0"), ".")
+	if s == "-0" {
+		return "0"
+	}
+	return s
+}
+
+var (
+	tagRegexp   = regexp.MustCompile(`<(/?)([a-zA-Z]+)([^>]*)>`)
+	classRegexp = regexp.MustCompile(`class\s*=\s*["']([\w\s-]*)["']`)
+)
+
+// plainText strips tags, and converts <br> to newlines.
+func plainText(s string) string {
+	s = tagRegexp.ReplaceAllStringFunc(s, func(tag string) string {
+		if m := tagRegexp.FindStringSubmatch(tag); strings.ToLower(m[2]) == "br" {
+			return "\n"
+		}
+		return ""
+	})
+	return html.UnescapeString(s)
+}
+
+// sanitizeHTML keeps <br> and <span class="...">, and escapes other text.
+func sanitizeHTML(s string) string {
+	var buf []byte
+	text := func(s string) {
+		buf = append(buf, html.EscapeString(html.UnescapeString(s))...)
+	}
+
+	last := 0
+	for _, loc := range tagRegexp.FindAllStringSubmatchIndex(s, -1) {
+		text(s[last:loc[0]])
+		last = loc[1]
+
+		closing, name, attrs := s[loc[2]:loc[3]] == "/", strings.ToLower(s[loc[4]:loc[5]]), s[loc[6]:loc[7]]
+		switch {
+		case name == "br" && !closing:
+			buf = append(buf, "<br>"...)
+		case name == "span" && closing:
+			buf = append(buf, "</span>"...)
+		case name == "span":
+			if m := classRegexp.FindStringSubmatch(attrs); m != nil {
+				buf = append(buf, `<span class="`+m[1]+`">`...)
+			} else {
+				buf = append(buf, "<span>"...)
+			}
+		}
+	}
+	text(s[last:])
+	return string(buf)
+}
@@ -0,0 +1,55 @@
+package staticdata
+
+import (
+	"testing"
+
+	"github.com/go-lol/lol"
+)
+
+func TestTooltip(t *testing.T) {
+	tip := ChampionSpellTooltip(&lol.ChampionSpell{
+		Tooltip: `Deals <span class="colorFF9900">{{ e1 }}</span> <span onclick="x()">(+{{ a1 }})</span> magic damage.<br>Range: {{ range }} {{ f1 }}`,
+		Effect:  [][]float64{nil, {80, 120, 160}},
+		Vars:    []*lol.SpellVars{{Key: "a1", Link: "spelldamage", Coeff: []float64{0.6}}},
+		Range:   &lol.SpellRange{Ranges: []int32{600, 600, 600}},
+	})
+
+	for _, tt := range []struct {
+		rank   int
+		format Format
+		want   string
+	}{
+		{0, PlainText, "Deals 80/120/160 (+60% AP) magic damage.\nRange: 600 ?"},
+		{2, PlainText, "Deals 120 (+60% AP) magic damage.\nRange: 600 ?"},
+		{3, HTML, `Deals <span class="colorFF9900">160</span> <span>(+60% AP)</span> magic damage.<br>Range: 600 ?`},
+	} {
+		if got := tip.Render(tt.rank, tt.format); got != tt.want {
+			t.Errorf("Expected %q, got %q", tt.want, got)
+		}
+	}
+
+	self := SummonerSpellTooltip(&lol.SummonerSpell{
+		Tooltip:    `Heals {{ e1 }} <script>&amp;</script> at {{ range }}`,
+		EffectBurn: []string{"", "90-345"},
+		Range:      &lol.SpellRange{Self: true},
+	})
+	if got, want := self.Render(0, HTML), `Heals 90-345 &amp; at Self`; got != want {
+		t.Errorf("Expected %q, got %q", want, got)
+	}
+}
+
+func TestFormatFloat(t *testing.T) {
+	for v, expected := range map[float64]string{
+		0.6 * 100:   "60",
+		0.125:       "0.125",
+		16777217:    "16777217", // not representable as float32
+		1234567.891: "1234567.891",
+		100:         "100",
+		-0.00001:    "0",
+		-2.5:        "-2.5",
+	} {
+		if s := formatFloat(v); s != expected {
+			t.Errorf("Expected %s for %v, got %s", expected, v, s)
+		}
+	}
+}
@@ -0,0 +1,332 @@
+// Generated by go-lol-generator. DO NOT EDIT.
+
+package lol
+
+import "encoding/json"
+import "io"
+import "net/http"
+import "net/url"
+import "golang.org/x/net/context"
+import "github.com/go-lol/lol/uritemplates"
+
+// AggregatedStatsDto - This object contains aggregated stat information.
+//
+// resource: "stats", original name: "AggregatedStatsDto"
+type AggregatedStats struct {
+	// Dominion only.
+	AverageAssists int32 `json:"averageAssists,omitempty"`
+	// Dominion only.
+	AverageChampionsKilled int32 `json:"averageChampionsKilled,omitempty"`
+	// Dominion only.
+	AverageCombatPlayerScore int32 `json:"averageCombatPlayerScore,omitempty"`
+	// Dominion only.
+	AverageNodeCapture int32 `json:"averageNodeCapture,omitempty"`
+	// Dominion only.
+	AverageNodeCaptureAssist int32 `json:"averageNodeCaptureAssist,omitempty"`
+	// Dominion only.
+	AverageNodeNeutralize int32 `json:"averageNodeNeutralize,omitempty"`
+	// Dominion only.
+	AverageNodeNeutralizeAssist int32 `json:"averageNodeNeutralizeAssist,omitempty"`
+	// Dominion only.
+	AverageNumDeaths int32 `json:"averageNumDeaths,omitempty"`
+	// Dominion only.
+	AverageObjectivePlayerScore int32 `json:"averageObjectivePlayerScore,omitempty"`
+	// Dominion only.
+	AverageTeamObjective int32 `json:"averageTeamObjective,omitempty"`
+	// Dominion only.
+	AverageTotalPlayerScore int32 `json:"averageTotalPlayerScore,omitempty"`
+	BotGamesPlayed          int32 `json:"botGamesPlayed,omitempty"`
+	KillingSpree            int32 `json:"killingSpree,omitempty"`
+	// Dominion only.
+	MaxAssists         int32 `json:"maxAssists,omitempty"`
+	MaxChampionsKilled int32 `json:"maxChampionsKilled,omitempty"`
+	// Dominion only.
+	MaxCombatPlayerScore     int32 `json:"maxCombatPlayerScore,omitempty"`
+	MaxLargestCriticalStrike int32 `json:"maxLargestCriticalStrike,omitempty"`
+	MaxLargestKillingSpree   int32 `json:"maxLargestKillingSpree,omitempty"`
+	// Dominion only.
+	MaxNodeCapture int32 `json:"maxNodeCapture,omitempty"`
+	// Dominion only.
+	MaxNodeCaptureAssist int32 `json:"maxNodeCaptureAssist,omitempty"`
+	// Dominion only.
+	MaxNodeNeutralize int32 `json:"maxNodeNeutralize,omitempty"`
+	// Dominion only.
+	MaxNodeNeutralizeAssist int32 `json:"maxNodeNeutralizeAssist,omitempty"`
+	// Only returned for ranked statistics.
+	MaxNumDeaths int32 `json:"maxNumDeaths,omitempty"`
+	// Dominion only.
+	MaxObjectivePlayerScore int32 `json:"maxObjectivePlayerScore,omitempty"`
+	// Dominion only.
+	MaxTeamObjective   int32 `json:"maxTeamObjective,omitempty"`
+	MaxTimePlayed      int32 `json:"maxTimePlayed,omitempty"`
+	MaxTimeSpentLiving int32 `json:"maxTimeSpentLiving,omitempty"`
+	// Dominion only.
+	MaxTotalPlayerScore         int32 `json:"maxTotalPlayerScore,omitempty"`
+	MostChampionKillsPerSession int32 `json:"mostChampionKillsPerSession,omitempty"`
+	MostSpellsCast              int32 `json:"mostSpellsCast,omitempty"`
+	NormalGamesPlayed           int32 `json:"normalGamesPlayed,omitempty"`
+	RankedPremadeGamesPlayed    int32 `json:"rankedPremadeGamesPlayed,omitempty"`
+	RankedSoloGamesPlayed       int32 `json:"rankedSoloGamesPlayed,omitempty"`
+	TotalAssists                int32 `json:"totalAssists,omitempty"`
+	TotalChampionKills          int32 `json:"totalChampionKills,omitempty"`
+	TotalDamageDealt            int32 `json:"totalDamageDealt,omitempty"`
+	TotalDamageTaken            int32 `json:"totalDamageTaken,omitempty"`
+	// Only returned for ranked statistics.
+	TotalDeathsPerSession     int32 `json:"totalDeathsPerSession,omitempty"`
+	TotalDoubleKills          int32 `json:"totalDoubleKills,omitempty"`
+	TotalFirstBlood           int32 `json:"totalFirstBlood,omitempty"`
+	TotalGoldEarned           int32 `json:"totalGoldEarned,omitempty"`
+	TotalHeal                 int32 `json:"totalHeal,omitempty"`
+	TotalMagicDamageDealt     int32 `json:"totalMagicDamageDealt,omitempty"`
+	TotalMinionKills          int32 `json:"totalMinionKills,omitempty"`
+	TotalNeutralMinionsKilled int32 `json:"totalNeutralMinionsKilled,omitempty"`
+	// Dominion only.
+	TotalNodeCapture int32 `json:"totalNodeCapture,omitempty"`
+	// Dominion only.
+	TotalNodeNeutralize      int32 `json:"totalNodeNeutralize,omitempty"`
+	TotalPentaKills          int32 `json:"totalPentaKills,omitempty"`
+	TotalPhysicalDamageDealt int32 `json:"totalPhysicalDamageDealt,omitempty"`
+	TotalQuadraKills         int32 `json:"totalQuadraKills,omitempty"`
+	TotalSessionsLost        int32 `json:"totalSessionsLost,omitempty"`
+	TotalSessionsPlayed      int32 `json:"totalSessionsPlayed,omitempty"`
+	TotalSessionsWon         int32 `json:"totalSessionsWon,omitempty"`
+	TotalTripleKills         int32 `json:"totalTripleKills,omitempty"`
+	TotalTurretsKilled       int32 `json:"totalTurretsKilled,omitempty"`
+	TotalUnrealKills         int32 `json:"totalUnrealKills,omitempty"`
+}
+
+// ChampionStatsDto - This object contains a collection of champion stats information.
+//
+// resource: "stats", original name: "ChampionStatsDto"
+type ChampionStats struct {
+	// Champion ID. Note that champion ID 0 represents the combined stats for all champions. For static information correlating to champion IDs, please refer to the LoL Static Data API.
+	ID int32 `json:"id,omitempty"`
+	// Aggregated stats associated with the champion.
+	Stats *AggregatedStats `json:"stats,omitempty"`
+}
+
+// PlayerStatsSummaryDto - This object contains player stats summary information.
+//
+// resource: "stats", original name: "PlayerStatsSummaryDto"
+type PlayerStatsSummary struct {
+	// Aggregated stats.
+	AggregatedStats *AggregatedStats `json:"aggregatedStats,omitempty"`
+	// Number of losses for this queue type. Returned for ranked queue types only.
+	Losses int32 `json:"losses,omitempty"`
+	// Date stats were last modified specified as epoch milliseconds.
+	ModifyDate EpochMillis `json:"modifyDate,omitempty"`
+	// Player stats summary type. (Legal values: AramUnranked5x5, Ascension, CAP5x5, CoopVsAI, CoopVsAI3x3, CounterPick, FirstBlood1x1, FirstBlood2x2, Hexakill, KingPoro, NightmareBot, OdinUnranked, OneForAll5x5, RankedPremade3x3, RankedPremade5x5, RankedSolo5x5, RankedTeam3x3, RankedTeam5x5, SummonersRift6x6, Unranked, Unranked3x3, URF, URFBots, Bilgewater)
+	PlayerStatSummaryType string `json:"playerStatSummaryType,omitempty"`
+	// Number of wins for this queue type.
+	Wins int32 `json:"wins,omitempty"`
+}
+
+// PlayerStatsSummaryListDto - This object contains a collection of player stats summary information.
+//
+// resource: "stats", original name: "PlayerStatsSummaryListDto"
+type PlayerStatsSummaryList struct {
+	// Collection of player stats summaries associated with the summoner.
+	PlayerStatSummaries []*PlayerStatsSummary `json:"playerStatSummaries,omitempty"`
+	// Summoner ID.
+	SummonerID int64 `json:"summonerId,omitempty"`
+}
+
+// RankedStatsDto - This object contains ranked stats information.
+//
+// resource: "stats", original name: "RankedStatsDto"
+type RankedStats struct {
+	// Collection of aggregated stats summarized by champion.
+	Champions []*ChampionStats `json:"champions,omitempty"`
+	// Date stats were last modified specified as epoch milliseconds.
+	ModifyDate EpochMillis `json:"modifyDate,omitempty"`
+	// Summoner ID.
+	SummonerID int64 `json:"summonerId,omitempty"`
+}
+
+// RankedStatsCall is a builder for "RankedStats"
+type RankedStatsCall struct {
+	ctx        context.Context
+	client     *Client
+	query      url.Values
+	pathParams map[string]string
+	region     Region
+}
+
+var rankedStatsInfo = &OperationInfo{
+	Name:     "RankedStats",
+	Resource: "stats",
+	Version:  "v1.3",
+	Method:   "GET",
+	Path:     "/api/lol/{region}/v1.3/stats/by-summoner/{summonerId}/ranked",
+	DocURL:   "https://developer.riotgames.com/api/methods#!/1018/3452",
+	Regions:  []Region{BR, EUNE, EUW, KR, LAN, LAS, NA, OCE, RU, TR},
+	Errors: []OperationError{
+		{400, "Bad request"},
+		{401, "Unauthorized"},
+		{404, "Stats data not found"},
+		{429, "Rate limit exceeded"},
+		{500, "Internal server error"},
+		{503, "Service unavailable"},
+	},
+}
+
+// Info returns information of the operation.
+func (c *RankedStatsCall) Info() *OperationInfo { return rankedStatsInfo }
+
+// Get ranked stats by summoner ID.
+//
+//
+// Implementation notes: Includes ranked stats for Twisted Treeline and Summoner's Rift.
+//
+// GET: /api/lol/{region}/v1.3/stats/by-summoner/{summonerId}/ranked
+//
+// Reference: https://developer.riotgames.com/api/methods#!/1018/3452
+func (c *Client) RankedStats(ctx context.Context, region Region, summonerID int64) *RankedStatsCall {
+	path := make(map[string]string)
+	path["summonerId"] = convertToString(summonerID)
+	return &RankedStatsCall{ctx: ctx, client: c, query: make(url.Values), pathParams: path, region: region}
+}
+
+// season configures query parameter "season".
+func (c *RankedStatsCall) Season(v string) *RankedStatsCall {
+	c.query.Set("season", convertToString(v))
+	return c
+}
+
+func (c *RankedStatsCall) doRequest() (*http.Response, error) {
+	var body io.Reader
+	switch c.region {
+	case BR, EUNE, EUW, KR, LAN, LAS, NA, OCE, RU, TR:
+	default:
+		return nil, ErrNotSupportedRegion
+	}
+	c.query.Set("api_key", c.client.apiKey)
+	c.pathParams["region"] = c.region.Name()
+
+	path, err := uritemplates.Expand("/api/lol/{region}/v1.3/stats/by-summoner/{summonerId}/ranked", c.pathParams)
+	if err != nil {
+		return nil, err
+	}
+	urls := c.region.baseURL() + path + "?" + c.query.Encode()
+
+	return c.client.doRequest(c.ctx, rankedStatsInfo, urls, body)
+}
+
+// Do executes api request.
+//
+// API Errors:
+//  400 - Bad request
+//  401 - Unauthorized
+//  404 - Stats data not found
+//  429 - Rate limit exceeded
+//  500 - Internal server error
+//  503 - Service unavailable
+func (c *RankedStatsCall) Do() (*RankedStats, error) {
+	res, err := c.doRequest()
+	if err != nil {
+		return nil, err
+	}
+	defer closeBody(res)
+	if err := verifyAPIResponse(res); err != nil {
+		return nil, err
+	}
+	ret := &RankedStats{}
+	if err := json.NewDecoder(res.Body).Decode(&ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// StatsSummaryCall is a builder for "StatsSummary"
+type StatsSummaryCall struct {
+	ctx        context.Context
+	client     *Client
+	query      url.Values
+	pathParams map[string]string
+	region     Region
+}
+
+var statsSummaryInfo = &OperationInfo{
+	Name:     "StatsSummary",
+	Resource: "stats",
+	Version:  "v1.3",
+	Method:   "GET",
+	Path:     "/api/lol/{region}/v1.3/stats/by-summoner/{summonerId}/summary",
+	DocURL:   "https://developer.riotgames.com/api/methods#!/1018/3453",
+	Regions:  []Region{BR, EUNE, EUW, KR, LAN, LAS, NA, OCE, RU, TR},
+	Errors: []OperationError{
+		{400, "Bad request"},
+		{401, "Unauthorized"},
+		{404, "Stats data not found"},
+		{429, "Rate limit exceeded"},
+		{500, "Internal server error"},
+		{503, "Service unavailable"},
+	},
+}
+
+// Info returns information of the operation.
+func (c *StatsSummaryCall) Info() *OperationInfo { return statsSummaryInfo }
+
+// Get player stats summaries by summoner ID.
+//
+//
+// Implementation notes: One summary is returned per queue type.
+//
+// GET: /api/lol/{region}/v1.3/stats/by-summoner/{summonerId}/summary
+//
+// Reference: https://developer.riotgames.com/api/methods#!/1018/3453
+func (c *Client) StatsSummary(ctx context.Context, region Region, summonerID int64) *StatsSummaryCall {
+	path := make(map[string]string)
+	path["summonerId"] = convertToString(summonerID)
+	return &StatsSummaryCall{ctx: ctx, client: c, query: make(url.Values), pathParams: path, region: region}
+}
+
+// season configures query parameter "season".
+func (c *StatsSummaryCall) Season(v string) *StatsSummaryCall {
+	c.query.Set("season", convertToString(v))
+	return c
+}
+
+func (c *StatsSummaryCall) doRequest() (*http.Response, error) {
+	var body io.Reader
+	switch c.region {
+	case BR, EUNE, EUW, KR, LAN, LAS, NA, OCE, RU, TR:
+	default:
+		return nil, ErrNotSupportedRegion
+	}
+	c.query.Set("api_key", c.client.apiKey)
+	c.pathParams["region"] = c.region.Name()
+
+	path, err := uritemplates.Expand("/api/lol/{region}/v1.3/stats/by-summoner/{summonerId}/summary", c.pathParams)
+	if err != nil {
+		return nil, err
+	}
+	urls := c.region.baseURL() + path + "?" + c.query.Encode()
+
+	return c.client.doRequest(c.ctx, statsSummaryInfo, urls, body)
+}
+
+// Do executes api request.
+//
+// API Errors:
+//  400 - Bad request
+//  401 - Unauthorized
+//  404 - Stats data not found
+//  429 - Rate limit exceeded
+//  500 - Internal server error
+//  503 - Service unavailable
+func (c *StatsSummaryCall) Do() (*PlayerStatsSummaryList, error) {
+	res, err := c.doRequest()
+	if err != nil {
+		return nil, err
+	}
+	defer closeBody(res)
+	if err := verifyAPIResponse(res); err != nil {
+		return nil, err
+	}
+	ret := &PlayerStatsSummaryList{}
+	if err := json.NewDecoder(res.Body).Decode(&ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
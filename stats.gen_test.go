@@ -0,0 +1,33 @@
+// Generated by go-lol-generator. DO NOT EDIT.
+
+package lol
+
+import "testing"
+import "golang.org/x/net/context"
+
+func TestStatsOperations(t *testing.T) {
+	runOperationTests(t, []operationTest{
+		{
+			name:        "RankedStats",
+			region:      NA,
+			unsupported: Global,
+			path:        "/api/lol/na/v1.3/stats/by-summoner/1/ranked",
+			apiKey:      true,
+			fixture:     "{\"champions\":[{\"id\":1,\"stats\":{\"averageAssists\":1,\"averageChampionsKilled\":1,\"averageCombatPlayerScore\":1,\"averageNodeCapture\":1,\"averageNodeCaptureAssist\":1,\"averageNodeNeutralize\":1,\"averageNodeNeutralizeAssist\":1,\"averageNumDeaths\":1,\"averageObjectivePlayerScore\":1,\"averageTeamObjective\":1,\"averageTotalPlayerScore\":1,\"botGamesPlayed\":1,\"killingSpree\":1,\"maxAssists\":1,\"maxChampionsKilled\":1,\"maxCombatPlayerScore\":1,\"maxLargestCriticalStrike\":1,\"maxLargestKillingSpree\":1,\"maxNodeCapture\":1,\"maxNodeCaptureAssist\":1,\"maxNodeNeutralize\":1,\"maxNodeNeutralizeAssist\":1,\"maxNumDeaths\":1,\"maxObjectivePlayerScore\":1,\"maxTeamObjective\":1,\"maxTimePlayed\":1,\"maxTimeSpentLiving\":1,\"maxTotalPlayerScore\":1,\"mostChampionKillsPerSession\":1,\"mostSpellsCast\":1,\"normalGamesPlayed\":1,\"rankedPremadeGamesPlayed\":1,\"rankedSoloGamesPlayed\":1,\"totalAssists\":1,\"totalChampionKills\":1,\"totalDamageDealt\":1,\"totalDamageTaken\":1,\"totalDeathsPerSession\":1,\"totalDoubleKills\":1,\"totalFirstBlood\":1,\"totalGoldEarned\":1,\"totalHeal\":1,\"totalMagicDamageDealt\":1,\"totalMinionKills\":1,\"totalNeutralMinionsKilled\":1,\"totalNodeCapture\":1,\"totalNodeNeutralize\":1,\"totalPentaKills\":1,\"totalPhysicalDamageDealt\":1,\"totalQuadraKills\":1,\"totalSessionsLost\":1,\"totalSessionsPlayed\":1,\"totalSessionsWon\":1,\"totalTripleKills\":1,\"totalTurretsKilled\":1,\"totalUnrealKills\":1}}],\"modifyDate\":1,\"summonerId\":1}",
+			do: func(c *Client, region Region) (interface{}, error) {
+				return c.RankedStats(context.TODO(), region, 1).Do()
+			},
+		},
+		{
+			name:        "StatsSummary",
+			region:      NA,
+			unsupported: Global,
+			path:        "/api/lol/na/v1.3/stats/by-summoner/1/summary",
+			apiKey:      true,
+			fixture:     "{\"playerStatSummaries\":[{\"aggregatedStats\":{\"averageAssists\":1,\"averageChampionsKilled\":1,\"averageCombatPlayerScore\":1,\"averageNodeCapture\":1,\"averageNodeCaptureAssist\":1,\"averageNodeNeutralize\":1,\"averageNodeNeutralizeAssist\":1,\"averageNumDeaths\":1,\"averageObjectivePlayerScore\":1,\"averageTeamObjective\":1,\"averageTotalPlayerScore\":1,\"botGamesPlayed\":1,\"killingSpree\":1,\"maxAssists\":1,\"maxChampionsKilled\":1,\"maxCombatPlayerScore\":1,\"maxLargestCriticalStrike\":1,\"maxLargestKillingSpree\":1,\"maxNodeCapture\":1,\"maxNodeCaptureAssist\":1,\"maxNodeNeutralize\":1,\"maxNodeNeutralizeAssist\":1,\"maxNumDeaths\":1,\"maxObjectivePlayerScore\":1,\"maxTeamObjective\":1,\"maxTimePlayed\":1,\"maxTimeSpentLiving\":1,\"maxTotalPlayerScore\":1,\"mostChampionKillsPerSession\":1,\"mostSpellsCast\":1,\"normalGamesPlayed\":1,\"rankedPremadeGamesPlayed\":1,\"rankedSoloGamesPlayed\":1,\"totalAssists\":1,\"totalChampionKills\":1,\"totalDamageDealt\":1,\"totalDamageTaken\":1,\"totalDeathsPerSession\":1,\"totalDoubleKills\":1,\"totalFirstBlood\":1,\"totalGoldEarned\":1,\"totalHeal\":1,\"totalMagicDamageDealt\":1,\"totalMinionKills\":1,\"totalNeutralMinionsKilled\":1,\"totalNodeCapture\":1,\"totalNodeNeutralize\":1,\"totalPentaKills\":1,\"totalPhysicalDamageDealt\":1,\"totalQuadraKills\":1,\"totalSessionsLost\":1,\"totalSessionsPlayed\":1,\"totalSessionsWon\":1,\"totalTripleKills\":1,\"totalTurretsKilled\":1,\"totalUnrealKills\":1},\"losses\":1,\"modifyDate\":1,\"playerStatSummaryType\":\"example\",\"wins\":1}],\"summonerId\":1}",
+			do: func(c *Client, region Region) (interface{}, error) {
+				return c.StatsSummary(context.TODO(), region, 1).Do()
+			},
+		},
+	})
+}
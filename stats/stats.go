@@ -0,0 +1,275 @@
+// Package stats derives player statistics like KDA and CS per minute
+// from matches, recent games and ranked stats.
+//
+// All sources are normalized into Counters first, so a statistic is
+// computed the same way regardless of the source.
+package stats
+
+import (
+	"errors"
+	"time"
+
+	"github.com/go-lol/lol"
+)
+
+// ErrParticipantNotFound is returned if a participant is not in the match.
+var ErrParticipantNotFound = errors.New("stats: participant not found")
+
+// Counters are raw counters of one or more games.
+// TeamKills, TeamGold, DamageToChampions, wards and Duration are not in some sources,
+// so they are known only if they are known in all games. See Known fields.
+type Counters struct {
+	Games, Wins int64
+
+	Kills, Deaths, Assists int64
+	// Kills of the participant's team.
+	TeamKills int64
+
+	MinionsKilled, NeutralMinionsKilled int64
+
+	DamageToChampions int64
+
+	GoldEarned int64
+	// Gold earned by the participant's team.
+	TeamGold int64
+
+	WardsPlaced, WardsKilled, VisionWardsBought int64
+
+	DoubleKills, TripleKills, QuadraKills, PentaKills, UnrealKills int64
+
+	// Total time played.
+	Duration time.Duration
+
+	// Number of games in which team kills and gold, damage to champions,
+	// wards and duration are known, respectively.
+	KnownTeamGames, KnownDamageGames, KnownWardGames, KnownDurationGames int64
+}
+
+// FromParticipant returns counters of a participant in a match.
+func FromParticipant(m *lol.MatchDetail, participantID int32) (Counters, error) {
+	v := lol.NewMatchView(m)
+	p := v.Participant(participantID)
+	if p == nil || p.Stats == nil {
+		return Counters{}, ErrParticipantNotFound
+	}
+
+	s := p.Stats
+	c := Counters{
+		Games:                1,
+		Wins:                 boolCount(s.Winner),
+		Kills:                s.Kills,
+		Deaths:               s.Deaths,
+		Assists:              s.Assists,
+		MinionsKilled:        s.MinionsKilled,
+		NeutralMinionsKilled: s.NeutralMinionsKilled,
+		DamageToChampions:    s.TotalDamageDealtToChampions,
+		GoldEarned:           s.GoldEarned,
+		WardsPlaced:          s.WardsPlaced,
+		WardsKilled:          s.WardsKilled,
+		VisionWardsBought:    s.VisionWardsBoughtInGame,
+		DoubleKills:          s.DoubleKills,
+		TripleKills:          s.TripleKills,
+		QuadraKills:          s.QuadraKills,
+		PentaKills:           s.PentaKills,
+		UnrealKills:          s.UnrealKills,
+		Duration:             m.MatchDuration.Duration(),
+		KnownTeamGames:       1,
+		KnownDamageGames:     1,
+		KnownWardGames:       1,
+		KnownDurationGames:   1,
+	}
+	for _, mate := range v.TeamParticipants(p.TeamID) {
+		if mate.Stats != nil {
+			c.TeamKills += mate.Stats.Kills
+			c.TeamGold += mate.Stats.GoldEarned
+		}
+	}
+	return c, nil
+}
+
+// FromGame returns counters of a recent game.
+// Team kills and gold are unknown.
+func FromGame(g *lol.Game) Counters {
+	s := g.Stats
+	if s == nil {
+		return Counters{Games: 1}
+	}
+	return Counters{
+		Games:                1,
+		Wins:                 boolCount(s.Win),
+		Kills:                int64(s.ChampionsKilled),
+		Deaths:               int64(s.NumDeaths),
+		Assists:              int64(s.Assists),
+		MinionsKilled:        int64(s.MinionsKilled),
+		NeutralMinionsKilled: int64(s.NeutralMinionsKilled),
+		DamageToChampions:    int64(s.TotalDamageDealtToChampions),
+		GoldEarned:           int64(s.GoldEarned),
+		WardsPlaced:          int64(s.WardPlaced),
+		WardsKilled:          int64(s.WardKilled),
+		VisionWardsBought:    int64(s.VisionWardsBought),
+		DoubleKills:          int64(s.DoubleKills),
+		TripleKills:          int64(s.TripleKills),
+		QuadraKills:          int64(s.QuadraKills),
+		PentaKills:           int64(s.PentaKills),
+		UnrealKills:          int64(s.UnrealKills),
+		Duration:             s.TimePlayed.Duration(),
+		KnownDamageGames:     1,
+		KnownWardGames:       1,
+		KnownDurationGames:   1,
+	}
+}
+
+// FromAggregated returns counters of aggregated stats.
+// Team kills and gold, damage to champions, wards and duration are unknown.
+func FromAggregated(a *lol.AggregatedStats) Counters {
+	return Counters{
+		Games:                int64(a.TotalSessionsPlayed),
+		Wins:                 int64(a.TotalSessionsWon),
+		Kills:                int64(a.TotalChampionKills),
+		Deaths:               int64(a.TotalDeathsPerSession),
+		Assists:              int64(a.TotalAssists),
+		MinionsKilled:        int64(a.TotalMinionKills),
+		NeutralMinionsKilled: int64(a.TotalNeutralMinionsKilled),
+		GoldEarned:           int64(a.TotalGoldEarned),
+		DoubleKills:          int64(a.TotalDoubleKills),
+		TripleKills:          int64(a.TotalTripleKills),
+		QuadraKills:          int64(a.TotalQuadraKills),
+		PentaKills:           int64(a.TotalPentaKills),
+		UnrealKills:          int64(a.TotalUnrealKills),
+	}
+}
+
+// FromRanked returns counters of a champion in ranked stats.
+// Champion id 0 is the sum of all champions.
+// It returns false if the champion is not found.
+func FromRanked(r *lol.RankedStats, championID int32) (Counters, bool) {
+	for _, c := range r.Champions {
+		if c.ID == championID && c.Stats != nil {
+			return FromAggregated(c.Stats), true
+		}
+	}
+	return Counters{}, false
+}
+
+// Add returns the sum of counters. Counters{} is the identity.
+// Sums of unknown and known values are unknown.
+func (c Counters) Add(o Counters) Counters {
+	return Counters{
+		Games:                c.Games + o.Games,
+		Wins:                 c.Wins + o.Wins,
+		Kills:                c.Kills + o.Kills,
+		Deaths:               c.Deaths + o.Deaths,
+		Assists:              c.Assists + o.Assists,
+		MinionsKilled:        c.MinionsKilled + o.MinionsKilled,
+		NeutralMinionsKilled: c.NeutralMinionsKilled + o.NeutralMinionsKilled,
+		GoldEarned:           c.GoldEarned + o.GoldEarned,
+		WardsPlaced:          c.WardsPlaced + o.WardsPlaced,
+		WardsKilled:          c.WardsKilled + o.WardsKilled,
+		VisionWardsBought:    c.VisionWardsBought + o.VisionWardsBought,
+		DoubleKills:          c.DoubleKills + o.DoubleKills,
+		TripleKills:          c.TripleKills + o.TripleKills,
+		QuadraKills:          c.QuadraKills + o.QuadraKills,
+		PentaKills:           c.PentaKills + o.PentaKills,
+		UnrealKills:          c.UnrealKills + o.UnrealKills,
+		TeamKills:            c.TeamKills + o.TeamKills,
+		TeamGold:             c.TeamGold + o.TeamGold,
+		DamageToChampions:    c.DamageToChampions + o.DamageToChampions,
+		Duration:             c.Duration + o.Duration,
+		KnownTeamGames:       c.KnownTeamGames + o.KnownTeamGames,
+		KnownDamageGames:     c.KnownDamageGames + o.KnownDamageGames,
+		KnownWardGames:       c.KnownWardGames + o.KnownWardGames,
+		KnownDurationGames:   c.KnownDurationGames + o.KnownDurationGames,
+	}
+}
+
+// KDA returns (kills + assists) / deaths. Deaths of 0 is counted as 1.
+func (c Counters) KDA() float64 {
+	deaths := c.Deaths
+	if deaths == 0 {
+		deaths = 1
+	}
+	return float64(c.Kills+c.Assists) / float64(deaths)
+}
+
+// WinRate returns wins / games.
+func (c Counters) WinRate() (float64, bool) { return ratio(c.Wins, c.Games) }
+
+// KillParticipation returns (kills + assists) / team kills.
+func (c Counters) KillParticipation() (float64, bool) {
+	if !c.known(c.KnownTeamGames) {
+		return 0, false
+	}
+	return ratio(c.Kills+c.Assists, c.TeamKills)
+}
+
+// CS returns minions and neutral monsters killed.
+func (c Counters) CS() int64 { return c.MinionsKilled + c.NeutralMinionsKilled }
+
+// CSPerMinute returns CS per minute.
+func (c Counters) CSPerMinute() (float64, bool) { return c.perMinute(c.CS()) }
+
+// DamagePerMinute returns damage to champions per minute.
+func (c Counters) DamagePerMinute() (float64, bool) {
+	if !c.known(c.KnownDamageGames) {
+		return 0, false
+	}
+	return c.perMinute(c.DamageToChampions)
+}
+
+// GoldPerMinute returns gold earned per minute.
+func (c Counters) GoldPerMinute() (float64, bool) { return c.perMinute(c.GoldEarned) }
+
+// GoldShare returns gold earned / team gold earned.
+func (c Counters) GoldShare() (float64, bool) {
+	if !c.known(c.KnownTeamGames) {
+		return 0, false
+	}
+	return ratio(c.GoldEarned, c.TeamGold)
+}
+
+// VisionProxy returns wards placed + wards killed + vision wards bought,
+// as a proxy of vision score, which the api does not provide.
+func (c Counters) VisionProxy() (int64, bool) {
+	if !c.known(c.KnownWardGames) {
+		return 0, false
+	}
+	return c.WardsPlaced + c.WardsKilled + c.VisionWardsBought, true
+}
+
+// VisionPerMinute returns VisionProxy per minute.
+func (c Counters) VisionPerMinute() (float64, bool) {
+	v, ok := c.VisionProxy()
+	if !ok {
+		return 0, false
+	}
+	return c.perMinute(v)
+}
+
+// MultiKills returns double, triple, quadra and penta kills.
+func (c Counters) MultiKills() [4]int64 {
+	return [4]int64{c.DoubleKills, c.TripleKills, c.QuadraKills, c.PentaKills}
+}
+
+// known returns true if a counter known in games is known in all games.
+func (c Counters) known(games int64) bool { return c.Games != 0 && games == c.Games }
+
+func (c Counters) perMinute(v int64) (float64, bool) {
+	if !c.known(c.KnownDurationGames) || c.Duration == 0 {
+		return 0, false
+	}
+	return float64(v) / c.Duration.Minutes(), true
+}
+
+func ratio(a, b int64) (float64, bool) {
+	if b == 0 {
+		return 0, false
+	}
+	return float64(a) / float64(b), true
+}
+
+func boolCount(b bool) int64 {
+	if b {
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,149 @@
+package stats
+
+import (
+	"testing"
+	"time"
+
+	"github.com/go-lol/lol"
+)
+
+func newTestMatch() *lol.MatchDetail {
+	return &lol.MatchDetail{
+		MatchDuration: 1200,
+		Participants: []*lol.MatchParticipant{
+			{ParticipantID: 1, TeamID: 100, Stats: &lol.ParticipantStats{
+				Kills: 4, Deaths: 2, Assists: 6, MinionsKilled: 180, NeutralMinionsKilled: 20,
+				GoldEarned: 10000, TotalDamageDealtToChampions: 12000,
+				WardsPlaced: 8, WardsKilled: 2, VisionWardsBoughtInGame: 2, DoubleKills: 1, Winner: true,
+			}},
+			{ParticipantID: 2, TeamID: 100, Stats: &lol.ParticipantStats{Kills: 6, GoldEarned: 15000}},
+			{ParticipantID: 3, TeamID: 200, Stats: &lol.ParticipantStats{Kills: 9, GoldEarned: 20000}},
+		},
+	}
+}
+
+func TestFromParticipant(t *testing.T) {
+	c, err := FromParticipant(newTestMatch(), 1)
+	if err != nil {
+		t.Fatal(err)
+		return
+	}
+
+	if c.KDA() != 5 {
+		t.Errorf("Expected KDA 5, got %v", c.KDA())
+	}
+	if v, ok := c.KillParticipation(); !ok || v != 1 {
+		t.Errorf("Expected kill participation 1, got %v %v", v, ok)
+	}
+	if v, ok := c.CSPerMinute(); !ok || v != 10 {
+		t.Errorf("Expected 10 cs/min, got %v %v", v, ok)
+	}
+	if v, ok := c.DamagePerMinute(); !ok || v != 600 {
+		t.Errorf("Expected 600 dpm, got %v %v", v, ok)
+	}
+	if v, ok := c.GoldShare(); !ok || v != 0.4 {
+		t.Errorf("Expected gold share 0.4, got %v %v", v, ok)
+	}
+	if v, ok := c.VisionProxy(); !ok || v != 12 || c.MultiKills()[0] != 1 || c.Wins != 1 {
+		t.Errorf("Invalid counters %+v", c)
+	}
+
+	if _, err := FromParticipant(newTestMatch(), 9); err != ErrParticipantNotFound {
+		t.Errorf("Expected ErrParticipantNotFound, got %v", err)
+	}
+}
+
+func TestSources(t *testing.T) {
+	m, _ := FromParticipant(newTestMatch(), 1)
+	g := FromGame(&lol.Game{Stats: &lol.RawStats{
+		ChampionsKilled: 4, NumDeaths: 2, Assists: 6, MinionsKilled: 180, NeutralMinionsKilled: 20,
+		GoldEarned: 10000, TotalDamageDealtToChampions: 12000, TimePlayed: 1200,
+		WardPlaced: 8, WardKilled: 2, VisionWardsBought: 2, DoubleKills: 1, Win: true,
+	}})
+	r, ok := FromRanked(&lol.RankedStats{Champions: []*lol.ChampionStats{
+		{ID: 0, Stats: &lol.AggregatedStats{
+			TotalSessionsPlayed: 1, TotalSessionsWon: 1, TotalChampionKills: 4, TotalDeathsPerSession: 2, TotalAssists: 6,
+			TotalMinionKills: 180, TotalNeutralMinionsKilled: 20, TotalGoldEarned: 10000, TotalDoubleKills: 1,
+		}},
+	}}, 0)
+	if !ok {
+		t.Fatal("Expected champion 0")
+		return
+	}
+
+	for _, c := range []Counters{g, r} {
+		if c.KDA() != m.KDA() || c.CS() != m.CS() || c.MultiKills() != m.MultiKills() {
+			t.Errorf("Expected %+v, got %+v", m, c)
+		}
+		if v, ok := c.WinRate(); !ok || v != 1 {
+			t.Errorf("Expected win rate 1, got %v %v", v, ok)
+		}
+	}
+	if v, ok := g.CSPerMinute(); !ok || v != 10 {
+		t.Errorf("Expected 10 cs/min, got %v %v", v, ok)
+	}
+	if _, ok := g.KillParticipation(); ok {
+		t.Error("Expected unknown kill participation of a game")
+	}
+	if _, ok := r.DamagePerMinute(); ok {
+		t.Error("Expected unknown dpm of ranked stats")
+	}
+	if v, ok := g.VisionProxy(); !ok || v != 12 {
+		t.Errorf("Expected vision 12 of a game, got %v %v", v, ok)
+	}
+	if _, ok := r.VisionProxy(); ok {
+		t.Error("Expected unknown vision of ranked stats")
+	}
+	if _, ok := m.Add(r).VisionPerMinute(); ok {
+		t.Error("Expected unknown vision of a sum with ranked stats")
+	}
+}
+
+func TestAdd(t *testing.T) {
+	a, _ := FromParticipant(newTestMatch(), 1)
+	b := FromGame(&lol.Game{Stats: &lol.RawStats{ChampionsKilled: 2, TimePlayed: 600}})
+
+	sum := a.Add(b)
+	if sum.Games != 2 || sum.Kills != 6 || sum.Duration != 30*time.Minute {
+		t.Errorf("Invalid sum %+v", sum)
+	}
+	if _, ok := sum.KillParticipation(); ok {
+		t.Error("Expected unknown kill participation of a sum with a game")
+	}
+	if sum := a.Add(a); sum.TeamKills != 20 {
+		t.Errorf("Expected 20 team kills, got %v", sum.TeamKills)
+	}
+}
+
+func TestAddFold(t *testing.T) {
+	m := newTestMatch()
+	a, _ := FromParticipant(m, 1)
+	if sum := (Counters{}).Add(a); sum != a {
+		t.Errorf("Expected %+v, got %+v", a, sum)
+	}
+
+	var sum Counters
+	for _, id := range []int32{1, 2, 3} {
+		c, err := FromParticipant(m, id)
+		if err != nil {
+			t.Fatal(err)
+			return
+		}
+		sum = sum.Add(c)
+	}
+	if sum.Games != 3 || sum.TeamKills != 29 || sum.TeamGold != 70000 || sum.Duration != time.Hour {
+		t.Errorf("Invalid sum %+v", sum)
+	}
+	if v, ok := sum.KillParticipation(); !ok || v != 25.0/29 {
+		t.Errorf("Expected kill participation 25/29, got %v %v", v, ok)
+	}
+	if v, ok := sum.DamagePerMinute(); !ok || v != 200 {
+		t.Errorf("Expected 200 dpm, got %v %v", v, ok)
+	}
+
+	// No damage is known zero.
+	b, _ := FromParticipant(m, 2)
+	if v, ok := b.DamagePerMinute(); !ok || v != 0 {
+		t.Errorf("Expected 0 dpm, got %v %v", v, ok)
+	}
+}
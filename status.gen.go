@@ -0,0 +1,230 @@
+// Generated by go-lol-generator. DO NOT EDIT.
+
+package lol
+
+import "encoding/json"
+import "io"
+import "net/http"
+import "net/url"
+import "golang.org/x/net/context"
+import "github.com/go-lol/lol/uritemplates"
+
+// Incident
+//
+// resource: "lol-status", original name: "Incident"
+type Incident struct {
+	Active    bool       `json:"active,omitempty"`
+	CreatedAt string     `json:"created_at,omitempty"`
+	ID        int64      `json:"id,omitempty"`
+	Updates   []*Message `json:"updates,omitempty"`
+}
+
+// Message
+//
+// resource: "lol-status", original name: "Message"
+type Message struct {
+	Author    string `json:"author,omitempty"`
+	Content   string `json:"content,omitempty"`
+	CreatedAt string `json:"created_at,omitempty"`
+	ID        int64  `json:"id,omitempty"`
+	// Legal values: Info, Alert, Error
+	Severity     string         `json:"severity,omitempty"`
+	Translations []*Translation `json:"translations,omitempty"`
+	UpdatedAt    string         `json:"updated_at,omitempty"`
+}
+
+// Service
+//
+// resource: "lol-status", original name: "Service"
+type Service struct {
+	Incidents []*Incident `json:"incidents,omitempty"`
+	Name      string      `json:"name,omitempty"`
+	Slug      string      `json:"slug,omitempty"`
+	// Legal values: Online, Alert, Offline, Deploying
+	Status string `json:"status,omitempty"`
+}
+
+// Shard
+//
+// resource: "lol-status", original name: "Shard"
+type Shard struct {
+	Hostname  string   `json:"hostname,omitempty"`
+	Locales   []string `json:"locales,omitempty"`
+	Name      string   `json:"name,omitempty"`
+	RegionTag string   `json:"region_tag,omitempty"`
+	Slug      string   `json:"slug,omitempty"`
+}
+
+// ShardStatus
+//
+// resource: "lol-status", original name: "ShardStatus"
+type ShardStatus struct {
+	Hostname  string     `json:"hostname,omitempty"`
+	Locales   []string   `json:"locales,omitempty"`
+	Name      string     `json:"name,omitempty"`
+	RegionTag string     `json:"region_tag,omitempty"`
+	Services  []*Service `json:"services,omitempty"`
+	Slug      string     `json:"slug,omitempty"`
+}
+
+// Translation
+//
+// resource: "lol-status", original name: "Translation"
+type Translation struct {
+	Content   string `json:"content,omitempty"`
+	Locale    string `json:"locale,omitempty"`
+	UpdatedAt string `json:"updated_at,omitempty"`
+}
+
+// ShardsCall is a builder for "Shards"
+type ShardsCall struct {
+	ctx        context.Context
+	client     *Client
+	query      url.Values
+	pathParams map[string]string
+}
+
+var shardsInfo = &OperationInfo{
+	Name:            "Shards",
+	Resource:        "lol-status",
+	Version:         "v1.0",
+	Method:          "GET",
+	Path:            "/shards",
+	DocURL:          "https://developer.riotgames.com/api/methods#!/908/3143",
+	RateLimitNotes:  "Requests to this API will not be counted in your Rate Limit.",
+	RateLimitExempt: true,
+	Regions:         []Region{BR, EUNE, EUW, LAN, LAS, NA, OCE, PBE, RU, TR},
+	Errors: []OperationError{
+		{403, "Forbidden"},
+		{429, "Rate limit exceeded"},
+	},
+}
+
+// Info returns information of the operation.
+func (c *ShardsCall) Info() *OperationInfo { return shardsInfo }
+
+// Get shard list.
+//
+//
+// Rate limit notes: Requests to this API will not be counted in your Rate Limit.
+//
+// GET: http://status.leagueoflegends.com/shards
+//
+// Reference: https://developer.riotgames.com/api/methods#!/908/3143
+func (c *Client) Shards(ctx context.Context) *ShardsCall {
+	path := make(map[string]string)
+	return &ShardsCall{ctx: ctx, client: c, query: make(url.Values), pathParams: path}
+}
+
+func (c *ShardsCall) doRequest() (*http.Response, error) {
+	var body io.Reader
+
+	path, err := uritemplates.Expand("/shards", c.pathParams)
+	if err != nil {
+		return nil, err
+	}
+	urls := "http://status.leagueoflegends.com" + path + "?" + c.query.Encode()
+
+	return c.client.doRequest(c.ctx, shardsInfo, urls, body)
+}
+
+// Do executes api request.
+//
+// API Errors:
+//  403 - Forbidden
+//  429 - Rate limit exceeded
+func (c *ShardsCall) Do() ([]*Shard, error) {
+	res, err := c.doRequest()
+	if err != nil {
+		return nil, err
+	}
+	defer closeBody(res)
+	if err := verifyAPIResponse(res); err != nil {
+		return nil, err
+	}
+	ret := make([]*Shard, 0)
+	if err := json.NewDecoder(res.Body).Decode(&ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// ShardsInRegionCall is a builder for "ShardsInRegion"
+type ShardsInRegionCall struct {
+	ctx        context.Context
+	client     *Client
+	query      url.Values
+	pathParams map[string]string
+	region     Region
+}
+
+var shardsInRegionInfo = &OperationInfo{
+	Name:            "ShardsInRegion",
+	Resource:        "lol-status",
+	Version:         "v1.0",
+	Method:          "GET",
+	Path:            "/shards/{region}",
+	DocURL:          "https://developer.riotgames.com/api/methods#!/908/3142",
+	RateLimitNotes:  "Requests to this API will not be counted in your Rate Limit.",
+	RateLimitExempt: true,
+	Regions:         []Region{BR, EUNE, EUW, LAN, LAS, NA, OCE, PBE, RU, TR},
+	Errors: []OperationError{
+		{403, "Forbidden"},
+		{429, "Rate limit exceeded"},
+	},
+}
+
+// Info returns information of the operation.
+func (c *ShardsInRegionCall) Info() *OperationInfo { return shardsInRegionInfo }
+
+// Get shard status. Returns the data available on the status.leagueoflegends.com website for the given region.
+//
+//
+// Rate limit notes: Requests to this API will not be counted in your Rate Limit.
+//
+// GET: http://status.leagueoflegends.com/shards/{region}
+//
+// Reference: https://developer.riotgames.com/api/methods#!/908/3142
+func (c *Client) ShardsInRegion(ctx context.Context, region Region) *ShardsInRegionCall {
+	path := make(map[string]string)
+	return &ShardsInRegionCall{ctx: ctx, client: c, query: make(url.Values), pathParams: path, region: region}
+}
+
+func (c *ShardsInRegionCall) doRequest() (*http.Response, error) {
+	var body io.Reader
+	switch c.region {
+	case BR, EUNE, EUW, LAN, LAS, NA, OCE, PBE, RU, TR:
+	default:
+		return nil, ErrNotSupportedRegion
+	}
+	c.pathParams["region"] = c.region.Name()
+
+	path, err := uritemplates.Expand("/shards/{region}", c.pathParams)
+	if err != nil {
+		return nil, err
+	}
+	urls := "http://status.leagueoflegends.com" + path + "?" + c.query.Encode()
+
+	return c.client.doRequest(c.ctx, shardsInRegionInfo, urls, body)
+}
+
+// Do executes api request.
+//
+// API Errors:
+//  403 - Forbidden
+//  429 - Rate limit exceeded
+func (c *ShardsInRegionCall) Do() (*ShardStatus, error) {
+	res, err := c.doRequest()
+	if err != nil {
+		return nil, err
+	}
+	defer closeBody(res)
+	if err := verifyAPIResponse(res); err != nil {
+		return nil, err
+	}
+	ret := &ShardStatus{}
+	if err := json.NewDecoder(res.Body).Decode(&ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
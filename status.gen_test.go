@@ -0,0 +1,31 @@
+// Generated by go-lol-generator. DO NOT EDIT.
+
+package lol
+
+import "testing"
+import "golang.org/x/net/context"
+
+func TestLolStatusOperations(t *testing.T) {
+	runOperationTests(t, []operationTest{
+		{
+			name:    "Shards",
+			path:    "/shards",
+			apiKey:  false,
+			fixture: "[{\"hostname\":\"example\",\"locales\":[\"example\"],\"name\":\"example\",\"region_tag\":\"example\",\"slug\":\"example\"}]",
+			do: func(c *Client, region Region) (interface{}, error) {
+				return c.Shards(context.TODO()).Do()
+			},
+		},
+		{
+			name:        "ShardsInRegion",
+			region:      NA,
+			unsupported: Global,
+			path:        "/shards/na",
+			apiKey:      false,
+			fixture:     "{\"hostname\":\"example\",\"locales\":[\"example\"],\"name\":\"example\",\"region_tag\":\"example\",\"services\":[{\"incidents\":[{\"active\":true,\"created_at\":\"example\",\"id\":1,\"updates\":[{\"author\":\"example\",\"content\":\"example\",\"created_at\":\"example\",\"id\":1,\"severity\":\"example\",\"translations\":[{\"content\":\"example\",\"locale\":\"example\",\"updated_at\":\"example\"}],\"updated_at\":\"example\"}]}],\"name\":\"example\",\"slug\":\"example\",\"status\":\"example\"}],\"slug\":\"example\"}",
+			do: func(c *Client, region Region) (interface{}, error) {
+				return c.ShardsInRegion(context.TODO(), region).Do()
+			},
+		},
+	})
+}
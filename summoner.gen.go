@@ -0,0 +1,559 @@
+// Generated by go-lol-generator. DO NOT EDIT.
+
+package lol
+
+import "encoding/json"
+import "io"
+import "strconv"
+import "net/http"
+import "net/url"
+import "golang.org/x/net/context"
+import "github.com/go-lol/lol/uritemplates"
+
+// MasteryPageDto - This object contains mastery page information.
+//
+// resource: "summoner", original name: "MasteryPageDto"
+type MasteryPage struct {
+	// Indicates if the mastery page is the current mastery page.
+	Current bool `json:"current,omitempty"`
+	// Mastery page ID.
+	ID int64 `json:"id,omitempty"`
+	// Collection of masteries associated with the mastery page.
+	Masteries []*SummonerMastery `json:"masteries,omitempty"`
+	// Mastery page name.
+	Name string `json:"name,omitempty"`
+}
+
+// MasteryPagesDto - This object contains masteries information.
+//
+// resource: "summoner", original name: "MasteryPagesDto"
+type MasteryPages struct {
+	// Collection of mastery pages associated with the summoner.
+	Pages []*MasteryPage `json:"pages,omitempty"`
+	// Summoner ID.
+	SummonerID int64 `json:"summonerId,omitempty"`
+}
+
+// RunePageDto - This object contains rune page information.
+//
+// resource: "summoner", original name: "RunePageDto"
+type RunePage struct {
+	// Indicates if the page is the current page.
+	Current bool `json:"current,omitempty"`
+	// Rune page ID.
+	ID int64 `json:"id,omitempty"`
+	// Rune page name.
+	Name string `json:"name,omitempty"`
+	// Collection of rune slots associated with the rune page.
+	Slots []*RuneSlot `json:"slots,omitempty"`
+}
+
+// RunePagesDto - This object contains rune pages information.
+//
+// resource: "summoner", original name: "RunePagesDto"
+type RunePages struct {
+	// Collection of rune pages associated with the summoner.
+	Pages []*RunePage `json:"pages,omitempty"`
+	// Summoner ID.
+	SummonerID int64 `json:"summonerId,omitempty"`
+}
+
+// RuneSlotDto - This object contains rune slot information.
+//
+// resource: "summoner", original name: "RuneSlotDto"
+type RuneSlot struct {
+	// Rune ID associated with the rune slot. For static information correlating to rune IDs, please refer to the LoL Static Data API.
+	RuneID int32 `json:"runeId,omitempty"`
+	// Rune slot ID.
+	RuneSlotID int32 `json:"runeSlotId,omitempty"`
+}
+
+// SummonerDto - This object contains summoner information.
+//
+// resource: "summoner", original name: "SummonerDto"
+type Summoner struct {
+	// Summoner ID.
+	ID int64 `json:"id,omitempty"`
+	// Summoner name.
+	Name string `json:"name,omitempty"`
+	// ID of the summoner icon associated with the summoner.
+	ProfileIconID int32 `json:"profileIconId,omitempty"`
+	// Date summoner was last modified specified as epoch milliseconds. The following events will update this timestamp: profile icon change, playing the tutorial or advanced tutorial, finishing a game, summoner name change
+	RevisionDate EpochMillis `json:"revisionDate,omitempty"`
+	// Summoner level associated with the summoner.
+	SummonerLevel int64 `json:"summonerLevel,omitempty"`
+}
+
+// MasteryDto - This object contains mastery information.
+//
+// resource: "summoner", original name: "MasteryDto"
+type SummonerMastery struct {
+	// Mastery ID. For static information correlating to masteries, please refer to the LoL Static Data API.
+	ID int32 `json:"id,omitempty"`
+	// Mastery rank (i.e., the number of points put into this mastery).
+	Rank int32 `json:"rank,omitempty"`
+}
+
+// SummonersByNameCall is a builder for "SummonersByName"
+type SummonersByNameCall struct {
+	ctx        context.Context
+	client     *Client
+	query      url.Values
+	pathParams map[string]string
+	region     Region
+}
+
+var summonersByNameInfo = &OperationInfo{
+	Name:     "SummonersByName",
+	Resource: "summoner",
+	Version:  "v1.4",
+	Method:   "GET",
+	Path:     "/api/lol/{region}/v1.4/summoner/by-name/{summonerNames}",
+	DocURL:   "https://developer.riotgames.com/api/methods#!/1017/3446",
+	Regions:  []Region{BR, EUNE, EUW, KR, LAN, LAS, NA, OCE, RU, TR},
+	Errors: []OperationError{
+		{400, "Bad request"},
+		{401, "Unauthorized"},
+		{404, "No summoner data found for any specified inputs"},
+		{429, "Rate limit exceeded"},
+		{500, "Internal server error"},
+		{503, "Service unavailable"},
+	},
+}
+
+// Info returns information of the operation.
+func (c *SummonersByNameCall) Info() *OperationInfo { return summonersByNameInfo }
+
+// Get summoner objects mapped by standardized summoner name for a given list of summoner names.
+//
+//
+// Implementation notes: The response object contains the summoner objects mapped by the standardized summoner name, which is the summoner name in all lower case and with spaces removed. Use this version of the name when checking if the returned object contains the data for a given summoner. This API will also accept standardized summoner names as valid parameters, although they are not required.
+//
+// GET: /api/lol/{region}/v1.4/summoner/by-name/{summonerNames}
+//
+// Reference: https://developer.riotgames.com/api/methods#!/1017/3446
+func (c *Client) SummonersByName(ctx context.Context, region Region, summonerNames []string) *SummonersByNameCall {
+	path := make(map[string]string)
+	path["summonerNames"] = convertToString(summonerNames)
+	return &SummonersByNameCall{ctx: ctx, client: c, query: make(url.Values), pathParams: path, region: region}
+}
+
+func (c *SummonersByNameCall) doRequest() (*http.Response, error) {
+	var body io.Reader
+	switch c.region {
+	case BR, EUNE, EUW, KR, LAN, LAS, NA, OCE, RU, TR:
+	default:
+		return nil, ErrNotSupportedRegion
+	}
+	c.query.Set("api_key", c.client.apiKey)
+	c.pathParams["region"] = c.region.Name()
+
+	path, err := uritemplates.Expand("/api/lol/{region}/v1.4/summoner/by-name/{summonerNames}", c.pathParams)
+	if err != nil {
+		return nil, err
+	}
+	urls := c.region.baseURL() + path + "?" + c.query.Encode()
+
+	return c.client.doRequest(c.ctx, summonersByNameInfo, urls, body)
+}
+
+// Do executes api request.
+//
+// API Errors:
+//  400 - Bad request
+//  401 - Unauthorized
+//  404 - No summoner data found for any specified inputs
+//  429 - Rate limit exceeded
+//  500 - Internal server error
+//  503 - Service unavailable
+func (c *SummonersByNameCall) Do() (map[string]*Summoner, error) {
+	res, err := c.doRequest()
+	if err != nil {
+		return nil, err
+	}
+	defer closeBody(res)
+	if err := verifyAPIResponse(res); err != nil {
+		return nil, err
+	}
+	ret := make(map[string]*Summoner)
+	if err := json.NewDecoder(res.Body).Decode(&ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// SummonersCall is a builder for "Summoners"
+type SummonersCall struct {
+	ctx        context.Context
+	client     *Client
+	query      url.Values
+	pathParams map[string]string
+	region     Region
+}
+
+var summonersInfo = &OperationInfo{
+	Name:     "Summoners",
+	Resource: "summoner",
+	Version:  "v1.4",
+	Method:   "GET",
+	Path:     "/api/lol/{region}/v1.4/summoner/{summonerIds}",
+	DocURL:   "https://developer.riotgames.com/api/methods#!/1017/3447",
+	Regions:  []Region{BR, EUNE, EUW, KR, LAN, LAS, NA, OCE, RU, TR},
+	Errors: []OperationError{
+		{400, "Bad request"},
+		{401, "Unauthorized"},
+		{404, "No summoner data found for any specified inputs"},
+		{429, "Rate limit exceeded"},
+		{500, "Internal server error"},
+		{503, "Service unavailable"},
+	},
+}
+
+// Info returns information of the operation.
+func (c *SummonersCall) Info() *OperationInfo { return summonersInfo }
+
+// Get summoner objects mapped by summoner ID for a given list of summoner IDs.
+//
+//
+// GET: /api/lol/{region}/v1.4/summoner/{summonerIds}
+//
+// Reference: https://developer.riotgames.com/api/methods#!/1017/3447
+func (c *Client) Summoners(ctx context.Context, region Region, summonerIDs []int64) *SummonersCall {
+	path := make(map[string]string)
+	path["summonerIds"] = convertToString(summonerIDs)
+	return &SummonersCall{ctx: ctx, client: c, query: make(url.Values), pathParams: path, region: region}
+}
+
+func (c *SummonersCall) doRequest() (*http.Response, error) {
+	var body io.Reader
+	switch c.region {
+	case BR, EUNE, EUW, KR, LAN, LAS, NA, OCE, RU, TR:
+	default:
+		return nil, ErrNotSupportedRegion
+	}
+	c.query.Set("api_key", c.client.apiKey)
+	c.pathParams["region"] = c.region.Name()
+
+	path, err := uritemplates.Expand("/api/lol/{region}/v1.4/summoner/{summonerIds}", c.pathParams)
+	if err != nil {
+		return nil, err
+	}
+	urls := c.region.baseURL() + path + "?" + c.query.Encode()
+
+	return c.client.doRequest(c.ctx, summonersInfo, urls, body)
+}
+
+// Do executes api request.
+//
+// API Errors:
+//  400 - Bad request
+//  401 - Unauthorized
+//  404 - No summoner data found for any specified inputs
+//  429 - Rate limit exceeded
+//  500 - Internal server error
+//  503 - Service unavailable
+func (c *SummonersCall) Do() (map[int64]*Summoner, error) {
+	res, err := c.doRequest()
+	if err != nil {
+		return nil, err
+	}
+	defer closeBody(res)
+	if err := verifyAPIResponse(res); err != nil {
+		return nil, err
+	}
+	ret := make(map[string]*Summoner)
+	if err := json.NewDecoder(res.Body).Decode(&ret); err != nil {
+		return nil, err
+	}
+	data := make(map[int64]*Summoner)
+	for k, v := range ret {
+		i, err := strconv.ParseInt(k, 10, 64)
+		if err != nil {
+			return nil, err
+		}
+		data[i] = v
+	}
+	return data, nil
+}
+
+// SummonerMasteriesCall is a builder for "SummonerMasteries"
+type SummonerMasteriesCall struct {
+	ctx        context.Context
+	client     *Client
+	query      url.Values
+	pathParams map[string]string
+	region     Region
+}
+
+var summonerMasteriesInfo = &OperationInfo{
+	Name:     "SummonerMasteries",
+	Resource: "summoner",
+	Version:  "v1.4",
+	Method:   "GET",
+	Path:     "/api/lol/{region}/v1.4/summoner/{summonerIds}/masteries",
+	DocURL:   "https://developer.riotgames.com/api/methods#!/1017/3450",
+	Regions:  []Region{BR, EUNE, EUW, KR, LAN, LAS, NA, OCE, RU, TR},
+	Errors: []OperationError{
+		{400, "Bad request"},
+		{401, "Unauthorized"},
+		{404, "No summoner data found for any specified inputs"},
+		{429, "Rate limit exceeded"},
+		{500, "Internal server error"},
+		{503, "Service unavailable"},
+	},
+}
+
+// Info returns information of the operation.
+func (c *SummonerMasteriesCall) Info() *OperationInfo { return summonerMasteriesInfo }
+
+// Get mastery pages mapped by summoner ID for a given list of summoner IDs
+//
+//
+// GET: /api/lol/{region}/v1.4/summoner/{summonerIds}/masteries
+//
+// Reference: https://developer.riotgames.com/api/methods#!/1017/3450
+func (c *Client) SummonerMasteries(ctx context.Context, region Region, summonerIDs []int64) *SummonerMasteriesCall {
+	path := make(map[string]string)
+	path["summonerIds"] = convertToString(summonerIDs)
+	return &SummonerMasteriesCall{ctx: ctx, client: c, query: make(url.Values), pathParams: path, region: region}
+}
+
+func (c *SummonerMasteriesCall) doRequest() (*http.Response, error) {
+	var body io.Reader
+	switch c.region {
+	case BR, EUNE, EUW, KR, LAN, LAS, NA, OCE, RU, TR:
+	default:
+		return nil, ErrNotSupportedRegion
+	}
+	c.query.Set("api_key", c.client.apiKey)
+	c.pathParams["region"] = c.region.Name()
+
+	path, err := uritemplates.Expand("/api/lol/{region}/v1.4/summoner/{summonerIds}/masteries", c.pathParams)
+	if err != nil {
+		return nil, err
+	}
+	urls := c.region.baseURL() + path + "?" + c.query.Encode()
+
+	return c.client.doRequest(c.ctx, summonerMasteriesInfo, urls, body)
+}
+
+// Do executes api request.
+//
+// API Errors:
+//  400 - Bad request
+//  401 - Unauthorized
+//  404 - No summoner data found for any specified inputs
+//  429 - Rate limit exceeded
+//  500 - Internal server error
+//  503 - Service unavailable
+func (c *SummonerMasteriesCall) Do() (map[int64]*MasteryPages, error) {
+	res, err := c.doRequest()
+	if err != nil {
+		return nil, err
+	}
+	defer closeBody(res)
+	if err := verifyAPIResponse(res); err != nil {
+		return nil, err
+	}
+	ret := make(map[string]*MasteryPages)
+	if err := json.NewDecoder(res.Body).Decode(&ret); err != nil {
+		return nil, err
+	}
+	data := make(map[int64]*MasteryPages)
+	for k, v := range ret {
+		i, err := strconv.ParseInt(k, 10, 64)
+		if err != nil {
+			return nil, err
+		}
+		data[i] = v
+	}
+	return data, nil
+}
+
+// SummonerNamesCall is a builder for "SummonerNames"
+type SummonerNamesCall struct {
+	ctx        context.Context
+	client     *Client
+	query      url.Values
+	pathParams map[string]string
+	region     Region
+}
+
+var summonerNamesInfo = &OperationInfo{
+	Name:     "SummonerNames",
+	Resource: "summoner",
+	Version:  "v1.4",
+	Method:   "GET",
+	Path:     "/api/lol/{region}/v1.4/summoner/{summonerIds}/name",
+	DocURL:   "https://developer.riotgames.com/api/methods#!/1017/3451",
+	Regions:  []Region{BR, EUNE, EUW, KR, LAN, LAS, NA, OCE, RU, TR},
+	Errors: []OperationError{
+		{400, "Bad request"},
+		{401, "Unauthorized"},
+		{404, "No summoner data found for any specified inputs"},
+		{429, "Rate limit exceeded"},
+		{500, "Internal server error"},
+		{503, "Service unavailable"},
+	},
+}
+
+// Info returns information of the operation.
+func (c *SummonerNamesCall) Info() *OperationInfo { return summonerNamesInfo }
+
+// Get summoner names mapped by summoner ID for a given list of summoner IDs.
+//
+//
+// GET: /api/lol/{region}/v1.4/summoner/{summonerIds}/name
+//
+// Reference: https://developer.riotgames.com/api/methods#!/1017/3451
+func (c *Client) SummonerNames(ctx context.Context, region Region, summonerIDs []int64) *SummonerNamesCall {
+	path := make(map[string]string)
+	path["summonerIds"] = convertToString(summonerIDs)
+	return &SummonerNamesCall{ctx: ctx, client: c, query: make(url.Values), pathParams: path, region: region}
+}
+
+func (c *SummonerNamesCall) doRequest() (*http.Response, error) {
+	var body io.Reader
+	switch c.region {
+	case BR, EUNE, EUW, KR, LAN, LAS, NA, OCE, RU, TR:
+	default:
+		return nil, ErrNotSupportedRegion
+	}
+	c.query.Set("api_key", c.client.apiKey)
+	c.pathParams["region"] = c.region.Name()
+
+	path, err := uritemplates.Expand("/api/lol/{region}/v1.4/summoner/{summonerIds}/name", c.pathParams)
+	if err != nil {
+		return nil, err
+	}
+	urls := c.region.baseURL() + path + "?" + c.query.Encode()
+
+	return c.client.doRequest(c.ctx, summonerNamesInfo, urls, body)
+}
+
+// Do executes api request.
+//
+// API Errors:
+//  400 - Bad request
+//  401 - Unauthorized
+//  404 - No summoner data found for any specified inputs
+//  429 - Rate limit exceeded
+//  500 - Internal server error
+//  503 - Service unavailable
+func (c *SummonerNamesCall) Do() (map[int64]string, error) {
+	res, err := c.doRequest()
+	if err != nil {
+		return nil, err
+	}
+	defer closeBody(res)
+	if err := verifyAPIResponse(res); err != nil {
+		return nil, err
+	}
+	ret := make(map[string]string)
+	if err := json.NewDecoder(res.Body).Decode(&ret); err != nil {
+		return nil, err
+	}
+	data := make(map[int64]string)
+	for k, v := range ret {
+		i, err := strconv.ParseInt(k, 10, 64)
+		if err != nil {
+			return nil, err
+		}
+		data[i] = v
+	}
+	return data, nil
+}
+
+// SummonerRunesCall is a builder for "SummonerRunes"
+type SummonerRunesCall struct {
+	ctx        context.Context
+	client     *Client
+	query      url.Values
+	pathParams map[string]string
+	region     Region
+}
+
+var summonerRunesInfo = &OperationInfo{
+	Name:     "SummonerRunes",
+	Resource: "summoner",
+	Version:  "v1.4",
+	Method:   "GET",
+	Path:     "/api/lol/{region}/v1.4/summoner/{summonerIds}/runes",
+	DocURL:   "https://developer.riotgames.com/api/methods#!/1017/3449",
+	Regions:  []Region{BR, EUNE, EUW, KR, LAN, LAS, NA, OCE, RU, TR},
+	Errors: []OperationError{
+		{400, "Bad request"},
+		{401, "Unauthorized"},
+		{404, "No summoner data found for any specified inputs"},
+		{429, "Rate limit exceeded"},
+		{500, "Internal server error"},
+		{503, "Service unavailable"},
+	},
+}
+
+// Info returns information of the operation.
+func (c *SummonerRunesCall) Info() *OperationInfo { return summonerRunesInfo }
+
+// Get rune pages mapped by summoner ID for a given list of summoner IDs.
+//
+//
+// GET: /api/lol/{region}/v1.4/summoner/{summonerIds}/runes
+//
+// Reference: https://developer.riotgames.com/api/methods#!/1017/3449
+func (c *Client) SummonerRunes(ctx context.Context, region Region, summonerIDs []int64) *SummonerRunesCall {
+	path := make(map[string]string)
+	path["summonerIds"] = convertToString(summonerIDs)
+	return &SummonerRunesCall{ctx: ctx, client: c, query: make(url.Values), pathParams: path, region: region}
+}
+
+func (c *SummonerRunesCall) doRequest() (*http.Response, error) {
+	var body io.Reader
+	switch c.region {
+	case BR, EUNE, EUW, KR, LAN, LAS, NA, OCE, RU, TR:
+	default:
+		return nil, ErrNotSupportedRegion
+	}
+	c.query.Set("api_key", c.client.apiKey)
+	c.pathParams["region"] = c.region.Name()
+
+	path, err := uritemplates.Expand("/api/lol/{region}/v1.4/summoner/{summonerIds}/runes", c.pathParams)
+	if err != nil {
+		return nil, err
+	}
+	urls := c.region.baseURL() + path + "?" + c.query.Encode()
+
+	return c.client.doRequest(c.ctx, summonerRunesInfo, urls, body)
+}
+
+// Do executes api request.
+//
+// API Errors:
+//  400 - Bad request
+//  401 - Unauthorized
+//  404 - No summoner data found for any specified inputs
+//  429 - Rate limit exceeded
+//  500 - Internal server error
+//  503 - Service unavailable
+func (c *SummonerRunesCall) Do() (map[int64]*RunePages, error) {
+	res, err := c.doRequest()
+	if err != nil {
+		return nil, err
+	}
+	defer closeBody(res)
+	if err := verifyAPIResponse(res); err != nil {
+		return nil, err
+	}
+	ret := make(map[string]*RunePages)
+	if err := json.NewDecoder(res.Body).Decode(&ret); err != nil {
+		return nil, err
+	}
+	data := make(map[int64]*RunePages)
+	for k, v := range ret {
+		i, err := strconv.ParseInt(k, 10, 64)
+		if err != nil {
+			return nil, err
+		}
+		data[i] = v
+	}
+	return data, nil
+}
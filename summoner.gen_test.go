@@ -0,0 +1,66 @@
+// Generated by go-lol-generator. DO NOT EDIT.
+
+package lol
+
+import "testing"
+import "golang.org/x/net/context"
+
+func TestSummonerOperations(t *testing.T) {
+	runOperationTests(t, []operationTest{
+		{
+			name:        "SummonersByName",
+			region:      NA,
+			unsupported: Global,
+			path:        "/api/lol/na/v1.4/summoner/by-name/example",
+			apiKey:      true,
+			fixture:     "{\"1\":{\"id\":1,\"name\":\"example\",\"profileIconId\":1,\"revisionDate\":1,\"summonerLevel\":1}}",
+			do: func(c *Client, region Region) (interface{}, error) {
+				return c.SummonersByName(context.TODO(), region, []string{"example"}).Do()
+			},
+		},
+		{
+			name:        "Summoners",
+			region:      NA,
+			unsupported: Global,
+			path:        "/api/lol/na/v1.4/summoner/1",
+			apiKey:      true,
+			fixture:     "{\"1\":{\"id\":1,\"name\":\"example\",\"profileIconId\":1,\"revisionDate\":1,\"summonerLevel\":1}}",
+			do: func(c *Client, region Region) (interface{}, error) {
+				return c.Summoners(context.TODO(), region, []int64{1}).Do()
+			},
+		},
+		{
+			name:        "SummonerMasteries",
+			region:      NA,
+			unsupported: Global,
+			path:        "/api/lol/na/v1.4/summoner/1/masteries",
+			apiKey:      true,
+			fixture:     "{\"1\":{\"pages\":[{\"current\":true,\"id\":1,\"masteries\":[{\"id\":1,\"rank\":1}],\"name\":\"example\"}],\"summonerId\":1}}",
+			do: func(c *Client, region Region) (interface{}, error) {
+				return c.SummonerMasteries(context.TODO(), region, []int64{1}).Do()
+			},
+		},
+		{
+			name:        "SummonerNames",
+			region:      NA,
+			unsupported: Global,
+			path:        "/api/lol/na/v1.4/summoner/1/name",
+			apiKey:      true,
+			fixture:     "{\"1\":\"example\"}",
+			do: func(c *Client, region Region) (interface{}, error) {
+				return c.SummonerNames(context.TODO(), region, []int64{1}).Do()
+			},
+		},
+		{
+			name:        "SummonerRunes",
+			region:      NA,
+			unsupported: Global,
+			path:        "/api/lol/na/v1.4/summoner/1/runes",
+			apiKey:      true,
+			fixture:     "{\"1\":{\"pages\":[{\"current\":true,\"id\":1,\"name\":\"example\",\"slots\":[{\"runeId\":1,\"runeSlotId\":1}]}],\"summonerId\":1}}",
+			do: func(c *Client, region Region) (interface{}, error) {
+				return c.SummonerRunes(context.TODO(), region, []int64{1}).Do()
+			},
+		},
+	})
+}
@@ -0,0 +1,266 @@
+// Generated by go-lol-generator. DO NOT EDIT.
+
+package lol
+
+import "encoding/json"
+import "io"
+import "strconv"
+import "net/http"
+import "net/url"
+import "golang.org/x/net/context"
+import "github.com/go-lol/lol/uritemplates"
+
+// MatchHistorySummaryDto - This object contains match history summary information.
+//
+// resource: "team", original name: "MatchHistorySummaryDto"
+type MatchHistorySummary struct {
+	Assists int32 `json:"assists,omitempty"`
+	// Date that match was completed specified as epoch milliseconds.
+	Date              EpochMillis `json:"date,omitempty"`
+	Deaths            int32       `json:"deaths,omitempty"`
+	GameID            int64       `json:"gameId,omitempty"`
+	GameMode          string      `json:"gameMode,omitempty"`
+	Invalid           bool        `json:"invalid,omitempty"`
+	Kills             int32       `json:"kills,omitempty"`
+	MapID             int32       `json:"mapId,omitempty"`
+	OpposingTeamKills int32       `json:"opposingTeamKills,omitempty"`
+	OpposingTeamName  string      `json:"opposingTeamName,omitempty"`
+	Win               bool        `json:"win,omitempty"`
+}
+
+// TeamDto - This object contains team information.
+//
+// resource: "team", original name: "TeamDto"
+type RankTeam struct {
+	// Date that team was created specified as epoch milliseconds.
+	CreateDate EpochMillis `json:"createDate,omitempty"`
+	FullID     string      `json:"fullId,omitempty"`
+	// Date that last game played by team ended specified as epoch milliseconds.
+	LastGameDate EpochMillis `json:"lastGameDate,omitempty"`
+	// Date that last member joined specified as epoch milliseconds.
+	LastJoinDate EpochMillis `json:"lastJoinDate,omitempty"`
+	// Date that team last joined the ranked team queue specified as epoch milliseconds.
+	LastJoinedRankedTeamQueueDate EpochMillis            `json:"lastJoinedRankedTeamQueueDate,omitempty"`
+	MatchHistory                  []*MatchHistorySummary `json:"matchHistory,omitempty"`
+	// Date that team was last modified specified as epoch milliseconds.
+	ModifyDate EpochMillis `json:"modifyDate,omitempty"`
+	Name       string      `json:"name,omitempty"`
+	Roster     *Roster     `json:"roster,omitempty"`
+	// Date that second to last member joined specified as epoch milliseconds.
+	SecondLastJoinDate EpochMillis           `json:"secondLastJoinDate,omitempty"`
+	Status             string                `json:"status,omitempty"`
+	Tag                string                `json:"tag,omitempty"`
+	TeamStatDetails    []*RankTeamStatDetail `json:"teamStatDetails,omitempty"`
+	// Date that third to last member joined specified as epoch milliseconds.
+	ThirdLastJoinDate EpochMillis `json:"thirdLastJoinDate,omitempty"`
+}
+
+// TeamMemberInfoDto - This object contains team member information.
+//
+// resource: "team", original name: "TeamMemberInfoDto"
+type RankTeamMemberInfo struct {
+	// Date that team member was invited to team specified as epoch milliseconds.
+	InviteDate EpochMillis `json:"inviteDate,omitempty"`
+	// Date that team member joined team specified as epoch milliseconds.
+	JoinDate EpochMillis `json:"joinDate,omitempty"`
+	PlayerID int64       `json:"playerId,omitempty"`
+	Status   string      `json:"status,omitempty"`
+}
+
+// TeamStatDetailDto - This object contains team statistics detail information.
+//
+// resource: "team", original name: "TeamStatDetailDto"
+type RankTeamStatDetail struct {
+	AverageGamesPlayed int32  `json:"averageGamesPlayed,omitempty"`
+	Losses             int32  `json:"losses,omitempty"`
+	TeamStatType       string `json:"teamStatType,omitempty"`
+	Wins               int32  `json:"wins,omitempty"`
+}
+
+// RosterDto - This object contains roster information.
+//
+// resource: "team", original name: "RosterDto"
+type Roster struct {
+	MemberList []*RankTeamMemberInfo `json:"memberList,omitempty"`
+	OwnerID    int64                 `json:"ownerId,omitempty"`
+}
+
+// TeamsBySummonerIDCall is a builder for "TeamsBySummonerID"
+type TeamsBySummonerIDCall struct {
+	ctx        context.Context
+	client     *Client
+	query      url.Values
+	pathParams map[string]string
+	region     Region
+}
+
+var teamsBySummonerIDInfo = &OperationInfo{
+	Name:     "TeamsBySummonerID",
+	Resource: "team",
+	Version:  "v2.4",
+	Method:   "GET",
+	Path:     "/api/lol/{region}/v2.4/team/by-summoner/{summonerIds}",
+	DocURL:   "https://developer.riotgames.com/api/methods#!/986/3358",
+	Regions:  []Region{BR, EUNE, EUW, KR, LAN, LAS, NA, OCE, RU, TR},
+	Errors: []OperationError{
+		{400, "Bad request"},
+		{401, "Unauthorized"},
+		{404, "Team not found"},
+		{429, "Rate limit exceeded"},
+		{500, "Internal server error"},
+		{503, "Service unavailable"},
+	},
+}
+
+// Info returns information of the operation.
+func (c *TeamsBySummonerIDCall) Info() *OperationInfo { return teamsBySummonerIDInfo }
+
+// Get teams mapped by summoner ID for a given list of summoner IDs.
+//
+//
+// GET: /api/lol/{region}/v2.4/team/by-summoner/{summonerIds}
+//
+// Reference: https://developer.riotgames.com/api/methods#!/986/3358
+func (c *Client) TeamsBySummonerID(ctx context.Context, region Region, summonerIDs []int64) *TeamsBySummonerIDCall {
+	path := make(map[string]string)
+	path["summonerIds"] = convertToString(summonerIDs)
+	return &TeamsBySummonerIDCall{ctx: ctx, client: c, query: make(url.Values), pathParams: path, region: region}
+}
+
+func (c *TeamsBySummonerIDCall) doRequest() (*http.Response, error) {
+	var body io.Reader
+	switch c.region {
+	case BR, EUNE, EUW, KR, LAN, LAS, NA, OCE, RU, TR:
+	default:
+		return nil, ErrNotSupportedRegion
+	}
+	c.query.Set("api_key", c.client.apiKey)
+	c.pathParams["region"] = c.region.Name()
+
+	path, err := uritemplates.Expand("/api/lol/{region}/v2.4/team/by-summoner/{summonerIds}", c.pathParams)
+	if err != nil {
+		return nil, err
+	}
+	urls := c.region.baseURL() + path + "?" + c.query.Encode()
+
+	return c.client.doRequest(c.ctx, teamsBySummonerIDInfo, urls, body)
+}
+
+// Do executes api request.
+//
+// API Errors:
+//  400 - Bad request
+//  401 - Unauthorized
+//  404 - Team not found
+//  429 - Rate limit exceeded
+//  500 - Internal server error
+//  503 - Service unavailable
+func (c *TeamsBySummonerIDCall) Do() (map[int64][]*RankTeam, error) {
+	res, err := c.doRequest()
+	if err != nil {
+		return nil, err
+	}
+	defer closeBody(res)
+	if err := verifyAPIResponse(res); err != nil {
+		return nil, err
+	}
+	ret := make(map[string][]*RankTeam)
+	if err := json.NewDecoder(res.Body).Decode(&ret); err != nil {
+		return nil, err
+	}
+	data := make(map[int64][]*RankTeam)
+	for k, v := range ret {
+		i, err := strconv.ParseInt(k, 10, 64)
+		if err != nil {
+			return nil, err
+		}
+		data[i] = v
+	}
+	return data, nil
+}
+
+// TeamsCall is a builder for "Teams"
+type TeamsCall struct {
+	ctx        context.Context
+	client     *Client
+	query      url.Values
+	pathParams map[string]string
+	region     Region
+}
+
+var teamsInfo = &OperationInfo{
+	Name:     "Teams",
+	Resource: "team",
+	Version:  "v2.4",
+	Method:   "GET",
+	Path:     "/api/lol/{region}/v2.4/team/{teamIds}",
+	DocURL:   "https://developer.riotgames.com/api/methods#!/986/3357",
+	Regions:  []Region{BR, EUNE, EUW, KR, LAN, LAS, NA, OCE, RU, TR},
+	Errors: []OperationError{
+		{400, "Bad request"},
+		{401, "Unauthorized"},
+		{404, "Team not found"},
+		{429, "Rate limit exceeded"},
+		{500, "Internal server error"},
+		{503, "Service unavailable"},
+	},
+}
+
+// Info returns information of the operation.
+func (c *TeamsCall) Info() *OperationInfo { return teamsInfo }
+
+// Get teams mapped by team ID for a given list of team IDs.
+//
+//
+// GET: /api/lol/{region}/v2.4/team/{teamIds}
+//
+// Reference: https://developer.riotgames.com/api/methods#!/986/3357
+func (c *Client) Teams(ctx context.Context, region Region, teamIDs []string) *TeamsCall {
+	path := make(map[string]string)
+	path["teamIds"] = convertToString(teamIDs)
+	return &TeamsCall{ctx: ctx, client: c, query: make(url.Values), pathParams: path, region: region}
+}
+
+func (c *TeamsCall) doRequest() (*http.Response, error) {
+	var body io.Reader
+	switch c.region {
+	case BR, EUNE, EUW, KR, LAN, LAS, NA, OCE, RU, TR:
+	default:
+		return nil, ErrNotSupportedRegion
+	}
+	c.query.Set("api_key", c.client.apiKey)
+	c.pathParams["region"] = c.region.Name()
+
+	path, err := uritemplates.Expand("/api/lol/{region}/v2.4/team/{teamIds}", c.pathParams)
+	if err != nil {
+		return nil, err
+	}
+	urls := c.region.baseURL() + path + "?" + c.query.Encode()
+
+	return c.client.doRequest(c.ctx, teamsInfo, urls, body)
+}
+
+// Do executes api request.
+//
+// API Errors:
+//  400 - Bad request
+//  401 - Unauthorized
+//  404 - Team not found
+//  429 - Rate limit exceeded
+//  500 - Internal server error
+//  503 - Service unavailable
+func (c *TeamsCall) Do() (map[string]*RankTeam, error) {
+	res, err := c.doRequest()
+	if err != nil {
+		return nil, err
+	}
+	defer closeBody(res)
+	if err := verifyAPIResponse(res); err != nil {
+		return nil, err
+	}
+	ret := make(map[string]*RankTeam)
+	if err := json.NewDecoder(res.Body).Decode(&ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
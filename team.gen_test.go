@@ -0,0 +1,33 @@
+// Generated by go-lol-generator. DO NOT EDIT.
+
+package lol
+
+import "testing"
+import "golang.org/x/net/context"
+
+func TestTeamOperations(t *testing.T) {
+	runOperationTests(t, []operationTest{
+		{
+			name:        "TeamsBySummonerID",
+			region:      NA,
+			unsupported: Global,
+			path:        "/api/lol/na/v2.4/team/by-summoner/1",
+			apiKey:      true,
+			fixture:     "{\"1\":[{\"createDate\":1,\"fullId\":\"example\",\"lastGameDate\":1,\"lastJoinDate\":1,\"lastJoinedRankedTeamQueueDate\":1,\"matchHistory\":[{\"assists\":1,\"date\":1,\"deaths\":1,\"gameId\":1,\"gameMode\":\"example\",\"invalid\":true,\"kills\":1,\"mapId\":1,\"opposingTeamKills\":1,\"opposingTeamName\":\"example\",\"win\":true}],\"modifyDate\":1,\"name\":\"example\",\"roster\":{\"memberList\":[{\"inviteDate\":1,\"joinDate\":1,\"playerId\":1,\"status\":\"example\"}],\"ownerId\":1},\"secondLastJoinDate\":1,\"status\":\"example\",\"tag\":\"example\",\"teamStatDetails\":[{\"averageGamesPlayed\":1,\"losses\":1,\"teamStatType\":\"example\",\"wins\":1}],\"thirdLastJoinDate\":1}]}",
+			do: func(c *Client, region Region) (interface{}, error) {
+				return c.TeamsBySummonerID(context.TODO(), region, []int64{1}).Do()
+			},
+		},
+		{
+			name:        "Teams",
+			region:      NA,
+			unsupported: Global,
+			path:        "/api/lol/na/v2.4/team/example",
+			apiKey:      true,
+			fixture:     "{\"1\":{\"createDate\":1,\"fullId\":\"example\",\"lastGameDate\":1,\"lastJoinDate\":1,\"lastJoinedRankedTeamQueueDate\":1,\"matchHistory\":[{\"assists\":1,\"date\":1,\"deaths\":1,\"gameId\":1,\"gameMode\":\"example\",\"invalid\":true,\"kills\":1,\"mapId\":1,\"opposingTeamKills\":1,\"opposingTeamName\":\"example\",\"win\":true}],\"modifyDate\":1,\"name\":\"example\",\"roster\":{\"memberList\":[{\"inviteDate\":1,\"joinDate\":1,\"playerId\":1,\"status\":\"example\"}],\"ownerId\":1},\"secondLastJoinDate\":1,\"status\":\"example\",\"tag\":\"example\",\"teamStatDetails\":[{\"averageGamesPlayed\":1,\"losses\":1,\"teamStatType\":\"example\",\"wins\":1}],\"thirdLastJoinDate\":1}}",
+			do: func(c *Client, region Region) (interface{}, error) {
+				return c.Teams(context.TODO(), region, []string{"example"}).Do()
+			},
+		},
+	})
+}
@@ -0,0 +1,191 @@
+// Package timeline provides typed events of a match timeline.
+package timeline
+
+import (
+	"time"
+
+	"github.com/go-lol/lol"
+)
+
+// Event types of lol.Event.EventType
+const (
+	TypeAscended         = "ASCENDED_EVENT"
+	TypeBuildingKill     = "BUILDING_KILL"
+	TypeCapturePoint     = "CAPTURE_POINT"
+	TypeChampionKill     = "CHAMPION_KILL"
+	TypeEliteMonsterKill = "ELITE_MONSTER_KILL"
+	TypeItemDestroyed    = "ITEM_DESTROYED"
+	TypeItemPurchased    = "ITEM_PURCHASED"
+	TypeItemSold         = "ITEM_SOLD"
+	TypeItemUndo         = "ITEM_UNDO"
+	TypePoroKingSummon   = "PORO_KING_SUMMON"
+	TypeSkillLevelUp     = "SKILL_LEVEL_UP"
+	TypeWardKill         = "WARD_KILL"
+	TypeWardPlaced       = "WARD_PLACED"
+)
+
+// Event is one of typed events like *ChampionKill.
+type Event interface {
+	// Raw returns the original event.
+	Raw() *lol.Event
+	// Time returns time since the game started.
+	Time() time.Duration
+
+	accept(h Handler)
+}
+
+// Base is embedded in all typed events.
+type Base struct {
+	raw *lol.Event
+}
+
+// Raw returns the original event.
+func (b Base) Raw() *lol.Event { return b.raw }
+
+// Time returns time since the game started.
+func (b Base) Time() time.Duration { return b.raw.Timestamp.Duration() }
+
+// ChampionKill is a CHAMPION_KILL event. KillerID is 0 if killed by minions, towers or monsters.
+type ChampionKill struct {
+	Base
+	KillerID, VictimID int32
+	Assists            []int32
+	Position           *lol.Position
+}
+
+// BuildingKill is a BUILDING_KILL event.
+// TeamID is the team of the building.
+type BuildingKill struct {
+	Base
+	KillerID, TeamID int32
+	Assists          []int32
+	// e.g. "TOWER_BUILDING", "INHIBITOR_BUILDING"
+	BuildingType string
+	// e.g. "MID_LANE"
+	LaneType string
+	// e.g. "OUTER_TURRET", only for towers.
+	TowerType string
+	Position  *lol.Position
+}
+
+// EliteMonsterKill is a ELITE_MONSTER_KILL event.
+type EliteMonsterKill struct {
+	Base
+	KillerID int32
+	// e.g. "DRAGON", "BARON_NASHOR"
+	MonsterType string
+	Position    *lol.Position
+}
+
+// ItemPurchased is a ITEM_PURCHASED event.
+type ItemPurchased struct {
+	Base
+	ParticipantID, ItemID int32
+}
+
+// ItemSold is a ITEM_SOLD event.
+type ItemSold struct {
+	Base
+	ParticipantID, ItemID int32
+}
+
+// ItemDestroyed is a ITEM_DESTROYED event.
+// It is sent for components combined into an item, and consumed items.
+type ItemDestroyed struct {
+	Base
+	ParticipantID, ItemID int32
+}
+
+// ItemUndo is a ITEM_UNDO event.
+// ItemBefore is the undone purchase, ItemAfter is the undone sale. (0 if none)
+type ItemUndo struct {
+	Base
+	ParticipantID         int32
+	ItemBefore, ItemAfter int32
+}
+
+// WardPlaced is a WARD_PLACED event.
+type WardPlaced struct {
+	Base
+	CreatorID int32
+	// e.g. "YELLOW_TRINKET", "SIGHT_WARD"
+	WardType string
+}
+
+// WardKill is a WARD_KILL event.
+type WardKill struct {
+	Base
+	KillerID int32
+	WardType string
+}
+
+// SkillLevelUp is a SKILL_LEVEL_UP event.
+type SkillLevelUp struct {
+	Base
+	ParticipantID int32
+	// 1-4 for Q, W, E and R.
+	SkillSlot int32
+	// e.g. "NORMAL", "EVOLVE"
+	LevelUpType string
+}
+
+// Ascended is a ASCENDED_EVENT event of Ascension.
+type Ascended struct {
+	Base
+	KillerID, VictimID int32
+	// e.g. "CHAMPION_ASCENDED"
+	AscendedType string
+}
+
+// CapturePoint is a CAPTURE_POINT event of Dominion and Ascension.
+type CapturePoint struct {
+	Base
+	ParticipantID, TeamID int32
+	// e.g. "POINT_A"
+	PointCaptured string
+}
+
+// PoroKingSummon is a PORO_KING_SUMMON event of Legend of the Poro King.
+type PoroKingSummon struct {
+	Base
+	TeamID int32
+}
+
+// Unknown is an event with unknown type.
+type Unknown struct {
+	Base
+}
+
+// Parse returns a typed event. Absent fields are zero.
+func Parse(e *lol.Event) Event {
+	b := Base{raw: e}
+	switch e.EventType {
+	case TypeChampionKill:
+		return &ChampionKill{b, e.KillerID, e.VictimID, e.AssistingParticipantIds, e.Position}
+	case TypeBuildingKill:
+		return &BuildingKill{b, e.KillerID, e.TeamID, e.AssistingParticipantIds, e.BuildingType, e.LaneType, e.TowerType, e.Position}
+	case TypeEliteMonsterKill:
+		return &EliteMonsterKill{b, e.KillerID, e.MonsterType, e.Position}
+	case TypeItemPurchased:
+		return &ItemPurchased{b, e.ParticipantID, e.ItemID}
+	case TypeItemSold:
+		return &ItemSold{b, e.ParticipantID, e.ItemID}
+	case TypeItemDestroyed:
+		return &ItemDestroyed{b, e.ParticipantID, e.ItemID}
+	case TypeItemUndo:
+		return &ItemUndo{b, e.ParticipantID, e.ItemBefore, e.ItemAfter}
+	case TypeWardPlaced:
+		return &WardPlaced{b, e.CreatorID, e.WardType}
+	case TypeWardKill:
+		return &WardKill{b, e.KillerID, e.WardType}
+	case TypeSkillLevelUp:
+		return &SkillLevelUp{b, e.ParticipantID, e.SkillSlot, e.LevelUpType}
+	case TypeAscended:
+		return &Ascended{b, e.KillerID, e.VictimID, e.AscendedType}
+	case TypeCapturePoint:
+		return &CapturePoint{b, e.ParticipantID, e.TeamID, e.PointCaptured}
+	case TypePoroKingSummon:
+		return &PoroKingSummon{b, e.TeamID}
+	}
+	return &Unknown{b}
+}
@@ -0,0 +1,87 @@
+package timeline
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/go-lol/lol"
+)
+
+// newTestMatch returns a match of participant 1 (team 100) and 2 (team 200).
+func newTestMatch() *lol.MatchDetail {
+	return &lol.MatchDetail{
+		Participants: []*lol.MatchParticipant{
+			{ParticipantID: 1, TeamID: 100, Stats: &lol.ParticipantStats{Item0: 1055, Item1: 3340}},
+			{ParticipantID: 2, TeamID: 200, Stats: &lol.ParticipantStats{Item0: 1056, Item6: 3340}},
+		},
+		Timeline: &lol.Timeline{
+			FrameInterval: 60000,
+			Frames: []*lol.Frame{
+				{Timestamp: 0, Events: []*lol.Event{
+					{EventType: TypeItemPurchased, Timestamp: 1200, ParticipantID: 1, ItemID: 1055},
+					{EventType: TypeItemPurchased, Timestamp: 1000, ParticipantID: 1, ItemID: 1001},
+					{EventType: TypeItemUndo, Timestamp: 1100, ParticipantID: 1, ItemBefore: 1001},
+					{EventType: TypeItemPurchased, Timestamp: 1300, ParticipantID: 1, ItemID: 3340},
+					{EventType: TypeItemPurchased, Timestamp: 1300, ParticipantID: 2, ItemID: 1056},
+					{EventType: TypeItemPurchased, Timestamp: 1400, ParticipantID: 2, ItemID: 2003},
+					{EventType: TypeItemPurchased, Timestamp: 1500, ParticipantID: 2, ItemID: 3340},
+				}},
+				{Timestamp: 60000, Events: []*lol.Event{
+					{EventType: TypeWardPlaced, Timestamp: 70000, CreatorID: 1, WardType: "YELLOW_TRINKET"},
+					{EventType: TypeChampionKill, Timestamp: 90000, KillerID: 1, VictimID: 2,
+						Position: &lol.Position{X: 7000, Y: 7000}},
+					{EventType: TypeItemDestroyed, Timestamp: 95000, ParticipantID: 2, ItemID: 2003},
+					{EventType: "NEW_EVENT", Timestamp: 100000},
+				}},
+			},
+		},
+	}
+}
+
+type recorder struct {
+	NopHandler
+	types []string
+	kills []*ChampionKill
+}
+
+func (r *recorder) ChampionKill(e *ChampionKill) {
+	r.kills = append(r.kills, e)
+	r.types = append(r.types, e.Raw().EventType)
+}
+func (r *recorder) ItemPurchased(e *ItemPurchased) { r.types = append(r.types, e.Raw().EventType) }
+func (r *recorder) ItemUndo(e *ItemUndo)           { r.types = append(r.types, e.Raw().EventType) }
+func (r *recorder) Unknown(e *Unknown)             { r.types = append(r.types, e.Raw().EventType) }
+
+func TestWalk(t *testing.T) {
+	r := &recorder{}
+	if err := Walk(newTestMatch(), r); err != nil {
+		t.Fatal(err)
+		return
+	}
+
+	want := []string{
+		TypeItemPurchased, TypeItemUndo, TypeItemPurchased, TypeItemPurchased,
+		TypeItemPurchased, TypeItemPurchased, TypeItemPurchased, TypeChampionKill, "NEW_EVENT",
+	}
+	if !reflect.DeepEqual(r.types, want) {
+		t.Errorf("Expected %v, got %v", want, r.types)
+	}
+	if len(r.kills) != 1 || r.kills[0].KillerID != 1 || r.kills[0].VictimID != 2 || r.kills[0].Time().Seconds() != 90 {
+		t.Errorf("Invalid kills %v", r.kills)
+	}
+
+	if err := Walk(&lol.MatchDetail{}, r); err != ErrNoTimeline {
+		t.Errorf("Expected ErrNoTimeline, got %v", err)
+	}
+}
+
+func TestEvents(t *testing.T) {
+	events := Events(newTestMatch().Timeline)
+	if e, ok := events[0].(*ItemPurchased); !ok || e.ItemID != 1001 {
+		t.Fatalf("Expected the first purchase, got %#v", events[0])
+		return
+	}
+	if e, ok := events[3].(*ItemPurchased); !ok || e.ParticipantID != 1 {
+		t.Errorf("Expected the original order for the same timestamp, got %#v", events[3])
+	}
+}
@@ -0,0 +1,94 @@
+package timeline
+
+import (
+	"errors"
+	"sort"
+
+	"github.com/go-lol/lol"
+)
+
+// ErrNoTimeline is returned if a match has no timeline.
+// Use Match(...).IncludeTimeline(true) to fetch it.
+var ErrNoTimeline = errors.New("timeline: match has no timeline")
+
+// Handler is called for each typed event by Walk.
+// Embed NopHandler to handle some of them.
+type Handler interface {
+	ChampionKill(e *ChampionKill)
+	BuildingKill(e *BuildingKill)
+	EliteMonsterKill(e *EliteMonsterKill)
+	ItemPurchased(e *ItemPurchased)
+	ItemSold(e *ItemSold)
+	ItemDestroyed(e *ItemDestroyed)
+	ItemUndo(e *ItemUndo)
+	WardPlaced(e *WardPlaced)
+	WardKill(e *WardKill)
+	SkillLevelUp(e *SkillLevelUp)
+	Ascended(e *Ascended)
+	CapturePoint(e *CapturePoint)
+	PoroKingSummon(e *PoroKingSummon)
+	Unknown(e *Unknown)
+}
+
+// NopHandler ignores all events.
+type NopHandler struct{}
+
+func (NopHandler) ChampionKill(*ChampionKill)         {}
+func (NopHandler) BuildingKill(*BuildingKill)         {}
+func (NopHandler) EliteMonsterKill(*EliteMonsterKill) {}
+func (NopHandler) ItemPurchased(*ItemPurchased)       {}
+func (NopHandler) ItemSold(*ItemSold)                 {}
+func (NopHandler) ItemDestroyed(*ItemDestroyed)       {}
+func (NopHandler) ItemUndo(*ItemUndo)                 {}
+func (NopHandler) WardPlaced(*WardPlaced)             {}
+func (NopHandler) WardKill(*WardKill)                 {}
+func (NopHandler) SkillLevelUp(*SkillLevelUp)         {}
+func (NopHandler) Ascended(*Ascended)                 {}
+func (NopHandler) CapturePoint(*CapturePoint)         {}
+func (NopHandler) PoroKingSummon(*PoroKingSummon)     {}
+func (NopHandler) Unknown(*Unknown)                   {}
+
+func (e *ChampionKill) accept(h Handler)     { h.ChampionKill(e) }
+func (e *BuildingKill) accept(h Handler)     { h.BuildingKill(e) }
+func (e *EliteMonsterKill) accept(h Handler) { h.EliteMonsterKill(e) }
+func (e *ItemPurchased) accept(h Handler)    { h.ItemPurchased(e) }
+func (e *ItemSold) accept(h Handler)         { h.ItemSold(e) }
+func (e *ItemDestroyed) accept(h Handler)    { h.ItemDestroyed(e) }
+func (e *ItemUndo) accept(h Handler)         { h.ItemUndo(e) }
+func (e *WardPlaced) accept(h Handler)       { h.WardPlaced(e) }
+func (e *WardKill) accept(h Handler)         { h.WardKill(e) }
+func (e *SkillLevelUp) accept(h Handler)     { h.SkillLevelUp(e) }
+func (e *Ascended) accept(h Handler)         { h.Ascended(e) }
+func (e *CapturePoint) accept(h Handler)     { h.CapturePoint(e) }
+func (e *PoroKingSummon) accept(h Handler)   { h.PoroKingSummon(e) }
+func (e *Unknown) accept(h Handler)          { h.Unknown(e) }
+
+// Events returns typed events of all frames in chronological order.
+// Events with the same timestamp keep the original order.
+func Events(tl *lol.Timeline) []Event {
+	var events []Event
+	for _, f := range tl.Frames {
+		for _, e := range f.Events {
+			events = append(events, Parse(e))
+		}
+	}
+	sort.Stable(byTime(events))
+	return events
+}
+
+type byTime []Event
+
+func (es byTime) Len() int           { return len(es) }
+func (es byTime) Swap(i, j int)      { es[i], es[j] = es[j], es[i] }
+func (es byTime) Less(i, j int) bool { return es[i].Raw().Timestamp < es[j].Raw().Timestamp }
+
+// Walk calls h for each event of the match in chronological order.
+func Walk(m *lol.MatchDetail, h Handler) error {
+	if m.Timeline == nil {
+		return ErrNoTimeline
+	}
+	for _, e := range Events(m.Timeline) {
+		e.accept(h)
+	}
+	return nil
+}
@@ -0,0 +1,383 @@
+package timeline
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/go-lol/lol"
+)
+
+// TrinketSlot is the index of the trinket slot in Inventory. (ParticipantStats.Item6)
+const TrinketSlot = 6
+
+// Slot is an item slot.
+type Slot struct {
+	ItemID int32
+	// Number of stacked items. 0 if empty.
+	Count int32
+}
+
+// Inventory is six item slots and a trinket slot. (ParticipantStats.Item0..Item6)
+type Inventory [7]Slot
+
+// Items returns sorted ids of items in the inventory, once for each slot.
+func (inv Inventory) Items() []int32 {
+	var ids []int32
+	for _, s := range inv {
+		if s.Count != 0 {
+			ids = append(ids, s.ItemID)
+		}
+	}
+	sort.Sort(int32s(ids))
+	return ids
+}
+
+// add returns false if the inventory is full.
+func (inv *Inventory) add(id int32, trinket bool, maxStacks int32) bool {
+	if trinket {
+		inv[TrinketSlot] = Slot{id, 1}
+		return true
+	}
+	for i := 0; i < TrinketSlot; i++ {
+		if inv[i].ItemID == id && inv[i].Count != 0 && inv[i].Count < maxStacks {
+			inv[i].Count++
+			return true
+		}
+	}
+	for i := 0; i < TrinketSlot; i++ {
+		if inv[i].Count == 0 {
+			inv[i] = Slot{id, 1}
+			return true
+		}
+	}
+	return false
+}
+
+// remove returns false if the item is not in the inventory.
+func (inv *Inventory) remove(id int32) bool {
+	for i := len(inv) - 1; i >= 0; i-- {
+		if inv[i].ItemID == id && inv[i].Count != 0 {
+			if inv[i].Count--; inv[i].Count == 0 {
+				inv[i] = Slot{}
+			}
+			return true
+		}
+	}
+	return false
+}
+
+// InventoryOptions configures Replay.
+type InventoryOptions struct {
+	// IsTrinket returns true for items in the trinket slot.
+	// Default: known trinkets like 3340 (Warding Totem).
+	IsTrinket func(id int32) bool
+	// MaxStacks returns the number of items stacked in a slot. (lol.Item.Stacks)
+	// Default: 5 for Health Potion (2003), 1 for others.
+	MaxStacks func(id int32) int32
+}
+
+var knownTrinkets = map[int32]bool{3340: true, 3341: true, 3342: true, 3345: true, 3361: true, 3362: true, 3363: true, 3364: true}
+
+func isKnownTrinket(id int32) bool { return knownTrinkets[id] }
+
+func defaultMaxStacks(id int32) int32 {
+	if id == 2003 {
+		return 5
+	}
+	return 1
+}
+
+// InventoryState is an inventory after a change.
+type InventoryState struct {
+	Time      time.Duration
+	Inventory Inventory
+	// Items purchased while the inventory is full, in order.
+	// They take slots freed later, e.g. by components destroyed
+	// after the purchase of a completed item.
+	Overflow []int32
+}
+
+// Replay is inventories of participants reconstructed from
+// ITEM_PURCHASED, ITEM_SOLD, ITEM_DESTROYED and ITEM_UNDO events.
+type Replay struct {
+	NopHandler
+
+	opts    InventoryOptions
+	history map[int32][]InventoryState
+	// Purchases not undone.
+	purchases map[int32][]*ItemPurchased
+
+	// The last transaction of each participant, events with the same timestamp.
+	// Undo of a purchase in it restores items destroyed by the purchase.
+	transactions map[int32]*transaction
+}
+
+type transaction struct {
+	timestamp lol.Millis
+	purchases []*purchase
+	// Items destroyed after the last purchase.
+	destroyed []int32
+}
+
+// purchase is a purchase and items destroyed just before it, like components of the item.
+type purchase struct {
+	*ItemPurchased
+	destroyed []int32
+}
+
+// ReplayInventories reconstructs inventories of participants in the match.
+// opts may be nil.
+func ReplayInventories(m *lol.MatchDetail, opts *InventoryOptions) (*Replay, error) {
+	r := &Replay{
+		history:      make(map[int32][]InventoryState),
+		purchases:    make(map[int32][]*ItemPurchased),
+		transactions: make(map[int32]*transaction),
+	}
+	if opts != nil {
+		r.opts = *opts
+	}
+	if r.opts.IsTrinket == nil {
+		r.opts.IsTrinket = isKnownTrinket
+	}
+	if r.opts.MaxStacks == nil {
+		r.opts.MaxStacks = defaultMaxStacks
+	}
+
+	if err := Walk(m, r); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// At returns the inventory of a participant at t.
+func (r *Replay) At(participantID int32, t time.Duration) Inventory {
+	h := r.history[participantID]
+	i := sort.Search(len(h), func(i int) bool { return h[i].Time > t })
+	if i == 0 {
+		return Inventory{}
+	}
+	return h[i-1].Inventory
+}
+
+// Final returns the inventory of a participant at the end of the game.
+func (r *Replay) Final(participantID int32) Inventory {
+	return r.last(participantID).Inventory
+}
+
+func (r *Replay) last(participantID int32) InventoryState {
+	h := r.history[participantID]
+	if len(h) == 0 {
+		return InventoryState{}
+	}
+	return h[len(h)-1]
+}
+
+// History returns inventories of a participant after each change.
+func (r *Replay) History(participantID int32) []InventoryState {
+	return r.history[participantID]
+}
+
+// BuildOrder returns items purchased by a participant in order,
+// excluding undone purchases, trinkets and stacked items like potions.
+func (r *Replay) BuildOrder(participantID int32) []int32 {
+	var ids []int32
+	for _, p := range r.purchases[participantID] {
+		if !r.opts.IsTrinket(p.ItemID) && r.opts.MaxStacks(p.ItemID) <= 1 {
+			ids = append(ids, p.ItemID)
+		}
+	}
+	return ids
+}
+
+// current returns the current state, and the transaction at the event.
+func (r *Replay) current(participantID int32, e Event) (InventoryState, *transaction) {
+	state := r.last(participantID)
+	tx := r.transactions[participantID]
+	if ts := e.Raw().Timestamp; tx == nil || tx.timestamp != ts {
+		tx = &transaction{timestamp: ts}
+		r.transactions[participantID] = tx
+	}
+	return state, tx
+}
+
+func (r *Replay) record(participantID int32, e Event, state InventoryState) {
+	state.Time = e.Time()
+	r.history[participantID] = append(r.history[participantID], state)
+}
+
+// add adds an item to the inventory, or to the overflow if the inventory is full.
+func (r *Replay) add(state *InventoryState, id int32) {
+	if !state.Inventory.add(id, r.opts.IsTrinket(id), r.opts.MaxStacks(id)) {
+		state.Overflow = appendInt32s(state.Overflow, id)
+	}
+}
+
+// remove removes an item, and moves overflowed items to the freed slot.
+func (r *Replay) remove(state *InventoryState, id int32) {
+	if !state.Inventory.remove(id) {
+		for i, o := range state.Overflow {
+			if o == id {
+				state.Overflow = appendInt32s(state.Overflow[:i:i], state.Overflow[i+1:]...)
+				break
+			}
+		}
+		return
+	}
+
+	overflow := state.Overflow
+	state.Overflow = nil
+	for _, id := range overflow {
+		r.add(state, id)
+	}
+}
+
+// ItemPurchased implements Handler.
+func (r *Replay) ItemPurchased(e *ItemPurchased) {
+	state, tx := r.current(e.ParticipantID, e)
+	r.add(&state, e.ItemID)
+	tx.purchases = append(tx.purchases, &purchase{ItemPurchased: e, destroyed: tx.destroyed})
+	tx.destroyed = nil
+	r.purchases[e.ParticipantID] = append(r.purchases[e.ParticipantID], e)
+	r.record(e.ParticipantID, e, state)
+}
+
+// ItemSold implements Handler.
+func (r *Replay) ItemSold(e *ItemSold) {
+	state, _ := r.current(e.ParticipantID, e)
+	r.remove(&state, e.ItemID)
+	r.record(e.ParticipantID, e, state)
+}
+
+// ItemDestroyed implements Handler.
+func (r *Replay) ItemDestroyed(e *ItemDestroyed) {
+	state, tx := r.current(e.ParticipantID, e)
+	r.remove(&state, e.ItemID)
+	tx.destroyed = append(tx.destroyed, e.ItemID)
+	r.record(e.ParticipantID, e, state)
+}
+
+// ItemUndo implements Handler.
+// An undone purchase is removed, and if it's in the last transaction,
+// items destroyed just before it are added back. Items destroyed after the last
+// purchase of the transaction are added back with the last purchase.
+// An undone sale is added back.
+func (r *Replay) ItemUndo(e *ItemUndo) {
+	state := r.last(e.ParticipantID)
+	if e.ItemBefore != 0 {
+		r.remove(&state, e.ItemBefore)
+		if tx := r.transactions[e.ParticipantID]; tx != nil {
+			for _, id := range tx.undo(e.ItemBefore) {
+				r.add(&state, id)
+			}
+		}
+
+		ps := r.purchases[e.ParticipantID]
+		for i := len(ps) - 1; i >= 0; i-- {
+			if ps[i].ItemID == e.ItemBefore {
+				r.purchases[e.ParticipantID] = append(ps[:i:i], ps[i+1:]...)
+				break
+			}
+		}
+	}
+	if e.ItemAfter != 0 {
+		r.add(&state, e.ItemAfter)
+	}
+	r.record(e.ParticipantID, e, state)
+}
+
+// undo removes the last purchase of the item from the transaction,
+// and returns items destroyed by it.
+func (tx *transaction) undo(id int32) []int32 {
+	for i := len(tx.purchases) - 1; i >= 0; i-- {
+		p := tx.purchases[i]
+		if p.ItemID != id {
+			continue
+		}
+
+		destroyed := p.destroyed
+		if i == len(tx.purchases)-1 {
+			destroyed = appendInt32s(destroyed, tx.destroyed...)
+			tx.destroyed = nil
+		}
+		tx.purchases = append(tx.purchases[:i:i], tx.purchases[i+1:]...)
+		return destroyed
+	}
+	return nil
+}
+
+// appendInt32s appends to a copy of s, as slices of InventoryState are shared by history.
+func appendInt32s(s []int32, ids ...int32) []int32 {
+	return append(append(make([]int32, 0, len(s)+len(ids)), s...), ids...)
+}
+
+// Mismatch is a difference between a reconstructed inventory and ParticipantStats.
+type Mismatch struct {
+	ParticipantID int32
+	// Sorted item ids.
+	Want, Got []int32
+}
+
+// ValidationError is returned by Validate.
+type ValidationError []Mismatch
+
+func (e ValidationError) Error() string {
+	strs := make([]string, len(e))
+	for i, m := range e {
+		strs[i] = fmt.Sprintf("participant %d: want %v, got %v", m.ParticipantID, m.Want, m.Got)
+	}
+	return "timeline: inventory mismatch: " + strings.Join(strs, "; ")
+}
+
+// Validate compares final inventories and overflowed items with ParticipantStats.Item0..Item6,
+// ignoring the order of slots and stacks.
+// It returns ValidationError if they differ.
+func (r *Replay) Validate(m *lol.MatchDetail) error {
+	var errs ValidationError
+	for _, p := range m.Participants {
+		if p.Stats == nil {
+			continue
+		}
+
+		var want []int32
+		for _, id := range []int64{p.Stats.Item0, p.Stats.Item1, p.Stats.Item2, p.Stats.Item3, p.Stats.Item4, p.Stats.Item5, p.Stats.Item6} {
+			if id != 0 {
+				want = append(want, int32(id))
+			}
+		}
+		sort.Sort(int32s(want))
+
+		state := r.last(p.ParticipantID)
+		got := state.Inventory.Items()
+		if len(state.Overflow) != 0 {
+			got = append(got, state.Overflow...)
+			sort.Sort(int32s(got))
+		}
+		if !equalInt32s(want, got) {
+			errs = append(errs, Mismatch{ParticipantID: p.ParticipantID, Want: want, Got: got})
+		}
+	}
+	if len(errs) != 0 {
+		return errs
+	}
+	return nil
+}
+
+func equalInt32s(a, b []int32) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+type int32s []int32
+
+func (s int32s) Len() int           { return len(s) }
+func (s int32s) Swap(i, j int)      { s[i], s[j] = s[j], s[i] }
+func (s int32s) Less(i, j int) bool { return s[i] < s[j] }
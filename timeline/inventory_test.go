@@ -0,0 +1,149 @@
+package timeline
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/go-lol/lol"
+)
+
+func TestReplayInventories(t *testing.T) {
+	m := newTestMatch()
+	r, err := ReplayInventories(m, nil)
+	if err != nil {
+		t.Fatal(err)
+		return
+	}
+	if err := r.Validate(m); err != nil {
+		t.Fatal(err)
+		return
+	}
+
+	if inv := r.At(1, 1050*time.Millisecond); inv[0] != (Slot{1001, 1}) {
+		t.Errorf("Expected Boots of Speed before undo, got %v", inv)
+	}
+	if inv := r.At(1, 1100*time.Millisecond); inv != (Inventory{}) {
+		t.Errorf("Expected empty inventory after undo, got %v", inv)
+	}
+	if inv := r.At(2, time.Minute); inv[1] != (Slot{2003, 1}) || inv[TrinketSlot] != (Slot{3340, 1}) {
+		t.Errorf("Invalid inventory %v", inv)
+	}
+	if order := r.BuildOrder(1); !reflect.DeepEqual(order, []int32{1055}) {
+		t.Errorf("Invalid build order %v", order)
+	}
+
+	m.Participants[0].Stats.Item2 = 1001
+	err = r.Validate(m)
+	if verr, ok := err.(ValidationError); !ok || len(verr) != 1 || verr[0].ParticipantID != 1 {
+		t.Errorf("Expected mismatch of participant 1, got %v", err)
+	}
+}
+
+func TestReplayUndoCombine(t *testing.T) {
+	// Long Sword x2 -> Serrated Dirk, then undo.
+	events := []*lol.Event{
+		{EventType: TypeItemPurchased, Timestamp: 1000, ParticipantID: 1, ItemID: 1036},
+		{EventType: TypeItemPurchased, Timestamp: 2000, ParticipantID: 1, ItemID: 1036},
+		{EventType: TypeItemDestroyed, Timestamp: 3000, ParticipantID: 1, ItemID: 1036},
+		{EventType: TypeItemDestroyed, Timestamp: 3000, ParticipantID: 1, ItemID: 1036},
+		{EventType: TypeItemPurchased, Timestamp: 3000, ParticipantID: 1, ItemID: 3134},
+		{EventType: TypeItemUndo, Timestamp: 3500, ParticipantID: 1, ItemBefore: 3134},
+		{EventType: TypeItemSold, Timestamp: 4000, ParticipantID: 1, ItemID: 1036},
+		{EventType: TypeItemUndo, Timestamp: 4100, ParticipantID: 1, ItemAfter: 1036},
+	}
+	m := &lol.MatchDetail{Timeline: &lol.Timeline{Frames: []*lol.Frame{{Events: events}}}}
+
+	r, err := ReplayInventories(m, nil)
+	if err != nil {
+		t.Fatal(err)
+		return
+	}
+	if items := r.At(1, 3*time.Second).Items(); !reflect.DeepEqual(items, []int32{3134}) {
+		t.Errorf("Expected Serrated Dirk, got %v", items)
+	}
+	if items := r.Final(1).Items(); !reflect.DeepEqual(items, []int32{1036, 1036}) {
+		t.Errorf("Expected two Long Swords after undo, got %v", items)
+	}
+	if order := r.BuildOrder(1); !reflect.DeepEqual(order, []int32{1036, 1036}) {
+		t.Errorf("Invalid build order %v", order)
+	}
+}
+
+func TestReplayFullInventory(t *testing.T) {
+	// Six items, then B. F. Sword -> Infinity Edge with Pickaxe and Cloak of Agility in the inventory.
+	var events []*lol.Event
+	for i, id := range []int32{1038, 1037, 1018, 1001, 1036, 1042} {
+		events = append(events, &lol.Event{EventType: TypeItemPurchased, Timestamp: lol.Millis(1000 * (i + 1)), ParticipantID: 1, ItemID: id})
+	}
+	events = append(events,
+		&lol.Event{EventType: TypeItemPurchased, Timestamp: 10000, ParticipantID: 1, ItemID: 3031},
+		&lol.Event{EventType: TypeItemDestroyed, Timestamp: 10000, ParticipantID: 1, ItemID: 1038},
+		&lol.Event{EventType: TypeItemDestroyed, Timestamp: 10000, ParticipantID: 1, ItemID: 1037},
+		&lol.Event{EventType: TypeItemDestroyed, Timestamp: 10000, ParticipantID: 1, ItemID: 1018},
+	)
+	m := &lol.MatchDetail{
+		Participants: []*lol.MatchParticipant{
+			{ParticipantID: 1, Stats: &lol.ParticipantStats{Item0: 3031, Item3: 1001, Item4: 1036, Item5: 1042}},
+		},
+		Timeline: &lol.Timeline{Frames: []*lol.Frame{{Events: events}}},
+	}
+
+	r, err := ReplayInventories(m, nil)
+	if err != nil {
+		t.Fatal(err)
+		return
+	}
+	if err := r.Validate(m); err != nil {
+		t.Fatal(err)
+		return
+	}
+
+	// Right after the purchase, no item is lost.
+	h := r.History(1)
+	if s := h[6]; !reflect.DeepEqual(s.Inventory.Items(), []int32{1001, 1018, 1036, 1037, 1038, 1042}) || !reflect.DeepEqual(s.Overflow, []int32{3031}) {
+		t.Errorf("Expected Infinity Edge in overflow, got %v %v", s.Inventory.Items(), s.Overflow)
+	}
+	if s := h[7]; s.Inventory.Items()[len(s.Inventory.Items())-1] != 3031 || len(s.Overflow) != 0 {
+		t.Errorf("Expected Infinity Edge in a freed slot, got %v %v", s.Inventory.Items(), s.Overflow)
+	}
+
+	// Overflowed items left at the end are reported.
+	m.Timeline.Frames[0].Events = events[:7]
+	if r, err = ReplayInventories(m, nil); err != nil {
+		t.Fatal(err)
+		return
+	}
+	err = r.Validate(m)
+	if verr, ok := err.(ValidationError); !ok || len(verr) != 1 || len(verr[0].Got) != 7 {
+		t.Errorf("Expected mismatch of 7 items, got %v", err)
+	}
+}
+
+func TestReplayUndoOnePurchase(t *testing.T) {
+	// Boots of Speed and a Health Potion at once, then undo the potion.
+	events := []*lol.Event{
+		{EventType: TypeItemPurchased, Timestamp: 1000, ParticipantID: 1, ItemID: 1001},
+		{EventType: TypeItemPurchased, Timestamp: 1000, ParticipantID: 1, ItemID: 2003},
+		{EventType: TypeItemUndo, Timestamp: 1500, ParticipantID: 1, ItemBefore: 2003},
+		{EventType: TypeItemSold, Timestamp: 2000, ParticipantID: 1, ItemID: 1001},
+		{EventType: TypeItemPurchased, Timestamp: 2000, ParticipantID: 1, ItemID: 1036},
+		{EventType: TypeItemUndo, Timestamp: 2500, ParticipantID: 1, ItemAfter: 1001},
+	}
+	m := &lol.MatchDetail{Timeline: &lol.Timeline{Frames: []*lol.Frame{{Events: events}}}}
+
+	r, err := ReplayInventories(m, nil)
+	if err != nil {
+		t.Fatal(err)
+		return
+	}
+	if items := r.At(1, 1500*time.Millisecond).Items(); !reflect.DeepEqual(items, []int32{1001}) {
+		t.Errorf("Expected Boots of Speed after undo of the potion, got %v", items)
+	}
+	if items := r.Final(1).Items(); !reflect.DeepEqual(items, []int32{1001, 1036}) {
+		t.Errorf("Expected Boots of Speed and Long Sword after undo of the sale, got %v", items)
+	}
+	if order := r.BuildOrder(1); !reflect.DeepEqual(order, []int32{1001, 1036}) {
+		t.Errorf("Invalid build order %v", order)
+	}
+}
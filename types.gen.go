@@ -0,0 +1,176 @@
+// Generated by go-lol-generator. DO NOT EDIT.
+
+package lol
+
+const (
+	// Global is a service area of league of legends.
+	Global Region = 1
+	// PBE is a service area of league of legends.
+	PBE Region = 2
+	// NA is a service area of league of legends.
+	NA Region = 10
+	// EUW is a service area of league of legends.
+	EUW Region = 11
+	// EUNE is a service area of league of legends.
+	EUNE Region = 12
+	// KR is a service area of league of legends.
+	KR Region = 13
+	// BR is a service area of league of legends.
+	BR Region = 14
+	// TR is a service area of league of legends.
+	TR Region = 15
+	// RU is a service area of league of legends.
+	RU Region = 16
+	// LAS is a service area of league of legends.
+	LAS Region = 17
+	// LAN is a service area of league of legends.
+	LAN Region = 18
+	// OCE is a service area of league of legends.
+	OCE Region = 19
+)
+
+var regions = []Region{
+	PBE,
+	NA,
+	EUW,
+	EUNE,
+	KR,
+	BR,
+	TR,
+	RU,
+	LAS,
+	LAN,
+	OCE,
+}
+
+var regionByName = map[string]Region{
+	"global": Global,
+	"pbe":    PBE,
+	"na":     NA,
+	"euw":    EUW,
+	"eune":   EUNE,
+	"kr":     KR,
+	"br":     BR,
+	"tr":     TR,
+	"ru":     RU,
+	"las":    LAS,
+	"lan":    LAN,
+	"oce":    OCE,
+}
+
+var regionByPlatformID = map[string]Region{
+	"pbe":  PBE,
+	"na1":  NA,
+	"euw1": EUW,
+	"eun1": EUNE,
+	"kr":   KR,
+	"br1":  BR,
+	"tr1":  TR,
+	"ru":   RU,
+	"la2":  LAS,
+	"la1":  LAN,
+	"oc1":  OCE,
+}
+
+var nameByRegion = map[Region]string{
+	Global: "global",
+	PBE:    "pbe",
+	NA:     "na",
+	EUW:    "euw",
+	EUNE:   "eune",
+	KR:     "kr",
+	BR:     "br",
+	TR:     "tr",
+	RU:     "ru",
+	LAS:    "las",
+	LAN:    "lan",
+	OCE:    "oce",
+}
+
+var platformIDByRegion = map[Region]string{
+	Global: "",
+	PBE:    "pbe",
+	NA:     "na1",
+	EUW:    "euw1",
+	EUNE:   "eun1",
+	KR:     "kr",
+	BR:     "br1",
+	TR:     "tr1",
+	RU:     "ru",
+	LAS:    "la2",
+	LAN:    "la1",
+	OCE:    "oc1",
+}
+
+var hostByRegion = map[Region]string{
+	Global: "global.api.pvp.net",
+	PBE:    "pbe.api.pvp.net",
+	NA:     "na.api.pvp.net",
+	EUW:    "euw.api.pvp.net",
+	EUNE:   "eune.api.pvp.net",
+	KR:     "kr.api.pvp.net",
+	BR:     "br.api.pvp.net",
+	TR:     "tr.api.pvp.net",
+	RU:     "ru.api.pvp.net",
+	LAS:    "las.api.pvp.net",
+	LAN:    "lan.api.pvp.net",
+	OCE:    "oce.api.pvp.net",
+}
+
+// Operations is a table of all operations by the name of Client method.
+var Operations = map[string]*OperationInfo{
+	"ChampionDatas":             championDatasInfo,
+	"ChampionData":              championDataInfo,
+	"Items":                     itemsInfo,
+	"Item":                      itemInfo,
+	"LanguageStrings":           languageStringsInfo,
+	"Languages":                 languagesInfo,
+	"Maps":                      mapsInfo,
+	"Masteries":                 masteriesInfo,
+	"Mastery":                   masteryInfo,
+	"Realm":                     realmInfo,
+	"Runes":                     runesInfo,
+	"Rune":                      runeInfo,
+	"SummonerSpells":            summonerSpellsInfo,
+	"SummonerSpell":             summonerSpellInfo,
+	"Versions":                  versionsInfo,
+	"Champions":                 championsInfo,
+	"Champion":                  championInfo,
+	"SpectatorGameInfo":         spectatorGameInfoInfo,
+	"FeaturedGames":             featuredGamesInfo,
+	"RecentGames":               recentGamesInfo,
+	"LeaguesBySummonerID":       leaguesBySummonerIDInfo,
+	"LeagueEntriesBySummonerID": leagueEntriesBySummonerIDInfo,
+	"LeaguesByTeamID":           leaguesByTeamIDInfo,
+	"LeagueEntriesByTeamID":     leagueEntriesByTeamIDInfo,
+	"Challenger":                challengerInfo,
+	"Master":                    masterInfo,
+	"Shards":                    shardsInfo,
+	"ShardsInRegion":            shardsInRegionInfo,
+	"MatchesByTournement":       matchesByTournementInfo,
+	"MatchForTournement":        matchForTournementInfo,
+	"Match":                     matchInfo,
+	"MatchesBySummonerID":       matchesBySummonerIDInfo,
+	"RankedStats":               rankedStatsInfo,
+	"StatsSummary":              statsSummaryInfo,
+	"SummonersByName":           summonersByNameInfo,
+	"Summoners":                 summonersInfo,
+	"SummonerMasteries":         summonerMasteriesInfo,
+	"SummonerNames":             summonerNamesInfo,
+	"SummonerRunes":             summonerRunesInfo,
+	"TeamsBySummonerID":         teamsBySummonerIDInfo,
+	"Teams":                     teamsInfo,
+}
+
+// ImageDto - This object contains image data.
+//
+// resource: "lol-static-data", original name: "ImageDto"
+type Image struct {
+	Full   string `json:"full,omitempty"`
+	Group  string `json:"group,omitempty"`
+	H      int32  `json:"h,omitempty"`
+	Sprite string `json:"sprite,omitempty"`
+	W      int32  `json:"w,omitempty"`
+	X      int32  `json:"x,omitempty"`
+	Y      int32  `json:"y,omitempty"`
+}
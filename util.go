@@ -15,6 +15,14 @@ type SpellRange struct {
 	Ranges []int32
 }
 
+// MarshalJSON returns "self", or an array of ranges, so that UnmarshalJSON restores sr.
+func (sr SpellRange) MarshalJSON() ([]byte, error) {
+	if sr.Self {
+		return []byte(`"self"`), nil
+	}
+	return json.Marshal(sr.Ranges)
+}
+
 // UnmarshalJSON handles strange value of the spell range.
 func (sr *SpellRange) UnmarshalJSON(data []byte) error {
 	str := string(data)
@@ -109,3 +117,30 @@ func convertToString(val interface{}) string {
 func ParseEpochMilliseconds(ms int64) time.Time {
 	return time.Unix(0, ms*int64(time.Millisecond))
 }
+
+// EpochMillis is a time in milliseconds since the unix epoch.
+// It's encoded as a number in json, like int64.
+type EpochMillis int64
+
+// NewEpochMillis returns t in milliseconds since the unix epoch.
+func NewEpochMillis(t time.Time) EpochMillis {
+	return EpochMillis(t.UnixNano() / int64(time.Millisecond))
+}
+
+// Time returns the time in the local timezone.
+func (ms EpochMillis) Time() time.Time { return ParseEpochMilliseconds(int64(ms)) }
+
+// IsZero returns true if ms is 0, which means absent in most cases.
+func (ms EpochMillis) IsZero() bool { return ms == 0 }
+
+// Millis is a duration in milliseconds. e.g. Event.Timestamp
+type Millis int64
+
+// Duration returns ms as time.Duration.
+func (ms Millis) Duration() time.Duration { return time.Duration(ms) * time.Millisecond }
+
+// Seconds is a duration in seconds. e.g. MatchDetail.MatchDuration
+type Seconds int64
+
+// Duration returns s as time.Duration.
+func (s Seconds) Duration() time.Duration { return time.Duration(s) * time.Second }
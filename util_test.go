@@ -0,0 +1,49 @@
+package lol
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestTimeTypes(t *testing.T) {
+	var m MatchDetail
+	if err := json.Unmarshal([]byte(`{"matchCreation":1451606400123,"matchDuration":1800}`), &m); err != nil {
+		t.Fatal(err)
+		return
+	}
+
+	if created := m.MatchCreation.Time().UTC(); !created.Equal(time.Date(2016, 1, 1, 0, 0, 0, 123e6, time.UTC)) {
+		t.Errorf("Invalid creation time %v", created)
+	}
+	if NewEpochMillis(m.MatchCreation.Time()) != m.MatchCreation {
+		t.Errorf("Expected %d, got %d", m.MatchCreation, NewEpochMillis(m.MatchCreation.Time()))
+	}
+	if m.MatchDuration.Duration() != 30*time.Minute || Millis(1500).Duration() != 1500*time.Millisecond {
+		t.Errorf("Invalid duration %v", m.MatchDuration.Duration())
+	}
+
+	b, err := json.Marshal(&m)
+	if err != nil || string(b) != `{"matchCreation":1451606400123,"matchDuration":1800}` {
+		t.Errorf("Expected numbers, got %s %v", b, err)
+	}
+}
+
+func TestSpellRangeJSON(t *testing.T) {
+	for _, sr := range []SpellRange{{Self: true}, {Ranges: []int32{600, 700}}} {
+		data, err := json.Marshal(&sr)
+		if err != nil {
+			t.Fatal(err)
+			return
+		}
+		var decoded SpellRange
+		if err := json.Unmarshal(data, &decoded); err != nil {
+			t.Fatal(err)
+			return
+		}
+		if !reflect.DeepEqual(decoded, sr) {
+			t.Errorf("Expected %+v, got %+v from %s", sr, decoded, data)
+		}
+	}
+}